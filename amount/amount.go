@@ -0,0 +1,312 @@
+// Copyright 2021 Coinbase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package amount provides arbitrary-precision integers for the values of
+// Rosetta API amounts.
+package amount
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math/big"
+	"math/bits"
+	"strconv"
+)
+
+const (
+	// pow10x19 is the largest power of 10 that fits within a uint64.
+	pow10x19 = 10000000000000000000
+	signBit  = 1 << 63
+)
+
+// Int represents an arbitrary-precision signed integer. The zero value
+// represents 0.
+//
+// Values that fit within 128 bits are held inline as two's complement
+// integers, so that arithmetic on them doesn't allocate. Larger values fall
+// back to math/big.
+//
+// Int values are immutable, and can be freely copied and compared with Cmp.
+type Int struct {
+	big *big.Int // only set for values that don't fit within 128 bits
+	hi  uint64
+	lo  uint64
+}
+
+// Add returns x + y.
+func (x Int) Add(y Int) Int {
+	if x.big == nil && y.big == nil {
+		lo, carry := bits.Add64(x.lo, y.lo, 0)
+		hi, _ := bits.Add64(x.hi, y.hi, carry)
+		// Signed overflow occurs when both operands have the same sign, and
+		// the sign of the result differs.
+		if (x.hi^y.hi)&signBit != 0 || (x.hi^hi)&signBit == 0 {
+			return Int{hi: hi, lo: lo}
+		}
+	}
+	return fromBig(new(big.Int).Add(x.toBig(), y.toBig()))
+}
+
+// AppendString appends the canonical decimal encoding of the Int to dst.
+func (x Int) AppendString(dst []byte) []byte {
+	if x.big != nil {
+		return x.big.Append(dst, 10)
+	}
+	hi, lo := x.hi, x.lo
+	if hi&signBit != 0 {
+		dst = append(dst, '-')
+		hi, lo = negate(hi, lo)
+	}
+	if hi == 0 {
+		return strconv.AppendUint(dst, lo, 10)
+	}
+	// NOTE(tav): The magnitude is at most 2^127, so hi is always less than
+	// 10^19, and the quotient always fits within a uint64.
+	q, r := bits.Div64(hi, lo, pow10x19)
+	dst = strconv.AppendUint(dst, q, 10)
+	var buf [19]byte
+	for i := len(buf) - 1; i >= 0; i-- {
+		buf[i] = byte('0' + r%10)
+		r /= 10
+	}
+	return append(dst, buf[:]...)
+}
+
+// Big returns the Int as a newly allocated big.Int.
+func (x Int) Big() *big.Int {
+	if x.big != nil {
+		return new(big.Int).Set(x.big)
+	}
+	return x.toBig()
+}
+
+// Cmp compares x and y, and returns -1 if x < y, 0 if x == y, and +1 if
+// x > y.
+func (x Int) Cmp(y Int) int {
+	if x.big != nil || y.big != nil {
+		return x.toBig().Cmp(y.toBig())
+	}
+	if x.hi != y.hi {
+		if int64(x.hi) < int64(y.hi) {
+			return -1
+		}
+		return 1
+	}
+	switch {
+	case x.lo < y.lo:
+		return -1
+	case x.lo > y.lo:
+		return 1
+	}
+	return 0
+}
+
+// Format returns the Int as a decimal in the standard unit of a currency with
+// the given number of decimal places, e.g. 150000000 with 8 decimals gives
+// "1.5". Trailing zeros in the fractional part are omitted.
+func (x Int) Format(decimals int32) string {
+	digits := x.AppendString(nil)
+	if decimals <= 0 {
+		return string(digits)
+	}
+	neg := false
+	if digits[0] == '-' {
+		neg = true
+		digits = digits[1:]
+	}
+	n := int(decimals)
+	b := make([]byte, 0, len(digits)+n+3)
+	if neg {
+		b = append(b, '-')
+	}
+	pad := 0
+	if len(digits) > n {
+		b = append(b, digits[:len(digits)-n]...)
+		digits = digits[len(digits)-n:]
+	} else {
+		b = append(b, '0')
+		pad = n - len(digits)
+	}
+	end := len(digits)
+	for end > 0 && digits[end-1] == '0' {
+		end--
+	}
+	if end > 0 {
+		b = append(b, '.')
+		for i := 0; i < pad; i++ {
+			b = append(b, '0')
+		}
+		b = append(b, digits[:end]...)
+	}
+	return string(b)
+}
+
+// IsZero returns whether the Int is zero.
+func (x Int) IsZero() bool {
+	return x.big == nil && x.hi == 0 && x.lo == 0
+}
+
+// Neg returns -x.
+func (x Int) Neg() Int {
+	// NOTE(tav): The negation of -2^127 is the only one that overflows.
+	if x.big == nil && (x.hi != signBit || x.lo != 0) {
+		hi, lo := negate(x.hi, x.lo)
+		return Int{hi: hi, lo: lo}
+	}
+	return fromBig(new(big.Int).Neg(x.toBig()))
+}
+
+// Sign returns -1 if x < 0, 0 if x == 0, and +1 if x > 0.
+func (x Int) Sign() int {
+	switch {
+	case x.big != nil:
+		return x.big.Sign()
+	case x.hi&signBit != 0:
+		return -1
+	case x.hi == 0 && x.lo == 0:
+		return 0
+	}
+	return 1
+}
+
+// String returns the canonical decimal encoding of the Int, i.e. without any
+// leading zeros, and without a sign for zero.
+func (x Int) String() string {
+	var buf [48]byte
+	return string(x.AppendString(buf[:0]))
+}
+
+// Sub returns x - y.
+func (x Int) Sub(y Int) Int {
+	if x.big == nil && y.big == nil {
+		lo, borrow := bits.Sub64(x.lo, y.lo, 0)
+		hi, _ := bits.Sub64(x.hi, y.hi, borrow)
+		// Signed overflow occurs when the operands have different signs, and
+		// the sign of the result differs from that of x.
+		if (x.hi^y.hi)&signBit == 0 || (x.hi^hi)&signBit == 0 {
+			return Int{hi: hi, lo: lo}
+		}
+	}
+	return fromBig(new(big.Int).Sub(x.toBig(), y.toBig()))
+}
+
+func (x Int) toBig() *big.Int {
+	if x.big != nil {
+		return x.big
+	}
+	if int64(x.hi) == int64(x.lo)>>63 {
+		return big.NewInt(int64(x.lo))
+	}
+	hi, lo := x.hi, x.lo
+	neg := hi&signBit != 0
+	if neg {
+		hi, lo = negate(hi, lo)
+	}
+	var buf [16]byte
+	binary.BigEndian.PutUint64(buf[:8], hi)
+	binary.BigEndian.PutUint64(buf[8:], lo)
+	v := new(big.Int).SetBytes(buf[:])
+	if neg {
+		v.Neg(v)
+	}
+	return v
+}
+
+// FromInt64 returns the Int for the given int64 value.
+func FromInt64(v int64) Int {
+	return Int{hi: uint64(v >> 63), lo: uint64(v)}
+}
+
+// MustParse is like Parse, but panics if the given value is invalid.
+func MustParse(s string) Int {
+	x, err := Parse(s)
+	if err != nil {
+		panic(err)
+	}
+	return x
+}
+
+// Parse parses a decimal integer, as used for the Value of a Rosetta API
+// Amount, i.e. a string of digits with an optional leading minus sign.
+func Parse(s string) (Int, error) {
+	digits := s
+	neg := false
+	if len(digits) > 0 && digits[0] == '-' {
+		neg = true
+		digits = digits[1:]
+	}
+	if len(digits) == 0 {
+		return Int{}, fmt.Errorf("amount: invalid integer value %q", s)
+	}
+	var hi, lo uint64
+	overflow := false
+	for i := 0; i < len(digits); i++ {
+		c := digits[i]
+		if c < '0' || c > '9' {
+			return Int{}, fmt.Errorf("amount: invalid integer value %q", s)
+		}
+		if overflow {
+			continue
+		}
+		// Compute (hi, lo) * 10 + c, while checking for overflow of the
+		// 128-bit magnitude.
+		carry, hi10 := bits.Mul64(hi, 10)
+		lhi, llo := bits.Mul64(lo, 10)
+		var c1, c2 uint64
+		hi, c1 = bits.Add64(hi10, lhi, 0)
+		lo, c2 = bits.Add64(llo, uint64(c-'0'), 0)
+		hi, c2 = bits.Add64(hi, 0, c2)
+		overflow = carry != 0 || c1 != 0 || c2 != 0
+	}
+	if !overflow {
+		switch {
+		case hi&signBit == 0:
+			if neg {
+				hi, lo = negate(hi, lo)
+			}
+			return Int{hi: hi, lo: lo}, nil
+		case neg && hi == signBit && lo == 0:
+			return Int{hi: hi, lo: lo}, nil
+		}
+	}
+	v, _ := new(big.Int).SetString(s, 10)
+	return Int{big: v}, nil
+}
+
+// fromBig returns the Int for the given big.Int, which must not be modified
+// afterwards. The value is held inline if it fits within 128 bits.
+func fromBig(v *big.Int) Int {
+	if v.BitLen() > 127 {
+		return Int{big: v}
+	}
+	if v.IsInt64() {
+		return FromInt64(v.Int64())
+	}
+	var buf [16]byte
+	abs := new(big.Int).Abs(v)
+	abs.FillBytes(buf[:])
+	hi := binary.BigEndian.Uint64(buf[:8])
+	lo := binary.BigEndian.Uint64(buf[8:])
+	if v.Sign() < 0 {
+		hi, lo = negate(hi, lo)
+	}
+	return Int{hi: hi, lo: lo}
+}
+
+// negate returns the two's complement negation of the given 128-bit value.
+func negate(hi uint64, lo uint64) (uint64, uint64) {
+	lo, borrow := bits.Sub64(0, lo, 0)
+	hi, _ = bits.Sub64(0, hi, borrow)
+	return hi, lo
+}
@@ -0,0 +1,160 @@
+// Copyright 2021 Coinbase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package amount
+
+import (
+	"math/big"
+	"math/rand"
+	"testing"
+)
+
+var testValues = []string{
+	"0",
+	"1",
+	"-1",
+	"9223372036854775807",
+	"-9223372036854775808",
+	"18446744073709551615",
+	"18446744073709551616",
+	"100000000000000000000000000000",
+	"170141183460469231731687303715884105727",
+	"-170141183460469231731687303715884105728",
+	"170141183460469231731687303715884105728",
+	"-170141183460469231731687303715884105729",
+	"340282366920938463463374607431768211456",
+	"123456789012345678901234567890123456789012345678901234567890",
+	"-123456789012345678901234567890123456789012345678901234567890",
+}
+
+func TestArithmetic(t *testing.T) {
+	for _, a := range testValues {
+		x := MustParse(a)
+		xb, _ := new(big.Int).SetString(a, 10)
+		if x.String() != a {
+			t.Errorf("Parse(%q).String() = %s", a, x)
+		}
+		if x.Sign() != xb.Sign() {
+			t.Errorf("Parse(%q).Sign() = %d", a, x.Sign())
+		}
+		if want := new(big.Int).Neg(xb).String(); x.Neg().String() != want {
+			t.Errorf("-%s = %s, want %s", a, x.Neg(), want)
+		}
+		for _, b := range testValues {
+			y := MustParse(b)
+			yb, _ := new(big.Int).SetString(b, 10)
+			if want := new(big.Int).Add(xb, yb).String(); x.Add(y).String() != want {
+				t.Errorf("%s + %s = %s, want %s", a, b, x.Add(y), want)
+			}
+			if want := new(big.Int).Sub(xb, yb).String(); x.Sub(y).String() != want {
+				t.Errorf("%s - %s = %s, want %s", a, b, x.Sub(y), want)
+			}
+			if got, want := x.Cmp(y), xb.Cmp(yb); got != want {
+				t.Errorf("Cmp(%s, %s) = %d, want %d", a, b, got, want)
+			}
+			if x.Add(y).Sub(y).Cmp(x) != 0 {
+				t.Errorf("%s + %s - %s != %s", a, b, b, a)
+			}
+		}
+	}
+}
+
+func TestArithmeticRandom(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	limit := new(big.Int).Lsh(big.NewInt(1), 130)
+	random := func() *big.Int {
+		v := new(big.Int).Rand(rng, limit)
+		v.Rsh(v, uint(rng.Intn(130)))
+		if rng.Intn(2) == 0 {
+			v.Neg(v)
+		}
+		return v
+	}
+	for i := 0; i < 10000; i++ {
+		xb, yb := random(), random()
+		x, y := MustParse(xb.String()), MustParse(yb.String())
+		if want := new(big.Int).Add(xb, yb).String(); x.Add(y).String() != want {
+			t.Fatalf("%s + %s = %s, want %s", xb, yb, x.Add(y), want)
+		}
+		if want := new(big.Int).Sub(xb, yb).String(); x.Sub(y).String() != want {
+			t.Fatalf("%s - %s = %s, want %s", xb, yb, x.Sub(y), want)
+		}
+		if got, want := x.Cmp(y), xb.Cmp(yb); got != want {
+			t.Fatalf("Cmp(%s, %s) = %d, want %d", xb, yb, got, want)
+		}
+	}
+}
+
+func TestFormat(t *testing.T) {
+	for _, test := range []struct {
+		value    string
+		decimals int32
+		want     string
+	}{
+		{"0", 8, "0"},
+		{"150000000", 8, "1.5"},
+		{"100000000", 8, "1"},
+		{"1", 8, "0.00000001"},
+		{"-1234", 2, "-12.34"},
+		{"-5", 3, "-0.005"},
+		{"1234", 0, "1234"},
+		{"123456789012345678901234567890", 18, "123456789012.34567890123456789"},
+	} {
+		if got := MustParse(test.value).Format(test.decimals); got != test.want {
+			t.Errorf("Format(%s, %d) = %s, want %s", test.value, test.decimals, got, test.want)
+		}
+	}
+}
+
+func TestParse(t *testing.T) {
+	for _, test := range []struct {
+		value string
+		want  string
+	}{
+		{"-0", "0"},
+		{"007", "7"},
+		{"-000000000000000000000000000000000000000000042", "-42"},
+	} {
+		if got := MustParse(test.value).String(); got != test.want {
+			t.Errorf("Parse(%q) = %s, want %s", test.value, got, test.want)
+		}
+	}
+	for _, value := range []string{"", "-", "+1", "1.5", "1e5", " 1", "0x10", "1_000"} {
+		if _, err := Parse(value); err == nil {
+			t.Errorf("Expected error when parsing %q", value)
+		}
+	}
+}
+
+func BenchmarkAdd(b *testing.B) {
+	x := MustParse("123456789012345678901234567")
+	y := MustParse("-98765432109876543210")
+	b.ReportAllocs()
+	sum := Int{}
+	for i := 0; i < b.N; i++ {
+		sum = sum.Add(x).Sub(y)
+	}
+	if sum.Sign() < 0 {
+		b.Fatal("Unexpected negative sum")
+	}
+}
+
+func BenchmarkParse(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		if _, err := Parse("123456789012345678901234567"); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
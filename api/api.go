@@ -19,9 +19,13 @@
 package api
 
 import (
+	"context"
+	"errors"
 	"fmt"
+	"net/http"
 
 	"github.com/tav/validate-rosetta/json"
+	"github.com/tav/validate-rosetta/retry"
 )
 
 // BlockEventType values.
@@ -72,12 +76,2084 @@ const (
 	SchnorrPoseidon SignatureType = "schnorr_poseidon"
 )
 
-// Client handles requests to Rosetta API servers.
+// AccountBalance calls the /account/balance endpoint.
 //
-// A Client can only be used to do one API call at a time. That is, do not
-// re-use a Client while a previous call is still being handled.
-type Client struct {
-	baseURL string
+// Calls /account/balance.
+//
+// Makes a request to /account/balance.
+func (c *Client) AccountBalance(
+	ctx context.Context, req *AccountBalanceRequest, resp *AccountBalanceResponse, retry retry.Handler,
+) *ClientError {
+	if len(c.netjson) == 0 {
+		c.err.reset()
+		c.err.CallError = errors.New(
+			"api: the SetNetwork method must be called before making a Client.AccountBalance call",
+		)
+		return c.err
+	}
+	c.req = req.EncodeJSON(c.req[:0], c.netjson)
+	if len(c.middleware) > 0 {
+		return c.intercept(ctx, "/account/balance", resp, func(ctx context.Context, inv *Invocation) *ClientError {
+			return c.doAccountBalance(ctx, inv, resp, retry)
+		})
+	}
+	return c.doAccountBalance(ctx, nil, resp, retry)
+}
+
+func (c *Client) doAccountBalance(
+	ctx context.Context, inv *Invocation, resp *AccountBalanceResponse, retry retry.Handler,
+) *ClientError {
+	it := retry.WithClassifier(classifyError).Iter()
+	var (
+		err   error
+		hresp *http.Response
+	)
+	for it.NextContext(ctx) {
+		if err = c.backend.acquire(ctx, "/account/balance"); err != nil {
+			c.reportAttempt(inv, "/account/balance", it.Record(err))
+			continue
+		}
+		hresp, err = c.post(ctx, "/account/balance")
+		if err != nil {
+			c.record(inv, "/account/balance", &it, err)
+			continue
+		}
+		inv.observe(hresp)
+		switch hresp.StatusCode {
+		case 200:
+			err = c.dec.ResetFromReadCloser(hresp.Body)
+			if err == nil {
+				resp.Reset()
+				err = resp.DecodeJSON(c.dec)
+			}
+			if err == nil {
+				err = c.dec.End()
+			}
+			if err == nil {
+				c.reportFindings("/account/balance")
+				c.record(inv, "/account/balance", &it, nil)
+				return nil
+			}
+		case 500:
+			c.err.reset()
+			err = c.dec.ResetFromReadCloser(hresp.Body)
+			if err == nil {
+				err = c.err.RosettaError.DecodeJSON(c.dec)
+			}
+			if err == nil {
+				err = c.dec.End()
+			}
+			if err == nil {
+				c.reportFindings("/account/balance")
+				err = c.err
+			}
+		default:
+			err = newStatusError("/account/balance", hresp)
+		}
+		c.record(inv, "/account/balance", &it, err)
+	}
+	// NOTE(tav): The context may have been done before the first attempt.
+	if err == nil {
+		err = ctx.Err()
+	}
+	// NOTE(tav): Rosetta errors are returned as is once they are no longer
+	// being retried.
+	if err == c.err {
+		return c.err
+	}
+	if err != nil {
+		c.reportLimit("/account/balance", err)
+		c.err.reset()
+		c.err.CallError = err
+		return c.err
+	}
+	return nil
+}
+
+// AccountCoins calls the /account/coins endpoint.
+//
+// Calls /account/coins.
+//
+// Makes a request to /account/coins.
+func (c *Client) AccountCoins(
+	ctx context.Context, req *AccountCoinsRequest, resp *AccountCoinsResponse, retry retry.Handler,
+) *ClientError {
+	if len(c.netjson) == 0 {
+		c.err.reset()
+		c.err.CallError = errors.New(
+			"api: the SetNetwork method must be called before making a Client.AccountCoins call",
+		)
+		return c.err
+	}
+	c.req = req.EncodeJSON(c.req[:0], c.netjson)
+	if len(c.middleware) > 0 {
+		return c.intercept(ctx, "/account/coins", resp, func(ctx context.Context, inv *Invocation) *ClientError {
+			return c.doAccountCoins(ctx, inv, resp, retry)
+		})
+	}
+	return c.doAccountCoins(ctx, nil, resp, retry)
+}
+
+func (c *Client) doAccountCoins(
+	ctx context.Context, inv *Invocation, resp *AccountCoinsResponse, retry retry.Handler,
+) *ClientError {
+	it := retry.WithClassifier(classifyError).Iter()
+	var (
+		err   error
+		hresp *http.Response
+	)
+	for it.NextContext(ctx) {
+		if err = c.backend.acquire(ctx, "/account/coins"); err != nil {
+			c.reportAttempt(inv, "/account/coins", it.Record(err))
+			continue
+		}
+		hresp, err = c.post(ctx, "/account/coins")
+		if err != nil {
+			c.record(inv, "/account/coins", &it, err)
+			continue
+		}
+		inv.observe(hresp)
+		switch hresp.StatusCode {
+		case 200:
+			err = c.dec.ResetFromReadCloser(hresp.Body)
+			if err == nil {
+				resp.Reset()
+				err = resp.DecodeJSON(c.dec)
+			}
+			if err == nil {
+				err = c.dec.End()
+			}
+			if err == nil {
+				c.reportFindings("/account/coins")
+				c.record(inv, "/account/coins", &it, nil)
+				return nil
+			}
+		case 500:
+			c.err.reset()
+			err = c.dec.ResetFromReadCloser(hresp.Body)
+			if err == nil {
+				err = c.err.RosettaError.DecodeJSON(c.dec)
+			}
+			if err == nil {
+				err = c.dec.End()
+			}
+			if err == nil {
+				c.reportFindings("/account/coins")
+				err = c.err
+			}
+		default:
+			err = newStatusError("/account/coins", hresp)
+		}
+		c.record(inv, "/account/coins", &it, err)
+	}
+	// NOTE(tav): The context may have been done before the first attempt.
+	if err == nil {
+		err = ctx.Err()
+	}
+	// NOTE(tav): Rosetta errors are returned as is once they are no longer
+	// being retried.
+	if err == c.err {
+		return c.err
+	}
+	if err != nil {
+		c.reportLimit("/account/coins", err)
+		c.err.reset()
+		c.err.CallError = err
+		return c.err
+	}
+	return nil
+}
+
+// Block calls the /block endpoint.
+//
+// Calls /block.
+//
+// Makes a request to /block.
+func (c *Client) Block(
+	ctx context.Context, req *BlockRequest, resp *BlockResponse, retry retry.Handler,
+) *ClientError {
+	if len(c.netjson) == 0 {
+		c.err.reset()
+		c.err.CallError = errors.New(
+			"api: the SetNetwork method must be called before making a Client.Block call",
+		)
+		return c.err
+	}
+	c.req = req.EncodeJSON(c.req[:0], c.netjson)
+	if len(c.middleware) > 0 {
+		return c.intercept(ctx, "/block", resp, func(ctx context.Context, inv *Invocation) *ClientError {
+			return c.doBlock(ctx, inv, resp, retry)
+		})
+	}
+	return c.doBlock(ctx, nil, resp, retry)
+}
+
+func (c *Client) doBlock(
+	ctx context.Context, inv *Invocation, resp *BlockResponse, retry retry.Handler,
+) *ClientError {
+	it := retry.WithClassifier(classifyError).Iter()
+	var (
+		err   error
+		hresp *http.Response
+	)
+	for it.NextContext(ctx) {
+		if err = c.backend.acquire(ctx, "/block"); err != nil {
+			c.reportAttempt(inv, "/block", it.Record(err))
+			continue
+		}
+		hresp, err = c.post(ctx, "/block")
+		if err != nil {
+			c.record(inv, "/block", &it, err)
+			continue
+		}
+		inv.observe(hresp)
+		switch hresp.StatusCode {
+		case 200:
+			err = c.dec.ResetFromReadCloser(hresp.Body)
+			if err == nil {
+				resp.Reset()
+				err = resp.DecodeJSON(c.dec)
+			}
+			if err == nil {
+				err = c.dec.End()
+			}
+			if err == nil {
+				c.reportFindings("/block")
+				c.record(inv, "/block", &it, nil)
+				return nil
+			}
+		case 500:
+			c.err.reset()
+			err = c.dec.ResetFromReadCloser(hresp.Body)
+			if err == nil {
+				err = c.err.RosettaError.DecodeJSON(c.dec)
+			}
+			if err == nil {
+				err = c.dec.End()
+			}
+			if err == nil {
+				c.reportFindings("/block")
+				err = c.err
+			}
+		default:
+			err = newStatusError("/block", hresp)
+		}
+		c.record(inv, "/block", &it, err)
+	}
+	// NOTE(tav): The context may have been done before the first attempt.
+	if err == nil {
+		err = ctx.Err()
+	}
+	// NOTE(tav): Rosetta errors are returned as is once they are no longer
+	// being retried.
+	if err == c.err {
+		return c.err
+	}
+	if err != nil {
+		c.reportLimit("/block", err)
+		c.err.reset()
+		c.err.CallError = err
+		return c.err
+	}
+	return nil
+}
+
+// BlockTransaction calls the /block/transaction endpoint.
+//
+// Calls /block/transaction.
+//
+// Makes a request to /block/transaction.
+func (c *Client) BlockTransaction(
+	ctx context.Context, req *BlockTransactionRequest, resp *BlockTransactionResponse, retry retry.Handler,
+) *ClientError {
+	if len(c.netjson) == 0 {
+		c.err.reset()
+		c.err.CallError = errors.New(
+			"api: the SetNetwork method must be called before making a Client.BlockTransaction call",
+		)
+		return c.err
+	}
+	c.req = req.EncodeJSON(c.req[:0], c.netjson)
+	if len(c.middleware) > 0 {
+		return c.intercept(ctx, "/block/transaction", resp, func(ctx context.Context, inv *Invocation) *ClientError {
+			return c.doBlockTransaction(ctx, inv, resp, retry)
+		})
+	}
+	return c.doBlockTransaction(ctx, nil, resp, retry)
+}
+
+func (c *Client) doBlockTransaction(
+	ctx context.Context, inv *Invocation, resp *BlockTransactionResponse, retry retry.Handler,
+) *ClientError {
+	it := retry.WithClassifier(classifyError).Iter()
+	var (
+		err   error
+		hresp *http.Response
+	)
+	for it.NextContext(ctx) {
+		if err = c.backend.acquire(ctx, "/block/transaction"); err != nil {
+			c.reportAttempt(inv, "/block/transaction", it.Record(err))
+			continue
+		}
+		hresp, err = c.post(ctx, "/block/transaction")
+		if err != nil {
+			c.record(inv, "/block/transaction", &it, err)
+			continue
+		}
+		inv.observe(hresp)
+		switch hresp.StatusCode {
+		case 200:
+			err = c.dec.ResetFromReadCloser(hresp.Body)
+			if err == nil {
+				resp.Reset()
+				err = resp.DecodeJSON(c.dec)
+			}
+			if err == nil {
+				err = c.dec.End()
+			}
+			if err == nil {
+				c.reportFindings("/block/transaction")
+				c.record(inv, "/block/transaction", &it, nil)
+				return nil
+			}
+		case 500:
+			c.err.reset()
+			err = c.dec.ResetFromReadCloser(hresp.Body)
+			if err == nil {
+				err = c.err.RosettaError.DecodeJSON(c.dec)
+			}
+			if err == nil {
+				err = c.dec.End()
+			}
+			if err == nil {
+				c.reportFindings("/block/transaction")
+				err = c.err
+			}
+		default:
+			err = newStatusError("/block/transaction", hresp)
+		}
+		c.record(inv, "/block/transaction", &it, err)
+	}
+	// NOTE(tav): The context may have been done before the first attempt.
+	if err == nil {
+		err = ctx.Err()
+	}
+	// NOTE(tav): Rosetta errors are returned as is once they are no longer
+	// being retried.
+	if err == c.err {
+		return c.err
+	}
+	if err != nil {
+		c.reportLimit("/block/transaction", err)
+		c.err.reset()
+		c.err.CallError = err
+		return c.err
+	}
+	return nil
+}
+
+// Call calls the /call endpoint.
+//
+// Calls /call.
+//
+// Makes a request to /call.
+func (c *Client) Call(
+	ctx context.Context, req *CallRequest, resp *CallResponse, retry retry.Handler,
+) *ClientError {
+	if len(c.netjson) == 0 {
+		c.err.reset()
+		c.err.CallError = errors.New(
+			"api: the SetNetwork method must be called before making a Client.Call call",
+		)
+		return c.err
+	}
+	c.req = req.EncodeJSON(c.req[:0], c.netjson)
+	if len(c.middleware) > 0 {
+		return c.intercept(ctx, "/call", resp, func(ctx context.Context, inv *Invocation) *ClientError {
+			return c.doCall(ctx, inv, resp, retry)
+		})
+	}
+	return c.doCall(ctx, nil, resp, retry)
+}
+
+func (c *Client) doCall(
+	ctx context.Context, inv *Invocation, resp *CallResponse, retry retry.Handler,
+) *ClientError {
+	it := retry.WithClassifier(classifyError).Iter()
+	var (
+		err   error
+		hresp *http.Response
+	)
+	for it.NextContext(ctx) {
+		if err = c.backend.acquire(ctx, "/call"); err != nil {
+			c.reportAttempt(inv, "/call", it.Record(err))
+			continue
+		}
+		hresp, err = c.post(ctx, "/call")
+		if err != nil {
+			c.record(inv, "/call", &it, err)
+			continue
+		}
+		inv.observe(hresp)
+		switch hresp.StatusCode {
+		case 200:
+			err = c.dec.ResetFromReadCloser(hresp.Body)
+			if err == nil {
+				resp.Reset()
+				err = resp.DecodeJSON(c.dec)
+			}
+			if err == nil {
+				err = c.dec.End()
+			}
+			if err == nil {
+				c.reportFindings("/call")
+				c.record(inv, "/call", &it, nil)
+				return nil
+			}
+		case 500:
+			c.err.reset()
+			err = c.dec.ResetFromReadCloser(hresp.Body)
+			if err == nil {
+				err = c.err.RosettaError.DecodeJSON(c.dec)
+			}
+			if err == nil {
+				err = c.dec.End()
+			}
+			if err == nil {
+				c.reportFindings("/call")
+				err = c.err
+			}
+		default:
+			err = newStatusError("/call", hresp)
+		}
+		c.record(inv, "/call", &it, err)
+	}
+	// NOTE(tav): The context may have been done before the first attempt.
+	if err == nil {
+		err = ctx.Err()
+	}
+	// NOTE(tav): Rosetta errors are returned as is once they are no longer
+	// being retried.
+	if err == c.err {
+		return c.err
+	}
+	if err != nil {
+		c.reportLimit("/call", err)
+		c.err.reset()
+		c.err.CallError = err
+		return c.err
+	}
+	return nil
+}
+
+// ConstructionCombine calls the /construction/combine endpoint.
+//
+// Calls /construction/combine.
+//
+// Makes a request to /construction/combine.
+func (c *Client) ConstructionCombine(
+	ctx context.Context, req *ConstructionCombineRequest, resp *ConstructionCombineResponse, retry retry.Handler,
+) *ClientError {
+	if len(c.netjson) == 0 {
+		c.err.reset()
+		c.err.CallError = errors.New(
+			"api: the SetNetwork method must be called before making a Client.ConstructionCombine call",
+		)
+		return c.err
+	}
+	c.req = req.EncodeJSON(c.req[:0], c.netjson)
+	if len(c.middleware) > 0 {
+		return c.intercept(ctx, "/construction/combine", resp, func(ctx context.Context, inv *Invocation) *ClientError {
+			return c.doConstructionCombine(ctx, inv, resp, retry)
+		})
+	}
+	return c.doConstructionCombine(ctx, nil, resp, retry)
+}
+
+func (c *Client) doConstructionCombine(
+	ctx context.Context, inv *Invocation, resp *ConstructionCombineResponse, retry retry.Handler,
+) *ClientError {
+	it := retry.WithClassifier(classifyError).Iter()
+	var (
+		err   error
+		hresp *http.Response
+	)
+	for it.NextContext(ctx) {
+		if err = c.backend.acquire(ctx, "/construction/combine"); err != nil {
+			c.reportAttempt(inv, "/construction/combine", it.Record(err))
+			continue
+		}
+		hresp, err = c.post(ctx, "/construction/combine")
+		if err != nil {
+			c.record(inv, "/construction/combine", &it, err)
+			continue
+		}
+		inv.observe(hresp)
+		switch hresp.StatusCode {
+		case 200:
+			err = c.dec.ResetFromReadCloser(hresp.Body)
+			if err == nil {
+				resp.Reset()
+				err = resp.DecodeJSON(c.dec)
+			}
+			if err == nil {
+				err = c.dec.End()
+			}
+			if err == nil {
+				c.reportFindings("/construction/combine")
+				c.record(inv, "/construction/combine", &it, nil)
+				return nil
+			}
+		case 500:
+			c.err.reset()
+			err = c.dec.ResetFromReadCloser(hresp.Body)
+			if err == nil {
+				err = c.err.RosettaError.DecodeJSON(c.dec)
+			}
+			if err == nil {
+				err = c.dec.End()
+			}
+			if err == nil {
+				c.reportFindings("/construction/combine")
+				err = c.err
+			}
+		default:
+			err = newStatusError("/construction/combine", hresp)
+		}
+		c.record(inv, "/construction/combine", &it, err)
+	}
+	// NOTE(tav): The context may have been done before the first attempt.
+	if err == nil {
+		err = ctx.Err()
+	}
+	// NOTE(tav): Rosetta errors are returned as is once they are no longer
+	// being retried.
+	if err == c.err {
+		return c.err
+	}
+	if err != nil {
+		c.reportLimit("/construction/combine", err)
+		c.err.reset()
+		c.err.CallError = err
+		return c.err
+	}
+	return nil
+}
+
+// ConstructionDerive calls the /construction/derive endpoint.
+//
+// Calls /construction/derive.
+//
+// Makes a request to /construction/derive.
+func (c *Client) ConstructionDerive(
+	ctx context.Context, req *ConstructionDeriveRequest, resp *ConstructionDeriveResponse, retry retry.Handler,
+) *ClientError {
+	if len(c.netjson) == 0 {
+		c.err.reset()
+		c.err.CallError = errors.New(
+			"api: the SetNetwork method must be called before making a Client.ConstructionDerive call",
+		)
+		return c.err
+	}
+	c.req = req.EncodeJSON(c.req[:0], c.netjson)
+	if len(c.middleware) > 0 {
+		return c.intercept(ctx, "/construction/derive", resp, func(ctx context.Context, inv *Invocation) *ClientError {
+			return c.doConstructionDerive(ctx, inv, resp, retry)
+		})
+	}
+	return c.doConstructionDerive(ctx, nil, resp, retry)
+}
+
+func (c *Client) doConstructionDerive(
+	ctx context.Context, inv *Invocation, resp *ConstructionDeriveResponse, retry retry.Handler,
+) *ClientError {
+	it := retry.WithClassifier(classifyError).Iter()
+	var (
+		err   error
+		hresp *http.Response
+	)
+	for it.NextContext(ctx) {
+		if err = c.backend.acquire(ctx, "/construction/derive"); err != nil {
+			c.reportAttempt(inv, "/construction/derive", it.Record(err))
+			continue
+		}
+		hresp, err = c.post(ctx, "/construction/derive")
+		if err != nil {
+			c.record(inv, "/construction/derive", &it, err)
+			continue
+		}
+		inv.observe(hresp)
+		switch hresp.StatusCode {
+		case 200:
+			err = c.dec.ResetFromReadCloser(hresp.Body)
+			if err == nil {
+				resp.Reset()
+				err = resp.DecodeJSON(c.dec)
+			}
+			if err == nil {
+				err = c.dec.End()
+			}
+			if err == nil {
+				c.reportFindings("/construction/derive")
+				c.record(inv, "/construction/derive", &it, nil)
+				return nil
+			}
+		case 500:
+			c.err.reset()
+			err = c.dec.ResetFromReadCloser(hresp.Body)
+			if err == nil {
+				err = c.err.RosettaError.DecodeJSON(c.dec)
+			}
+			if err == nil {
+				err = c.dec.End()
+			}
+			if err == nil {
+				c.reportFindings("/construction/derive")
+				err = c.err
+			}
+		default:
+			err = newStatusError("/construction/derive", hresp)
+		}
+		c.record(inv, "/construction/derive", &it, err)
+	}
+	// NOTE(tav): The context may have been done before the first attempt.
+	if err == nil {
+		err = ctx.Err()
+	}
+	// NOTE(tav): Rosetta errors are returned as is once they are no longer
+	// being retried.
+	if err == c.err {
+		return c.err
+	}
+	if err != nil {
+		c.reportLimit("/construction/derive", err)
+		c.err.reset()
+		c.err.CallError = err
+		return c.err
+	}
+	return nil
+}
+
+// ConstructionHash calls the /construction/hash endpoint.
+//
+// Calls /construction/hash.
+//
+// Makes a request to /construction/hash.
+func (c *Client) ConstructionHash(
+	ctx context.Context, req *ConstructionHashRequest, resp *TransactionIdentifierResponse, retry retry.Handler,
+) *ClientError {
+	if len(c.netjson) == 0 {
+		c.err.reset()
+		c.err.CallError = errors.New(
+			"api: the SetNetwork method must be called before making a Client.ConstructionHash call",
+		)
+		return c.err
+	}
+	c.req = req.EncodeJSON(c.req[:0], c.netjson)
+	if len(c.middleware) > 0 {
+		return c.intercept(ctx, "/construction/hash", resp, func(ctx context.Context, inv *Invocation) *ClientError {
+			return c.doConstructionHash(ctx, inv, resp, retry)
+		})
+	}
+	return c.doConstructionHash(ctx, nil, resp, retry)
+}
+
+func (c *Client) doConstructionHash(
+	ctx context.Context, inv *Invocation, resp *TransactionIdentifierResponse, retry retry.Handler,
+) *ClientError {
+	it := retry.WithClassifier(classifyError).Iter()
+	var (
+		err   error
+		hresp *http.Response
+	)
+	for it.NextContext(ctx) {
+		if err = c.backend.acquire(ctx, "/construction/hash"); err != nil {
+			c.reportAttempt(inv, "/construction/hash", it.Record(err))
+			continue
+		}
+		hresp, err = c.post(ctx, "/construction/hash")
+		if err != nil {
+			c.record(inv, "/construction/hash", &it, err)
+			continue
+		}
+		inv.observe(hresp)
+		switch hresp.StatusCode {
+		case 200:
+			err = c.dec.ResetFromReadCloser(hresp.Body)
+			if err == nil {
+				resp.Reset()
+				err = resp.DecodeJSON(c.dec)
+			}
+			if err == nil {
+				err = c.dec.End()
+			}
+			if err == nil {
+				c.reportFindings("/construction/hash")
+				c.record(inv, "/construction/hash", &it, nil)
+				return nil
+			}
+		case 500:
+			c.err.reset()
+			err = c.dec.ResetFromReadCloser(hresp.Body)
+			if err == nil {
+				err = c.err.RosettaError.DecodeJSON(c.dec)
+			}
+			if err == nil {
+				err = c.dec.End()
+			}
+			if err == nil {
+				c.reportFindings("/construction/hash")
+				err = c.err
+			}
+		default:
+			err = newStatusError("/construction/hash", hresp)
+		}
+		c.record(inv, "/construction/hash", &it, err)
+	}
+	// NOTE(tav): The context may have been done before the first attempt.
+	if err == nil {
+		err = ctx.Err()
+	}
+	// NOTE(tav): Rosetta errors are returned as is once they are no longer
+	// being retried.
+	if err == c.err {
+		return c.err
+	}
+	if err != nil {
+		c.reportLimit("/construction/hash", err)
+		c.err.reset()
+		c.err.CallError = err
+		return c.err
+	}
+	return nil
+}
+
+// ConstructionMetadata calls the /construction/metadata endpoint.
+//
+// Calls /construction/metadata.
+//
+// Makes a request to /construction/metadata.
+func (c *Client) ConstructionMetadata(
+	ctx context.Context, req *ConstructionMetadataRequest, resp *ConstructionMetadataResponse, retry retry.Handler,
+) *ClientError {
+	if len(c.netjson) == 0 {
+		c.err.reset()
+		c.err.CallError = errors.New(
+			"api: the SetNetwork method must be called before making a Client.ConstructionMetadata call",
+		)
+		return c.err
+	}
+	c.req = req.EncodeJSON(c.req[:0], c.netjson)
+	if len(c.middleware) > 0 {
+		return c.intercept(ctx, "/construction/metadata", resp, func(ctx context.Context, inv *Invocation) *ClientError {
+			return c.doConstructionMetadata(ctx, inv, resp, retry)
+		})
+	}
+	return c.doConstructionMetadata(ctx, nil, resp, retry)
+}
+
+func (c *Client) doConstructionMetadata(
+	ctx context.Context, inv *Invocation, resp *ConstructionMetadataResponse, retry retry.Handler,
+) *ClientError {
+	it := retry.WithClassifier(classifyError).Iter()
+	var (
+		err   error
+		hresp *http.Response
+	)
+	for it.NextContext(ctx) {
+		if err = c.backend.acquire(ctx, "/construction/metadata"); err != nil {
+			c.reportAttempt(inv, "/construction/metadata", it.Record(err))
+			continue
+		}
+		hresp, err = c.post(ctx, "/construction/metadata")
+		if err != nil {
+			c.record(inv, "/construction/metadata", &it, err)
+			continue
+		}
+		inv.observe(hresp)
+		switch hresp.StatusCode {
+		case 200:
+			err = c.dec.ResetFromReadCloser(hresp.Body)
+			if err == nil {
+				resp.Reset()
+				err = resp.DecodeJSON(c.dec)
+			}
+			if err == nil {
+				err = c.dec.End()
+			}
+			if err == nil {
+				c.reportFindings("/construction/metadata")
+				c.record(inv, "/construction/metadata", &it, nil)
+				return nil
+			}
+		case 500:
+			c.err.reset()
+			err = c.dec.ResetFromReadCloser(hresp.Body)
+			if err == nil {
+				err = c.err.RosettaError.DecodeJSON(c.dec)
+			}
+			if err == nil {
+				err = c.dec.End()
+			}
+			if err == nil {
+				c.reportFindings("/construction/metadata")
+				err = c.err
+			}
+		default:
+			err = newStatusError("/construction/metadata", hresp)
+		}
+		c.record(inv, "/construction/metadata", &it, err)
+	}
+	// NOTE(tav): The context may have been done before the first attempt.
+	if err == nil {
+		err = ctx.Err()
+	}
+	// NOTE(tav): Rosetta errors are returned as is once they are no longer
+	// being retried.
+	if err == c.err {
+		return c.err
+	}
+	if err != nil {
+		c.reportLimit("/construction/metadata", err)
+		c.err.reset()
+		c.err.CallError = err
+		return c.err
+	}
+	return nil
+}
+
+// ConstructionParse calls the /construction/parse endpoint.
+//
+// Calls /construction/parse.
+//
+// Makes a request to /construction/parse.
+func (c *Client) ConstructionParse(
+	ctx context.Context, req *ConstructionParseRequest, resp *ConstructionParseResponse, retry retry.Handler,
+) *ClientError {
+	if len(c.netjson) == 0 {
+		c.err.reset()
+		c.err.CallError = errors.New(
+			"api: the SetNetwork method must be called before making a Client.ConstructionParse call",
+		)
+		return c.err
+	}
+	c.req = req.EncodeJSON(c.req[:0], c.netjson)
+	if len(c.middleware) > 0 {
+		return c.intercept(ctx, "/construction/parse", resp, func(ctx context.Context, inv *Invocation) *ClientError {
+			return c.doConstructionParse(ctx, inv, resp, retry)
+		})
+	}
+	return c.doConstructionParse(ctx, nil, resp, retry)
+}
+
+func (c *Client) doConstructionParse(
+	ctx context.Context, inv *Invocation, resp *ConstructionParseResponse, retry retry.Handler,
+) *ClientError {
+	it := retry.WithClassifier(classifyError).Iter()
+	var (
+		err   error
+		hresp *http.Response
+	)
+	for it.NextContext(ctx) {
+		if err = c.backend.acquire(ctx, "/construction/parse"); err != nil {
+			c.reportAttempt(inv, "/construction/parse", it.Record(err))
+			continue
+		}
+		hresp, err = c.post(ctx, "/construction/parse")
+		if err != nil {
+			c.record(inv, "/construction/parse", &it, err)
+			continue
+		}
+		inv.observe(hresp)
+		switch hresp.StatusCode {
+		case 200:
+			err = c.dec.ResetFromReadCloser(hresp.Body)
+			if err == nil {
+				resp.Reset()
+				err = resp.DecodeJSON(c.dec)
+			}
+			if err == nil {
+				err = c.dec.End()
+			}
+			if err == nil {
+				c.reportFindings("/construction/parse")
+				c.record(inv, "/construction/parse", &it, nil)
+				return nil
+			}
+		case 500:
+			c.err.reset()
+			err = c.dec.ResetFromReadCloser(hresp.Body)
+			if err == nil {
+				err = c.err.RosettaError.DecodeJSON(c.dec)
+			}
+			if err == nil {
+				err = c.dec.End()
+			}
+			if err == nil {
+				c.reportFindings("/construction/parse")
+				err = c.err
+			}
+		default:
+			err = newStatusError("/construction/parse", hresp)
+		}
+		c.record(inv, "/construction/parse", &it, err)
+	}
+	// NOTE(tav): The context may have been done before the first attempt.
+	if err == nil {
+		err = ctx.Err()
+	}
+	// NOTE(tav): Rosetta errors are returned as is once they are no longer
+	// being retried.
+	if err == c.err {
+		return c.err
+	}
+	if err != nil {
+		c.reportLimit("/construction/parse", err)
+		c.err.reset()
+		c.err.CallError = err
+		return c.err
+	}
+	return nil
+}
+
+// ConstructionPayloads calls the /construction/payloads endpoint.
+//
+// Calls /construction/payloads.
+//
+// Makes a request to /construction/payloads.
+func (c *Client) ConstructionPayloads(
+	ctx context.Context, req *ConstructionPayloadsRequest, resp *ConstructionPayloadsResponse, retry retry.Handler,
+) *ClientError {
+	if len(c.netjson) == 0 {
+		c.err.reset()
+		c.err.CallError = errors.New(
+			"api: the SetNetwork method must be called before making a Client.ConstructionPayloads call",
+		)
+		return c.err
+	}
+	c.req = req.EncodeJSON(c.req[:0], c.netjson)
+	if len(c.middleware) > 0 {
+		return c.intercept(ctx, "/construction/payloads", resp, func(ctx context.Context, inv *Invocation) *ClientError {
+			return c.doConstructionPayloads(ctx, inv, resp, retry)
+		})
+	}
+	return c.doConstructionPayloads(ctx, nil, resp, retry)
+}
+
+func (c *Client) doConstructionPayloads(
+	ctx context.Context, inv *Invocation, resp *ConstructionPayloadsResponse, retry retry.Handler,
+) *ClientError {
+	it := retry.WithClassifier(classifyError).Iter()
+	var (
+		err   error
+		hresp *http.Response
+	)
+	for it.NextContext(ctx) {
+		if err = c.backend.acquire(ctx, "/construction/payloads"); err != nil {
+			c.reportAttempt(inv, "/construction/payloads", it.Record(err))
+			continue
+		}
+		hresp, err = c.post(ctx, "/construction/payloads")
+		if err != nil {
+			c.record(inv, "/construction/payloads", &it, err)
+			continue
+		}
+		inv.observe(hresp)
+		switch hresp.StatusCode {
+		case 200:
+			err = c.dec.ResetFromReadCloser(hresp.Body)
+			if err == nil {
+				resp.Reset()
+				err = resp.DecodeJSON(c.dec)
+			}
+			if err == nil {
+				err = c.dec.End()
+			}
+			if err == nil {
+				c.reportFindings("/construction/payloads")
+				c.record(inv, "/construction/payloads", &it, nil)
+				return nil
+			}
+		case 500:
+			c.err.reset()
+			err = c.dec.ResetFromReadCloser(hresp.Body)
+			if err == nil {
+				err = c.err.RosettaError.DecodeJSON(c.dec)
+			}
+			if err == nil {
+				err = c.dec.End()
+			}
+			if err == nil {
+				c.reportFindings("/construction/payloads")
+				err = c.err
+			}
+		default:
+			err = newStatusError("/construction/payloads", hresp)
+		}
+		c.record(inv, "/construction/payloads", &it, err)
+	}
+	// NOTE(tav): The context may have been done before the first attempt.
+	if err == nil {
+		err = ctx.Err()
+	}
+	// NOTE(tav): Rosetta errors are returned as is once they are no longer
+	// being retried.
+	if err == c.err {
+		return c.err
+	}
+	if err != nil {
+		c.reportLimit("/construction/payloads", err)
+		c.err.reset()
+		c.err.CallError = err
+		return c.err
+	}
+	return nil
+}
+
+// ConstructionPreprocess calls the /construction/preprocess endpoint.
+//
+// Calls /construction/preprocess.
+//
+// Makes a request to /construction/preprocess.
+func (c *Client) ConstructionPreprocess(
+	ctx context.Context, req *ConstructionPreprocessRequest, resp *ConstructionPreprocessResponse, retry retry.Handler,
+) *ClientError {
+	if len(c.netjson) == 0 {
+		c.err.reset()
+		c.err.CallError = errors.New(
+			"api: the SetNetwork method must be called before making a Client.ConstructionPreprocess call",
+		)
+		return c.err
+	}
+	c.req = req.EncodeJSON(c.req[:0], c.netjson)
+	if len(c.middleware) > 0 {
+		return c.intercept(ctx, "/construction/preprocess", resp, func(ctx context.Context, inv *Invocation) *ClientError {
+			return c.doConstructionPreprocess(ctx, inv, resp, retry)
+		})
+	}
+	return c.doConstructionPreprocess(ctx, nil, resp, retry)
+}
+
+func (c *Client) doConstructionPreprocess(
+	ctx context.Context, inv *Invocation, resp *ConstructionPreprocessResponse, retry retry.Handler,
+) *ClientError {
+	it := retry.WithClassifier(classifyError).Iter()
+	var (
+		err   error
+		hresp *http.Response
+	)
+	for it.NextContext(ctx) {
+		if err = c.backend.acquire(ctx, "/construction/preprocess"); err != nil {
+			c.reportAttempt(inv, "/construction/preprocess", it.Record(err))
+			continue
+		}
+		hresp, err = c.post(ctx, "/construction/preprocess")
+		if err != nil {
+			c.record(inv, "/construction/preprocess", &it, err)
+			continue
+		}
+		inv.observe(hresp)
+		switch hresp.StatusCode {
+		case 200:
+			err = c.dec.ResetFromReadCloser(hresp.Body)
+			if err == nil {
+				resp.Reset()
+				err = resp.DecodeJSON(c.dec)
+			}
+			if err == nil {
+				err = c.dec.End()
+			}
+			if err == nil {
+				c.reportFindings("/construction/preprocess")
+				c.record(inv, "/construction/preprocess", &it, nil)
+				return nil
+			}
+		case 500:
+			c.err.reset()
+			err = c.dec.ResetFromReadCloser(hresp.Body)
+			if err == nil {
+				err = c.err.RosettaError.DecodeJSON(c.dec)
+			}
+			if err == nil {
+				err = c.dec.End()
+			}
+			if err == nil {
+				c.reportFindings("/construction/preprocess")
+				err = c.err
+			}
+		default:
+			err = newStatusError("/construction/preprocess", hresp)
+		}
+		c.record(inv, "/construction/preprocess", &it, err)
+	}
+	// NOTE(tav): The context may have been done before the first attempt.
+	if err == nil {
+		err = ctx.Err()
+	}
+	// NOTE(tav): Rosetta errors are returned as is once they are no longer
+	// being retried.
+	if err == c.err {
+		return c.err
+	}
+	if err != nil {
+		c.reportLimit("/construction/preprocess", err)
+		c.err.reset()
+		c.err.CallError = err
+		return c.err
+	}
+	return nil
+}
+
+// ConstructionSubmit calls the /construction/submit endpoint.
+//
+// Calls /construction/submit.
+//
+// Makes a request to /construction/submit.
+func (c *Client) ConstructionSubmit(
+	ctx context.Context, req *ConstructionSubmitRequest, resp *TransactionIdentifierResponse, retry retry.Handler,
+) *ClientError {
+	if len(c.netjson) == 0 {
+		c.err.reset()
+		c.err.CallError = errors.New(
+			"api: the SetNetwork method must be called before making a Client.ConstructionSubmit call",
+		)
+		return c.err
+	}
+	c.req = req.EncodeJSON(c.req[:0], c.netjson)
+	if len(c.middleware) > 0 {
+		return c.intercept(ctx, "/construction/submit", resp, func(ctx context.Context, inv *Invocation) *ClientError {
+			return c.doConstructionSubmit(ctx, inv, resp, retry)
+		})
+	}
+	return c.doConstructionSubmit(ctx, nil, resp, retry)
+}
+
+func (c *Client) doConstructionSubmit(
+	ctx context.Context, inv *Invocation, resp *TransactionIdentifierResponse, retry retry.Handler,
+) *ClientError {
+	it := retry.WithClassifier(classifyError).Iter()
+	var (
+		err   error
+		hresp *http.Response
+	)
+	for it.NextContext(ctx) {
+		if err = c.backend.acquire(ctx, "/construction/submit"); err != nil {
+			c.reportAttempt(inv, "/construction/submit", it.Record(err))
+			continue
+		}
+		hresp, err = c.post(ctx, "/construction/submit")
+		if err != nil {
+			c.record(inv, "/construction/submit", &it, err)
+			continue
+		}
+		inv.observe(hresp)
+		switch hresp.StatusCode {
+		case 200:
+			err = c.dec.ResetFromReadCloser(hresp.Body)
+			if err == nil {
+				resp.Reset()
+				err = resp.DecodeJSON(c.dec)
+			}
+			if err == nil {
+				err = c.dec.End()
+			}
+			if err == nil {
+				c.reportFindings("/construction/submit")
+				c.record(inv, "/construction/submit", &it, nil)
+				return nil
+			}
+		case 500:
+			c.err.reset()
+			err = c.dec.ResetFromReadCloser(hresp.Body)
+			if err == nil {
+				err = c.err.RosettaError.DecodeJSON(c.dec)
+			}
+			if err == nil {
+				err = c.dec.End()
+			}
+			if err == nil {
+				c.reportFindings("/construction/submit")
+				err = c.err
+			}
+		default:
+			err = newStatusError("/construction/submit", hresp)
+		}
+		c.record(inv, "/construction/submit", &it, err)
+	}
+	// NOTE(tav): The context may have been done before the first attempt.
+	if err == nil {
+		err = ctx.Err()
+	}
+	// NOTE(tav): Rosetta errors are returned as is once they are no longer
+	// being retried.
+	if err == c.err {
+		return c.err
+	}
+	if err != nil {
+		c.reportLimit("/construction/submit", err)
+		c.err.reset()
+		c.err.CallError = err
+		return c.err
+	}
+	return nil
+}
+
+// EventsBlocks calls the /events/blocks endpoint.
+//
+// Calls /events/blocks.
+//
+// Makes a request to /events/blocks.
+func (c *Client) EventsBlocks(
+	ctx context.Context, req *EventsBlocksRequest, resp *EventsBlocksResponse, retry retry.Handler,
+) *ClientError {
+	if len(c.netjson) == 0 {
+		c.err.reset()
+		c.err.CallError = errors.New(
+			"api: the SetNetwork method must be called before making a Client.EventsBlocks call",
+		)
+		return c.err
+	}
+	c.req = req.EncodeJSON(c.req[:0], c.netjson)
+	if len(c.middleware) > 0 {
+		return c.intercept(ctx, "/events/blocks", resp, func(ctx context.Context, inv *Invocation) *ClientError {
+			return c.doEventsBlocks(ctx, inv, resp, retry)
+		})
+	}
+	return c.doEventsBlocks(ctx, nil, resp, retry)
+}
+
+func (c *Client) doEventsBlocks(
+	ctx context.Context, inv *Invocation, resp *EventsBlocksResponse, retry retry.Handler,
+) *ClientError {
+	it := retry.WithClassifier(classifyError).Iter()
+	var (
+		err   error
+		hresp *http.Response
+	)
+	for it.NextContext(ctx) {
+		if err = c.backend.acquire(ctx, "/events/blocks"); err != nil {
+			c.reportAttempt(inv, "/events/blocks", it.Record(err))
+			continue
+		}
+		hresp, err = c.post(ctx, "/events/blocks")
+		if err != nil {
+			c.record(inv, "/events/blocks", &it, err)
+			continue
+		}
+		inv.observe(hresp)
+		switch hresp.StatusCode {
+		case 200:
+			err = c.dec.ResetFromReadCloser(hresp.Body)
+			if err == nil {
+				resp.Reset()
+				err = resp.DecodeJSON(c.dec)
+			}
+			if err == nil {
+				err = c.dec.End()
+			}
+			if err == nil {
+				c.reportFindings("/events/blocks")
+				c.record(inv, "/events/blocks", &it, nil)
+				return nil
+			}
+		case 500:
+			c.err.reset()
+			err = c.dec.ResetFromReadCloser(hresp.Body)
+			if err == nil {
+				err = c.err.RosettaError.DecodeJSON(c.dec)
+			}
+			if err == nil {
+				err = c.dec.End()
+			}
+			if err == nil {
+				c.reportFindings("/events/blocks")
+				err = c.err
+			}
+		default:
+			err = newStatusError("/events/blocks", hresp)
+		}
+		c.record(inv, "/events/blocks", &it, err)
+	}
+	// NOTE(tav): The context may have been done before the first attempt.
+	if err == nil {
+		err = ctx.Err()
+	}
+	// NOTE(tav): Rosetta errors are returned as is once they are no longer
+	// being retried.
+	if err == c.err {
+		return c.err
+	}
+	if err != nil {
+		c.reportLimit("/events/blocks", err)
+		c.err.reset()
+		c.err.CallError = err
+		return c.err
+	}
+	return nil
+}
+
+// Mempool calls the /mempool endpoint.
+//
+// Calls /mempool.
+//
+// Makes a request to /mempool.
+func (c *Client) Mempool(
+	ctx context.Context, req *NetworkRequest, resp *MempoolResponse, retry retry.Handler,
+) *ClientError {
+	if len(c.netjson) == 0 {
+		c.err.reset()
+		c.err.CallError = errors.New(
+			"api: the SetNetwork method must be called before making a Client.Mempool call",
+		)
+		return c.err
+	}
+	c.req = req.EncodeJSON(c.req[:0], c.netjson)
+	if len(c.middleware) > 0 {
+		return c.intercept(ctx, "/mempool", resp, func(ctx context.Context, inv *Invocation) *ClientError {
+			return c.doMempool(ctx, inv, resp, retry)
+		})
+	}
+	return c.doMempool(ctx, nil, resp, retry)
+}
+
+func (c *Client) doMempool(
+	ctx context.Context, inv *Invocation, resp *MempoolResponse, retry retry.Handler,
+) *ClientError {
+	it := retry.WithClassifier(classifyError).Iter()
+	var (
+		err   error
+		hresp *http.Response
+	)
+	for it.NextContext(ctx) {
+		if err = c.backend.acquire(ctx, "/mempool"); err != nil {
+			c.reportAttempt(inv, "/mempool", it.Record(err))
+			continue
+		}
+		hresp, err = c.post(ctx, "/mempool")
+		if err != nil {
+			c.record(inv, "/mempool", &it, err)
+			continue
+		}
+		inv.observe(hresp)
+		switch hresp.StatusCode {
+		case 200:
+			err = c.dec.ResetFromReadCloser(hresp.Body)
+			if err == nil {
+				resp.Reset()
+				err = resp.DecodeJSON(c.dec)
+			}
+			if err == nil {
+				err = c.dec.End()
+			}
+			if err == nil {
+				c.reportFindings("/mempool")
+				c.record(inv, "/mempool", &it, nil)
+				return nil
+			}
+		case 500:
+			c.err.reset()
+			err = c.dec.ResetFromReadCloser(hresp.Body)
+			if err == nil {
+				err = c.err.RosettaError.DecodeJSON(c.dec)
+			}
+			if err == nil {
+				err = c.dec.End()
+			}
+			if err == nil {
+				c.reportFindings("/mempool")
+				err = c.err
+			}
+		default:
+			err = newStatusError("/mempool", hresp)
+		}
+		c.record(inv, "/mempool", &it, err)
+	}
+	// NOTE(tav): The context may have been done before the first attempt.
+	if err == nil {
+		err = ctx.Err()
+	}
+	// NOTE(tav): Rosetta errors are returned as is once they are no longer
+	// being retried.
+	if err == c.err {
+		return c.err
+	}
+	if err != nil {
+		c.reportLimit("/mempool", err)
+		c.err.reset()
+		c.err.CallError = err
+		return c.err
+	}
+	return nil
+}
+
+// MempoolTransaction calls the /mempool/transaction endpoint.
+//
+// Calls /mempool/transaction.
+//
+// Makes a request to /mempool/transaction.
+func (c *Client) MempoolTransaction(
+	ctx context.Context, req *MempoolTransactionRequest, resp *MempoolTransactionResponse, retry retry.Handler,
+) *ClientError {
+	if len(c.netjson) == 0 {
+		c.err.reset()
+		c.err.CallError = errors.New(
+			"api: the SetNetwork method must be called before making a Client.MempoolTransaction call",
+		)
+		return c.err
+	}
+	c.req = req.EncodeJSON(c.req[:0], c.netjson)
+	if len(c.middleware) > 0 {
+		return c.intercept(ctx, "/mempool/transaction", resp, func(ctx context.Context, inv *Invocation) *ClientError {
+			return c.doMempoolTransaction(ctx, inv, resp, retry)
+		})
+	}
+	return c.doMempoolTransaction(ctx, nil, resp, retry)
+}
+
+func (c *Client) doMempoolTransaction(
+	ctx context.Context, inv *Invocation, resp *MempoolTransactionResponse, retry retry.Handler,
+) *ClientError {
+	it := retry.WithClassifier(classifyError).Iter()
+	var (
+		err   error
+		hresp *http.Response
+	)
+	for it.NextContext(ctx) {
+		if err = c.backend.acquire(ctx, "/mempool/transaction"); err != nil {
+			c.reportAttempt(inv, "/mempool/transaction", it.Record(err))
+			continue
+		}
+		hresp, err = c.post(ctx, "/mempool/transaction")
+		if err != nil {
+			c.record(inv, "/mempool/transaction", &it, err)
+			continue
+		}
+		inv.observe(hresp)
+		switch hresp.StatusCode {
+		case 200:
+			err = c.dec.ResetFromReadCloser(hresp.Body)
+			if err == nil {
+				resp.Reset()
+				err = resp.DecodeJSON(c.dec)
+			}
+			if err == nil {
+				err = c.dec.End()
+			}
+			if err == nil {
+				c.reportFindings("/mempool/transaction")
+				c.record(inv, "/mempool/transaction", &it, nil)
+				return nil
+			}
+		case 500:
+			c.err.reset()
+			err = c.dec.ResetFromReadCloser(hresp.Body)
+			if err == nil {
+				err = c.err.RosettaError.DecodeJSON(c.dec)
+			}
+			if err == nil {
+				err = c.dec.End()
+			}
+			if err == nil {
+				c.reportFindings("/mempool/transaction")
+				err = c.err
+			}
+		default:
+			err = newStatusError("/mempool/transaction", hresp)
+		}
+		c.record(inv, "/mempool/transaction", &it, err)
+	}
+	// NOTE(tav): The context may have been done before the first attempt.
+	if err == nil {
+		err = ctx.Err()
+	}
+	// NOTE(tav): Rosetta errors are returned as is once they are no longer
+	// being retried.
+	if err == c.err {
+		return c.err
+	}
+	if err != nil {
+		c.reportLimit("/mempool/transaction", err)
+		c.err.reset()
+		c.err.CallError = err
+		return c.err
+	}
+	return nil
+}
+
+// NetworkList calls the /network/list endpoint.
+//
+// Calls /network/list.
+//
+// Makes a request to /network/list.
+func (c *Client) NetworkList(
+	ctx context.Context, req *MetadataRequest, resp *NetworkListResponse, retry retry.Handler,
+) *ClientError {
+	if len(c.netjson) == 0 {
+		c.err.reset()
+		c.err.CallError = errors.New(
+			"api: the SetNetwork method must be called before making a Client.NetworkList call",
+		)
+		return c.err
+	}
+	c.req = req.EncodeJSON(c.req[:0])
+	if len(c.middleware) > 0 {
+		return c.intercept(ctx, "/network/list", resp, func(ctx context.Context, inv *Invocation) *ClientError {
+			return c.doNetworkList(ctx, inv, resp, retry)
+		})
+	}
+	return c.doNetworkList(ctx, nil, resp, retry)
+}
+
+func (c *Client) doNetworkList(
+	ctx context.Context, inv *Invocation, resp *NetworkListResponse, retry retry.Handler,
+) *ClientError {
+	it := retry.WithClassifier(classifyError).Iter()
+	var (
+		err   error
+		hresp *http.Response
+	)
+	for it.NextContext(ctx) {
+		if err = c.backend.acquire(ctx, "/network/list"); err != nil {
+			c.reportAttempt(inv, "/network/list", it.Record(err))
+			continue
+		}
+		hresp, err = c.post(ctx, "/network/list")
+		if err != nil {
+			c.record(inv, "/network/list", &it, err)
+			continue
+		}
+		inv.observe(hresp)
+		switch hresp.StatusCode {
+		case 200:
+			err = c.dec.ResetFromReadCloser(hresp.Body)
+			if err == nil {
+				resp.Reset()
+				err = resp.DecodeJSON(c.dec)
+			}
+			if err == nil {
+				err = c.dec.End()
+			}
+			if err == nil {
+				c.reportFindings("/network/list")
+				c.record(inv, "/network/list", &it, nil)
+				return nil
+			}
+		case 500:
+			c.err.reset()
+			err = c.dec.ResetFromReadCloser(hresp.Body)
+			if err == nil {
+				err = c.err.RosettaError.DecodeJSON(c.dec)
+			}
+			if err == nil {
+				err = c.dec.End()
+			}
+			if err == nil {
+				c.reportFindings("/network/list")
+				err = c.err
+			}
+		default:
+			err = newStatusError("/network/list", hresp)
+		}
+		c.record(inv, "/network/list", &it, err)
+	}
+	// NOTE(tav): The context may have been done before the first attempt.
+	if err == nil {
+		err = ctx.Err()
+	}
+	// NOTE(tav): Rosetta errors are returned as is once they are no longer
+	// being retried.
+	if err == c.err {
+		return c.err
+	}
+	if err != nil {
+		c.reportLimit("/network/list", err)
+		c.err.reset()
+		c.err.CallError = err
+		return c.err
+	}
+	return nil
+}
+
+// NetworkOptions calls the /network/options endpoint.
+//
+// Calls /network/options.
+//
+// Makes a request to /network/options.
+func (c *Client) NetworkOptions(
+	ctx context.Context, req *NetworkRequest, resp *NetworkOptionsResponse, retry retry.Handler,
+) *ClientError {
+	if len(c.netjson) == 0 {
+		c.err.reset()
+		c.err.CallError = errors.New(
+			"api: the SetNetwork method must be called before making a Client.NetworkOptions call",
+		)
+		return c.err
+	}
+	c.req = req.EncodeJSON(c.req[:0], c.netjson)
+	if len(c.middleware) > 0 {
+		return c.intercept(ctx, "/network/options", resp, func(ctx context.Context, inv *Invocation) *ClientError {
+			return c.doNetworkOptions(ctx, inv, resp, retry)
+		})
+	}
+	return c.doNetworkOptions(ctx, nil, resp, retry)
+}
+
+func (c *Client) doNetworkOptions(
+	ctx context.Context, inv *Invocation, resp *NetworkOptionsResponse, retry retry.Handler,
+) *ClientError {
+	it := retry.WithClassifier(classifyError).Iter()
+	var (
+		err   error
+		hresp *http.Response
+	)
+	for it.NextContext(ctx) {
+		if err = c.backend.acquire(ctx, "/network/options"); err != nil {
+			c.reportAttempt(inv, "/network/options", it.Record(err))
+			continue
+		}
+		hresp, err = c.post(ctx, "/network/options")
+		if err != nil {
+			c.record(inv, "/network/options", &it, err)
+			continue
+		}
+		inv.observe(hresp)
+		switch hresp.StatusCode {
+		case 200:
+			err = c.dec.ResetFromReadCloser(hresp.Body)
+			if err == nil {
+				resp.Reset()
+				err = resp.DecodeJSON(c.dec)
+			}
+			if err == nil {
+				err = c.dec.End()
+			}
+			if err == nil {
+				c.reportFindings("/network/options")
+				c.record(inv, "/network/options", &it, nil)
+				return nil
+			}
+		case 500:
+			c.err.reset()
+			err = c.dec.ResetFromReadCloser(hresp.Body)
+			if err == nil {
+				err = c.err.RosettaError.DecodeJSON(c.dec)
+			}
+			if err == nil {
+				err = c.dec.End()
+			}
+			if err == nil {
+				c.reportFindings("/network/options")
+				err = c.err
+			}
+		default:
+			err = newStatusError("/network/options", hresp)
+		}
+		c.record(inv, "/network/options", &it, err)
+	}
+	// NOTE(tav): The context may have been done before the first attempt.
+	if err == nil {
+		err = ctx.Err()
+	}
+	// NOTE(tav): Rosetta errors are returned as is once they are no longer
+	// being retried.
+	if err == c.err {
+		return c.err
+	}
+	if err != nil {
+		c.reportLimit("/network/options", err)
+		c.err.reset()
+		c.err.CallError = err
+		return c.err
+	}
+	return nil
+}
+
+// NetworkStatus calls the /network/status endpoint.
+//
+// Calls /network/status.
+//
+// Makes a request to /network/status.
+func (c *Client) NetworkStatus(
+	ctx context.Context, req *NetworkRequest, resp *NetworkStatusResponse, retry retry.Handler,
+) *ClientError {
+	if len(c.netjson) == 0 {
+		c.err.reset()
+		c.err.CallError = errors.New(
+			"api: the SetNetwork method must be called before making a Client.NetworkStatus call",
+		)
+		return c.err
+	}
+	c.req = req.EncodeJSON(c.req[:0], c.netjson)
+	if len(c.middleware) > 0 {
+		return c.intercept(ctx, "/network/status", resp, func(ctx context.Context, inv *Invocation) *ClientError {
+			return c.doNetworkStatus(ctx, inv, resp, retry)
+		})
+	}
+	return c.doNetworkStatus(ctx, nil, resp, retry)
+}
+
+func (c *Client) doNetworkStatus(
+	ctx context.Context, inv *Invocation, resp *NetworkStatusResponse, retry retry.Handler,
+) *ClientError {
+	it := retry.WithClassifier(classifyError).Iter()
+	var (
+		err   error
+		hresp *http.Response
+	)
+	for it.NextContext(ctx) {
+		if err = c.backend.acquire(ctx, "/network/status"); err != nil {
+			c.reportAttempt(inv, "/network/status", it.Record(err))
+			continue
+		}
+		hresp, err = c.post(ctx, "/network/status")
+		if err != nil {
+			c.record(inv, "/network/status", &it, err)
+			continue
+		}
+		inv.observe(hresp)
+		switch hresp.StatusCode {
+		case 200:
+			err = c.dec.ResetFromReadCloser(hresp.Body)
+			if err == nil {
+				resp.Reset()
+				err = resp.DecodeJSON(c.dec)
+			}
+			if err == nil {
+				err = c.dec.End()
+			}
+			if err == nil {
+				c.reportFindings("/network/status")
+				c.record(inv, "/network/status", &it, nil)
+				return nil
+			}
+		case 500:
+			c.err.reset()
+			err = c.dec.ResetFromReadCloser(hresp.Body)
+			if err == nil {
+				err = c.err.RosettaError.DecodeJSON(c.dec)
+			}
+			if err == nil {
+				err = c.dec.End()
+			}
+			if err == nil {
+				c.reportFindings("/network/status")
+				err = c.err
+			}
+		default:
+			err = newStatusError("/network/status", hresp)
+		}
+		c.record(inv, "/network/status", &it, err)
+	}
+	// NOTE(tav): The context may have been done before the first attempt.
+	if err == nil {
+		err = ctx.Err()
+	}
+	// NOTE(tav): Rosetta errors are returned as is once they are no longer
+	// being retried.
+	if err == c.err {
+		return c.err
+	}
+	if err != nil {
+		c.reportLimit("/network/status", err)
+		c.err.reset()
+		c.err.CallError = err
+		return c.err
+	}
+	return nil
+}
+
+// SearchTransactions calls the /search/transactions endpoint.
+//
+// Calls /search/transactions.
+//
+// Makes a request to /search/transactions.
+func (c *Client) SearchTransactions(
+	ctx context.Context, req *SearchTransactionsRequest, resp *SearchTransactionsResponse, retry retry.Handler,
+) *ClientError {
+	if len(c.netjson) == 0 {
+		c.err.reset()
+		c.err.CallError = errors.New(
+			"api: the SetNetwork method must be called before making a Client.SearchTransactions call",
+		)
+		return c.err
+	}
+	c.req = req.EncodeJSON(c.req[:0], c.netjson)
+	if len(c.middleware) > 0 {
+		return c.intercept(ctx, "/search/transactions", resp, func(ctx context.Context, inv *Invocation) *ClientError {
+			return c.doSearchTransactions(ctx, inv, resp, retry)
+		})
+	}
+	return c.doSearchTransactions(ctx, nil, resp, retry)
+}
+
+func (c *Client) doSearchTransactions(
+	ctx context.Context, inv *Invocation, resp *SearchTransactionsResponse, retry retry.Handler,
+) *ClientError {
+	it := retry.WithClassifier(classifyError).Iter()
+	var (
+		err   error
+		hresp *http.Response
+	)
+	for it.NextContext(ctx) {
+		if err = c.backend.acquire(ctx, "/search/transactions"); err != nil {
+			c.reportAttempt(inv, "/search/transactions", it.Record(err))
+			continue
+		}
+		hresp, err = c.post(ctx, "/search/transactions")
+		if err != nil {
+			c.record(inv, "/search/transactions", &it, err)
+			continue
+		}
+		inv.observe(hresp)
+		switch hresp.StatusCode {
+		case 200:
+			err = c.dec.ResetFromReadCloser(hresp.Body)
+			if err == nil {
+				resp.Reset()
+				err = resp.DecodeJSON(c.dec)
+			}
+			if err == nil {
+				err = c.dec.End()
+			}
+			if err == nil {
+				c.reportFindings("/search/transactions")
+				c.record(inv, "/search/transactions", &it, nil)
+				return nil
+			}
+		case 500:
+			c.err.reset()
+			err = c.dec.ResetFromReadCloser(hresp.Body)
+			if err == nil {
+				err = c.err.RosettaError.DecodeJSON(c.dec)
+			}
+			if err == nil {
+				err = c.dec.End()
+			}
+			if err == nil {
+				c.reportFindings("/search/transactions")
+				err = c.err
+			}
+		default:
+			err = newStatusError("/search/transactions", hresp)
+		}
+		c.record(inv, "/search/transactions", &it, err)
+	}
+	// NOTE(tav): The context may have been done before the first attempt.
+	if err == nil {
+		err = ctx.Err()
+	}
+	// NOTE(tav): Rosetta errors are returned as is once they are no longer
+	// being retried.
+	if err == c.err {
+		return c.err
+	}
+	if err != nil {
+		c.reportLimit("/search/transactions", err)
+		c.err.reset()
+		c.err.CallError = err
+		return c.err
+	}
+	return nil
+}
+
+// AccountBalance calls the /account/balance endpoint with a Client from the Pool. See
+// Client.AccountBalance for details.
+func (p *Pool) AccountBalance(
+	ctx context.Context, req *AccountBalanceRequest, resp *AccountBalanceResponse, retry retry.Handler,
+) *ClientError {
+	c := p.Get()
+	defer p.Put(c)
+	return c.AccountBalance(ctx, req, resp, retry).clone()
+}
+
+// AccountCoins calls the /account/coins endpoint with a Client from the Pool. See
+// Client.AccountCoins for details.
+func (p *Pool) AccountCoins(
+	ctx context.Context, req *AccountCoinsRequest, resp *AccountCoinsResponse, retry retry.Handler,
+) *ClientError {
+	c := p.Get()
+	defer p.Put(c)
+	return c.AccountCoins(ctx, req, resp, retry).clone()
+}
+
+// Block calls the /block endpoint with a Client from the Pool. See
+// Client.Block for details.
+func (p *Pool) Block(
+	ctx context.Context, req *BlockRequest, resp *BlockResponse, retry retry.Handler,
+) *ClientError {
+	c := p.Get()
+	defer p.Put(c)
+	return c.Block(ctx, req, resp, retry).clone()
+}
+
+// BlockTransaction calls the /block/transaction endpoint with a Client from the Pool. See
+// Client.BlockTransaction for details.
+func (p *Pool) BlockTransaction(
+	ctx context.Context, req *BlockTransactionRequest, resp *BlockTransactionResponse, retry retry.Handler,
+) *ClientError {
+	c := p.Get()
+	defer p.Put(c)
+	return c.BlockTransaction(ctx, req, resp, retry).clone()
+}
+
+// Call calls the /call endpoint with a Client from the Pool. See
+// Client.Call for details.
+func (p *Pool) Call(
+	ctx context.Context, req *CallRequest, resp *CallResponse, retry retry.Handler,
+) *ClientError {
+	c := p.Get()
+	defer p.Put(c)
+	return c.Call(ctx, req, resp, retry).clone()
+}
+
+// ConstructionCombine calls the /construction/combine endpoint with a Client from the Pool. See
+// Client.ConstructionCombine for details.
+func (p *Pool) ConstructionCombine(
+	ctx context.Context, req *ConstructionCombineRequest, resp *ConstructionCombineResponse, retry retry.Handler,
+) *ClientError {
+	c := p.Get()
+	defer p.Put(c)
+	return c.ConstructionCombine(ctx, req, resp, retry).clone()
+}
+
+// ConstructionDerive calls the /construction/derive endpoint with a Client from the Pool. See
+// Client.ConstructionDerive for details.
+func (p *Pool) ConstructionDerive(
+	ctx context.Context, req *ConstructionDeriveRequest, resp *ConstructionDeriveResponse, retry retry.Handler,
+) *ClientError {
+	c := p.Get()
+	defer p.Put(c)
+	return c.ConstructionDerive(ctx, req, resp, retry).clone()
+}
+
+// ConstructionHash calls the /construction/hash endpoint with a Client from the Pool. See
+// Client.ConstructionHash for details.
+func (p *Pool) ConstructionHash(
+	ctx context.Context, req *ConstructionHashRequest, resp *TransactionIdentifierResponse, retry retry.Handler,
+) *ClientError {
+	c := p.Get()
+	defer p.Put(c)
+	return c.ConstructionHash(ctx, req, resp, retry).clone()
+}
+
+// ConstructionMetadata calls the /construction/metadata endpoint with a Client from the Pool. See
+// Client.ConstructionMetadata for details.
+func (p *Pool) ConstructionMetadata(
+	ctx context.Context, req *ConstructionMetadataRequest, resp *ConstructionMetadataResponse, retry retry.Handler,
+) *ClientError {
+	c := p.Get()
+	defer p.Put(c)
+	return c.ConstructionMetadata(ctx, req, resp, retry).clone()
+}
+
+// ConstructionParse calls the /construction/parse endpoint with a Client from the Pool. See
+// Client.ConstructionParse for details.
+func (p *Pool) ConstructionParse(
+	ctx context.Context, req *ConstructionParseRequest, resp *ConstructionParseResponse, retry retry.Handler,
+) *ClientError {
+	c := p.Get()
+	defer p.Put(c)
+	return c.ConstructionParse(ctx, req, resp, retry).clone()
+}
+
+// ConstructionPayloads calls the /construction/payloads endpoint with a Client from the Pool. See
+// Client.ConstructionPayloads for details.
+func (p *Pool) ConstructionPayloads(
+	ctx context.Context, req *ConstructionPayloadsRequest, resp *ConstructionPayloadsResponse, retry retry.Handler,
+) *ClientError {
+	c := p.Get()
+	defer p.Put(c)
+	return c.ConstructionPayloads(ctx, req, resp, retry).clone()
+}
+
+// ConstructionPreprocess calls the /construction/preprocess endpoint with a Client from the Pool. See
+// Client.ConstructionPreprocess for details.
+func (p *Pool) ConstructionPreprocess(
+	ctx context.Context, req *ConstructionPreprocessRequest, resp *ConstructionPreprocessResponse, retry retry.Handler,
+) *ClientError {
+	c := p.Get()
+	defer p.Put(c)
+	return c.ConstructionPreprocess(ctx, req, resp, retry).clone()
+}
+
+// ConstructionSubmit calls the /construction/submit endpoint with a Client from the Pool. See
+// Client.ConstructionSubmit for details.
+func (p *Pool) ConstructionSubmit(
+	ctx context.Context, req *ConstructionSubmitRequest, resp *TransactionIdentifierResponse, retry retry.Handler,
+) *ClientError {
+	c := p.Get()
+	defer p.Put(c)
+	return c.ConstructionSubmit(ctx, req, resp, retry).clone()
+}
+
+// EventsBlocks calls the /events/blocks endpoint with a Client from the Pool. See
+// Client.EventsBlocks for details.
+func (p *Pool) EventsBlocks(
+	ctx context.Context, req *EventsBlocksRequest, resp *EventsBlocksResponse, retry retry.Handler,
+) *ClientError {
+	c := p.Get()
+	defer p.Put(c)
+	return c.EventsBlocks(ctx, req, resp, retry).clone()
+}
+
+// Mempool calls the /mempool endpoint with a Client from the Pool. See
+// Client.Mempool for details.
+func (p *Pool) Mempool(
+	ctx context.Context, req *NetworkRequest, resp *MempoolResponse, retry retry.Handler,
+) *ClientError {
+	c := p.Get()
+	defer p.Put(c)
+	return c.Mempool(ctx, req, resp, retry).clone()
+}
+
+// MempoolTransaction calls the /mempool/transaction endpoint with a Client from the Pool. See
+// Client.MempoolTransaction for details.
+func (p *Pool) MempoolTransaction(
+	ctx context.Context, req *MempoolTransactionRequest, resp *MempoolTransactionResponse, retry retry.Handler,
+) *ClientError {
+	c := p.Get()
+	defer p.Put(c)
+	return c.MempoolTransaction(ctx, req, resp, retry).clone()
+}
+
+// NetworkList calls the /network/list endpoint with a Client from the Pool. See
+// Client.NetworkList for details.
+func (p *Pool) NetworkList(
+	ctx context.Context, req *MetadataRequest, resp *NetworkListResponse, retry retry.Handler,
+) *ClientError {
+	c := p.Get()
+	defer p.Put(c)
+	return c.NetworkList(ctx, req, resp, retry).clone()
+}
+
+// NetworkOptions calls the /network/options endpoint with a Client from the Pool. See
+// Client.NetworkOptions for details.
+func (p *Pool) NetworkOptions(
+	ctx context.Context, req *NetworkRequest, resp *NetworkOptionsResponse, retry retry.Handler,
+) *ClientError {
+	c := p.Get()
+	defer p.Put(c)
+	return c.NetworkOptions(ctx, req, resp, retry).clone()
+}
+
+// NetworkStatus calls the /network/status endpoint with a Client from the Pool. See
+// Client.NetworkStatus for details.
+func (p *Pool) NetworkStatus(
+	ctx context.Context, req *NetworkRequest, resp *NetworkStatusResponse, retry retry.Handler,
+) *ClientError {
+	c := p.Get()
+	defer p.Put(c)
+	return c.NetworkStatus(ctx, req, resp, retry).clone()
+}
+
+// SearchTransactions calls the /search/transactions endpoint with a Client from the Pool. See
+// Client.SearchTransactions for details.
+func (p *Pool) SearchTransactions(
+	ctx context.Context, req *SearchTransactionsRequest, resp *SearchTransactionsResponse, retry retry.Handler,
+) *ClientError {
+	c := p.Get()
+	defer p.Put(c)
+	return c.SearchTransactions(ctx, req, resp, retry).clone()
 }
 
 // OptionalAccountIdentifierType encapsulates an optional AccountIdentifier value.
@@ -315,6 +2391,112 @@ type AccountBalanceRequest struct {
 	Currencies []Currency
 }
 
+// DecodeJSON decodes an AccountBalanceRequest value from JSON.
+func (v *AccountBalanceRequest) DecodeJSON(d *json.Decoder, network *NetworkIdentifier) error {
+	more, err := d.ObjectStart()
+	if err != nil {
+		return err
+	}
+	seen := uint64(0)
+	for more {
+		var key []byte
+		if key, err = d.Key(); err != nil {
+			return err
+		}
+		switch string(key) {
+		case "network_identifier":
+			if network == nil {
+				err = d.Skip()
+			} else {
+				err = network.DecodeJSON(d)
+			}
+		case "account_identifier":
+			err = v.AccountIdentifier.DecodeJSON(d)
+			seen |= 1 << 0
+		case "block_identifier":
+			if d.Null() {
+				v.BlockIdentifier.Set = false
+				err = d.Report(json.NullOptional)
+				break
+			}
+			v.BlockIdentifier.Set = true
+			err = v.BlockIdentifier.Value.DecodeJSON(d)
+		case "currencies":
+			if d.Null() {
+				v.Currencies = v.Currencies[:0]
+				err = d.Report(json.NullOptional)
+				break
+			}
+			v.Currencies = v.Currencies[:0]
+			if d.Null() {
+				break
+			}
+			var next bool
+			if next, err = d.ArrayStart(); err != nil {
+				break
+			}
+			for next {
+				n := len(v.Currencies)
+				if n < cap(v.Currencies) {
+					v.Currencies = v.Currencies[:n+1]
+					v.Currencies[n].Reset()
+				} else {
+					v.Currencies = append(v.Currencies, Currency{})
+				}
+				if err = v.Currencies[n].DecodeJSON(d); err != nil {
+					break
+				}
+				if next, err = d.ArrayNext(); err != nil {
+					break
+				}
+			}
+		default:
+			if err = d.Report(json.UnknownKey); err == nil {
+				err = d.Skip()
+			}
+		}
+		if err != nil {
+			return err
+		}
+		if more, err = d.ObjectNext(); err != nil {
+			return err
+		}
+	}
+	if seen&(1<<0) == 0 {
+		return d.Errorf("missing required field \"account_identifier\" in AccountBalanceRequest")
+	}
+	return nil
+}
+
+// Diff returns the differences between two AccountBalanceRequest values.
+func (v AccountBalanceRequest) Diff(o AccountBalanceRequest) []Difference {
+	return v.appendDiffs(nil, "", o)
+}
+
+func (v AccountBalanceRequest) appendDiffs(d []Difference, path string, o AccountBalanceRequest) []Difference {
+	d = v.AccountIdentifier.appendDiffs(d, diffPath(path, "account_identifier"), o.AccountIdentifier)
+	switch {
+	case v.BlockIdentifier.Set && o.BlockIdentifier.Set:
+		d = v.BlockIdentifier.Value.appendDiffs(d, diffPath(path, "block_identifier"), o.BlockIdentifier.Value)
+	case v.BlockIdentifier.Set:
+		d = append(d, Difference{A: string(v.BlockIdentifier.Value.EncodeJSON(nil)), Path: diffPath(path, "block_identifier")})
+	case o.BlockIdentifier.Set:
+		d = append(d, Difference{B: string(o.BlockIdentifier.Value.EncodeJSON(nil)), Path: diffPath(path, "block_identifier")})
+	}
+	for i := 0; i < len(v.Currencies) || i < len(o.Currencies); i++ {
+		p := diffIndex(diffPath(path, "currencies"), i)
+		switch {
+		case i >= len(o.Currencies):
+			d = append(d, Difference{A: string(v.Currencies[i].EncodeJSON(nil)), Path: p})
+		case i >= len(v.Currencies):
+			d = append(d, Difference{B: string(o.Currencies[i].EncodeJSON(nil)), Path: p})
+		default:
+			d = v.Currencies[i].appendDiffs(d, p, o.Currencies[i])
+		}
+	}
+	return d
+}
+
 // EncodeJSON encodes AccountBalanceRequest into JSON.
 func (v AccountBalanceRequest) EncodeJSON(b []byte, network []byte) []byte {
 	b = append(b, network...)
@@ -343,8 +2525,8 @@ func (v AccountBalanceRequest) EncodeJSON(b []byte, network []byte) []byte {
 // Equal returns whether two AccountBalanceRequest values are equal.
 func (v AccountBalanceRequest) Equal(o AccountBalanceRequest) bool {
 	return v.AccountIdentifier.Equal(o.AccountIdentifier) &&
-		v.BlockIdentifier.Value.Equal(o.BlockIdentifier.Value) &&
 		v.BlockIdentifier.Set == o.BlockIdentifier.Set &&
+		v.BlockIdentifier.Value.Equal(o.BlockIdentifier.Value) &&
 		len(v.Currencies) == len(o.Currencies) &&
 		currencySliceEqual(v.Currencies, o.Currencies)
 }
@@ -362,10 +2544,8 @@ func (v *AccountBalanceRequest) Reset() {
 // An AccountBalanceResponse is returned on the /account/balance endpoint. If an
 // account has a balance for each AccountIdentifier describing it (ex: an ERC-20
 // token balance on a few smart contracts), an account balance request must be
-// made with each AccountIdentifier.
-//
-// The `coins` field was removed and replaced by by `/account/coins` in
-// `v1.4.7`.
+// made with each AccountIdentifier. The `coins` field was removed and replaced
+// by by `/account/coins` in `v1.4.7`.
 type AccountBalanceResponse struct {
 	// A single account may have a balance in multiple currencies.
 	Balances        []Amount
@@ -376,6 +2556,103 @@ type AccountBalanceResponse struct {
 	Metadata MapObject
 }
 
+// DecodeJSON decodes an AccountBalanceResponse value from JSON.
+func (v *AccountBalanceResponse) DecodeJSON(d *json.Decoder) error {
+	more, err := d.ObjectStart()
+	if err != nil {
+		return err
+	}
+	seen := uint64(0)
+	for more {
+		var key []byte
+		if key, err = d.Key(); err != nil {
+			return err
+		}
+		switch string(key) {
+		case "balances":
+			v.Balances = v.Balances[:0]
+			if d.Null() {
+				break
+			}
+			var next bool
+			if next, err = d.ArrayStart(); err != nil {
+				break
+			}
+			for next {
+				n := len(v.Balances)
+				if n < cap(v.Balances) {
+					v.Balances = v.Balances[:n+1]
+					v.Balances[n].Reset()
+				} else {
+					v.Balances = append(v.Balances, Amount{})
+				}
+				if err = v.Balances[n].DecodeJSON(d); err != nil {
+					break
+				}
+				if next, err = d.ArrayNext(); err != nil {
+					break
+				}
+			}
+			seen |= 1 << 0
+		case "block_identifier":
+			err = v.BlockIdentifier.DecodeJSON(d)
+			seen |= 1 << 1
+		case "metadata":
+			if d.Null() {
+				v.Metadata = v.Metadata[:0]
+				err = d.Report(json.NullOptional)
+				break
+			}
+			v.Metadata, err = decodeMapObject(d, v.Metadata)
+		default:
+			if err = d.Report(json.UnknownKey); err == nil {
+				err = d.Skip()
+			}
+		}
+		if err != nil {
+			return err
+		}
+		if more, err = d.ObjectNext(); err != nil {
+			return err
+		}
+	}
+	if seen&(1<<0) == 0 {
+		return d.Errorf("missing required field \"balances\" in AccountBalanceResponse")
+	}
+	if seen&(1<<1) == 0 {
+		return d.Errorf("missing required field \"block_identifier\" in AccountBalanceResponse")
+	}
+	return nil
+}
+
+// Diff returns the differences between two AccountBalanceResponse values.
+func (v AccountBalanceResponse) Diff(o AccountBalanceResponse) []Difference {
+	return v.appendDiffs(nil, "", o)
+}
+
+func (v AccountBalanceResponse) appendDiffs(d []Difference, path string, o AccountBalanceResponse) []Difference {
+	for i := 0; i < len(v.Balances) || i < len(o.Balances); i++ {
+		p := diffIndex(diffPath(path, "balances"), i)
+		switch {
+		case i >= len(o.Balances):
+			d = append(d, Difference{A: string(v.Balances[i].EncodeJSON(nil)), Path: p})
+		case i >= len(v.Balances):
+			d = append(d, Difference{B: string(o.Balances[i].EncodeJSON(nil)), Path: p})
+		default:
+			d = v.Balances[i].appendDiffs(d, p, o.Balances[i])
+		}
+	}
+	d = v.BlockIdentifier.appendDiffs(d, diffPath(path, "block_identifier"), o.BlockIdentifier)
+	if string(v.Metadata) != string(o.Metadata) {
+		d = append(d, Difference{
+			A:    string(appendMapObject(nil, v.Metadata)),
+			B:    string(appendMapObject(nil, o.Metadata)),
+			Path: diffPath(path, "metadata"),
+		})
+	}
+	return d
+}
+
 // EncodeJSON encodes AccountBalanceResponse into JSON.
 func (v AccountBalanceResponse) EncodeJSON(b []byte) []byte {
 	b = append(b, `{"balances":[`...)
@@ -427,6 +2704,109 @@ type AccountCoinsRequest struct {
 	IncludeMempool bool
 }
 
+// DecodeJSON decodes an AccountCoinsRequest value from JSON.
+func (v *AccountCoinsRequest) DecodeJSON(d *json.Decoder, network *NetworkIdentifier) error {
+	more, err := d.ObjectStart()
+	if err != nil {
+		return err
+	}
+	seen := uint64(0)
+	for more {
+		var key []byte
+		if key, err = d.Key(); err != nil {
+			return err
+		}
+		switch string(key) {
+		case "network_identifier":
+			if network == nil {
+				err = d.Skip()
+			} else {
+				err = network.DecodeJSON(d)
+			}
+		case "account_identifier":
+			err = v.AccountIdentifier.DecodeJSON(d)
+			seen |= 1 << 0
+		case "currencies":
+			if d.Null() {
+				v.Currencies = v.Currencies[:0]
+				err = d.Report(json.NullOptional)
+				break
+			}
+			v.Currencies = v.Currencies[:0]
+			if d.Null() {
+				break
+			}
+			var next bool
+			if next, err = d.ArrayStart(); err != nil {
+				break
+			}
+			for next {
+				n := len(v.Currencies)
+				if n < cap(v.Currencies) {
+					v.Currencies = v.Currencies[:n+1]
+					v.Currencies[n].Reset()
+				} else {
+					v.Currencies = append(v.Currencies, Currency{})
+				}
+				if err = v.Currencies[n].DecodeJSON(d); err != nil {
+					break
+				}
+				if next, err = d.ArrayNext(); err != nil {
+					break
+				}
+			}
+		case "include_mempool":
+			v.IncludeMempool, err = d.Bool()
+			seen |= 1 << 1
+		default:
+			if err = d.Report(json.UnknownKey); err == nil {
+				err = d.Skip()
+			}
+		}
+		if err != nil {
+			return err
+		}
+		if more, err = d.ObjectNext(); err != nil {
+			return err
+		}
+	}
+	if seen&(1<<0) == 0 {
+		return d.Errorf("missing required field \"account_identifier\" in AccountCoinsRequest")
+	}
+	if seen&(1<<1) == 0 {
+		return d.Errorf("missing required field \"include_mempool\" in AccountCoinsRequest")
+	}
+	return nil
+}
+
+// Diff returns the differences between two AccountCoinsRequest values.
+func (v AccountCoinsRequest) Diff(o AccountCoinsRequest) []Difference {
+	return v.appendDiffs(nil, "", o)
+}
+
+func (v AccountCoinsRequest) appendDiffs(d []Difference, path string, o AccountCoinsRequest) []Difference {
+	d = v.AccountIdentifier.appendDiffs(d, diffPath(path, "account_identifier"), o.AccountIdentifier)
+	for i := 0; i < len(v.Currencies) || i < len(o.Currencies); i++ {
+		p := diffIndex(diffPath(path, "currencies"), i)
+		switch {
+		case i >= len(o.Currencies):
+			d = append(d, Difference{A: string(v.Currencies[i].EncodeJSON(nil)), Path: p})
+		case i >= len(v.Currencies):
+			d = append(d, Difference{B: string(o.Currencies[i].EncodeJSON(nil)), Path: p})
+		default:
+			d = v.Currencies[i].appendDiffs(d, p, o.Currencies[i])
+		}
+	}
+	if v.IncludeMempool != o.IncludeMempool {
+		d = append(d, Difference{
+			A:    string(json.AppendBool(nil, v.IncludeMempool)),
+			B:    string(json.AppendBool(nil, o.IncludeMempool)),
+			Path: diffPath(path, "include_mempool"),
+		})
+	}
+	return d
+}
+
 // EncodeJSON encodes AccountCoinsRequest into JSON.
 func (v AccountCoinsRequest) EncodeJSON(b []byte, network []byte) []byte {
 	b = append(b, network...)
@@ -479,6 +2859,103 @@ type AccountCoinsResponse struct {
 	Metadata MapObject
 }
 
+// DecodeJSON decodes an AccountCoinsResponse value from JSON.
+func (v *AccountCoinsResponse) DecodeJSON(d *json.Decoder) error {
+	more, err := d.ObjectStart()
+	if err != nil {
+		return err
+	}
+	seen := uint64(0)
+	for more {
+		var key []byte
+		if key, err = d.Key(); err != nil {
+			return err
+		}
+		switch string(key) {
+		case "block_identifier":
+			err = v.BlockIdentifier.DecodeJSON(d)
+			seen |= 1 << 0
+		case "coins":
+			v.Coins = v.Coins[:0]
+			if d.Null() {
+				break
+			}
+			var next bool
+			if next, err = d.ArrayStart(); err != nil {
+				break
+			}
+			for next {
+				n := len(v.Coins)
+				if n < cap(v.Coins) {
+					v.Coins = v.Coins[:n+1]
+					v.Coins[n].Reset()
+				} else {
+					v.Coins = append(v.Coins, Coin{})
+				}
+				if err = v.Coins[n].DecodeJSON(d); err != nil {
+					break
+				}
+				if next, err = d.ArrayNext(); err != nil {
+					break
+				}
+			}
+			seen |= 1 << 1
+		case "metadata":
+			if d.Null() {
+				v.Metadata = v.Metadata[:0]
+				err = d.Report(json.NullOptional)
+				break
+			}
+			v.Metadata, err = decodeMapObject(d, v.Metadata)
+		default:
+			if err = d.Report(json.UnknownKey); err == nil {
+				err = d.Skip()
+			}
+		}
+		if err != nil {
+			return err
+		}
+		if more, err = d.ObjectNext(); err != nil {
+			return err
+		}
+	}
+	if seen&(1<<0) == 0 {
+		return d.Errorf("missing required field \"block_identifier\" in AccountCoinsResponse")
+	}
+	if seen&(1<<1) == 0 {
+		return d.Errorf("missing required field \"coins\" in AccountCoinsResponse")
+	}
+	return nil
+}
+
+// Diff returns the differences between two AccountCoinsResponse values.
+func (v AccountCoinsResponse) Diff(o AccountCoinsResponse) []Difference {
+	return v.appendDiffs(nil, "", o)
+}
+
+func (v AccountCoinsResponse) appendDiffs(d []Difference, path string, o AccountCoinsResponse) []Difference {
+	d = v.BlockIdentifier.appendDiffs(d, diffPath(path, "block_identifier"), o.BlockIdentifier)
+	for i := 0; i < len(v.Coins) || i < len(o.Coins); i++ {
+		p := diffIndex(diffPath(path, "coins"), i)
+		switch {
+		case i >= len(o.Coins):
+			d = append(d, Difference{A: string(v.Coins[i].EncodeJSON(nil)), Path: p})
+		case i >= len(v.Coins):
+			d = append(d, Difference{B: string(o.Coins[i].EncodeJSON(nil)), Path: p})
+		default:
+			d = v.Coins[i].appendDiffs(d, p, o.Coins[i])
+		}
+	}
+	if string(v.Metadata) != string(o.Metadata) {
+		d = append(d, Difference{
+			A:    string(appendMapObject(nil, v.Metadata)),
+			B:    string(appendMapObject(nil, o.Metadata)),
+			Path: diffPath(path, "metadata"),
+		})
+	}
+	return d
+}
+
 // EncodeJSON encodes AccountCoinsResponse into JSON.
 func (v AccountCoinsResponse) EncodeJSON(b []byte) []byte {
 	b = append(b, '{', '"', 'b', 'l', 'o', 'c', 'k', '_', 'i', 'd', 'e', 'n', 't', 'i', 'f', 'i', 'e', 'r', '"', ':')
@@ -531,6 +3008,88 @@ type AccountIdentifier struct {
 	SubAccount OptionalSubAccountIdentifierType
 }
 
+// DecodeJSON decodes an AccountIdentifier value from JSON.
+func (v *AccountIdentifier) DecodeJSON(d *json.Decoder) error {
+	more, err := d.ObjectStart()
+	if err != nil {
+		return err
+	}
+	seen := uint64(0)
+	for more {
+		var key []byte
+		if key, err = d.Key(); err != nil {
+			return err
+		}
+		switch string(key) {
+		case "address":
+			var s []byte
+			s, err = d.String()
+			v.Address = string(s)
+			seen |= 1 << 0
+		case "metadata":
+			if d.Null() {
+				v.Metadata = v.Metadata[:0]
+				err = d.Report(json.NullOptional)
+				break
+			}
+			v.Metadata, err = decodeMapObject(d, v.Metadata)
+		case "sub_account":
+			if d.Null() {
+				v.SubAccount.Set = false
+				err = d.Report(json.NullOptional)
+				break
+			}
+			v.SubAccount.Set = true
+			err = v.SubAccount.Value.DecodeJSON(d)
+		default:
+			if err = d.Report(json.UnknownKey); err == nil {
+				err = d.Skip()
+			}
+		}
+		if err != nil {
+			return err
+		}
+		if more, err = d.ObjectNext(); err != nil {
+			return err
+		}
+	}
+	if seen&(1<<0) == 0 {
+		return d.Errorf("missing required field \"address\" in AccountIdentifier")
+	}
+	return nil
+}
+
+// Diff returns the differences between two AccountIdentifier values.
+func (v AccountIdentifier) Diff(o AccountIdentifier) []Difference {
+	return v.appendDiffs(nil, "", o)
+}
+
+func (v AccountIdentifier) appendDiffs(d []Difference, path string, o AccountIdentifier) []Difference {
+	if v.Address != o.Address {
+		d = append(d, Difference{
+			A:    string(json.AppendString(nil, v.Address)),
+			B:    string(json.AppendString(nil, o.Address)),
+			Path: diffPath(path, "address"),
+		})
+	}
+	if string(v.Metadata) != string(o.Metadata) {
+		d = append(d, Difference{
+			A:    string(appendMapObject(nil, v.Metadata)),
+			B:    string(appendMapObject(nil, o.Metadata)),
+			Path: diffPath(path, "metadata"),
+		})
+	}
+	switch {
+	case v.SubAccount.Set && o.SubAccount.Set:
+		d = v.SubAccount.Value.appendDiffs(d, diffPath(path, "sub_account"), o.SubAccount.Value)
+	case v.SubAccount.Set:
+		d = append(d, Difference{A: string(v.SubAccount.Value.EncodeJSON(nil)), Path: diffPath(path, "sub_account")})
+	case o.SubAccount.Set:
+		d = append(d, Difference{B: string(o.SubAccount.Value.EncodeJSON(nil)), Path: diffPath(path, "sub_account")})
+	}
+	return d
+}
+
 // EncodeJSON encodes AccountIdentifier into JSON.
 func (v AccountIdentifier) EncodeJSON(b []byte) []byte {
 	b = append(b, `{"address":`...)
@@ -554,8 +3113,8 @@ func (v AccountIdentifier) EncodeJSON(b []byte) []byte {
 func (v AccountIdentifier) Equal(o AccountIdentifier) bool {
 	return v.Address == o.Address &&
 		string(v.Metadata) == string(o.Metadata) &&
-		v.SubAccount.Value.Equal(o.SubAccount.Value) &&
-		v.SubAccount.Set == o.SubAccount.Set
+		v.SubAccount.Set == o.SubAccount.Set &&
+		v.SubAccount.Value.Equal(o.SubAccount.Value)
 }
 
 // Reset resets AccountIdentifier so that it can be reused.
@@ -574,14 +3133,11 @@ func (v *AccountIdentifier) Reset() {
 type Allow struct {
 	// BalanceExemptions is an array of BalanceExemption indicating which
 	// account balances could change without a corresponding Operation.
-	//
 	// BalanceExemptions should be used sparingly as they may introduce
 	// significant complexity for integrators that attempt to reconcile all
-	// account balance changes.
-	//
-	// If your implementation relies on any BalanceExemptions, you MUST
-	// implement historical balance lookup (the ability to query an account
-	// balance at any BlockIdentifier).
+	// account balance changes. If your implementation relies on any
+	// BalanceExemptions, you MUST implement historical balance lookup (the
+	// ability to query an account balance at any BlockIdentifier).
 	BalanceExemptions []BalanceExemption
 	// All methods that are supported by the /call endpoint. Communicating which
 	// parameters should be provided to /call is the responsibility of the
@@ -611,13 +3167,228 @@ type Allow struct {
 	// If populated, `timestamp_start_index` indicates the first block index
 	// where block timestamps are considered valid (i.e. all blocks less than
 	// `timestamp_start_index` could have invalid timestamps). This is useful
-	// when the genesis block (or blocks) of a network have timestamp 0.
-	//
-	// If not populated, block timestamps are assumed to be valid for all
-	// available blocks.
+	// when the genesis block (or blocks) of a network have timestamp 0. If not
+	// populated, block timestamps are assumed to be valid for all available
+	// blocks.
 	TimestampStartIndex OptionalInt64Type
 }
 
+// DecodeJSON decodes an Allow value from JSON.
+func (v *Allow) DecodeJSON(d *json.Decoder) error {
+	more, err := d.ObjectStart()
+	if err != nil {
+		return err
+	}
+	seen := uint64(0)
+	for more {
+		var key []byte
+		if key, err = d.Key(); err != nil {
+			return err
+		}
+		switch string(key) {
+		case "balance_exemptions":
+			v.BalanceExemptions = v.BalanceExemptions[:0]
+			if d.Null() {
+				break
+			}
+			var next bool
+			if next, err = d.ArrayStart(); err != nil {
+				break
+			}
+			for next {
+				n := len(v.BalanceExemptions)
+				if n < cap(v.BalanceExemptions) {
+					v.BalanceExemptions = v.BalanceExemptions[:n+1]
+					v.BalanceExemptions[n].Reset()
+				} else {
+					v.BalanceExemptions = append(v.BalanceExemptions, BalanceExemption{})
+				}
+				if err = v.BalanceExemptions[n].DecodeJSON(d); err != nil {
+					break
+				}
+				if next, err = d.ArrayNext(); err != nil {
+					break
+				}
+			}
+			seen |= 1 << 0
+		case "call_methods":
+			v.CallMethods, err = decodeStrings(d, v.CallMethods[:0])
+			seen |= 1 << 1
+		case "errors":
+			v.Errors = v.Errors[:0]
+			if d.Null() {
+				break
+			}
+			var next bool
+			if next, err = d.ArrayStart(); err != nil {
+				break
+			}
+			for next {
+				n := len(v.Errors)
+				if n < cap(v.Errors) {
+					v.Errors = v.Errors[:n+1]
+					v.Errors[n].Reset()
+				} else {
+					v.Errors = append(v.Errors, Error{})
+				}
+				if err = v.Errors[n].DecodeJSON(d); err != nil {
+					break
+				}
+				if next, err = d.ArrayNext(); err != nil {
+					break
+				}
+			}
+			seen |= 1 << 2
+		case "historical_balance_lookup":
+			v.HistoricalBalanceLookup, err = d.Bool()
+			seen |= 1 << 3
+		case "mempool_coins":
+			v.MempoolCoins, err = d.Bool()
+			seen |= 1 << 4
+		case "operation_statuses":
+			v.OperationStatuses = v.OperationStatuses[:0]
+			if d.Null() {
+				break
+			}
+			var next bool
+			if next, err = d.ArrayStart(); err != nil {
+				break
+			}
+			for next {
+				n := len(v.OperationStatuses)
+				if n < cap(v.OperationStatuses) {
+					v.OperationStatuses = v.OperationStatuses[:n+1]
+					v.OperationStatuses[n].Reset()
+				} else {
+					v.OperationStatuses = append(v.OperationStatuses, OperationStatus{})
+				}
+				if err = v.OperationStatuses[n].DecodeJSON(d); err != nil {
+					break
+				}
+				if next, err = d.ArrayNext(); err != nil {
+					break
+				}
+			}
+			seen |= 1 << 5
+		case "operation_types":
+			v.OperationTypes, err = decodeStrings(d, v.OperationTypes[:0])
+			seen |= 1 << 6
+		case "timestamp_start_index":
+			if d.Null() {
+				v.TimestampStartIndex.Set = false
+				err = d.Report(json.NullOptional)
+				break
+			}
+			v.TimestampStartIndex.Set = true
+			v.TimestampStartIndex.Value, err = d.Int64()
+		default:
+			if err = d.Report(json.UnknownKey); err == nil {
+				err = d.Skip()
+			}
+		}
+		if err != nil {
+			return err
+		}
+		if more, err = d.ObjectNext(); err != nil {
+			return err
+		}
+	}
+	if seen&(1<<0) == 0 {
+		return d.Errorf("missing required field \"balance_exemptions\" in Allow")
+	}
+	if seen&(1<<1) == 0 {
+		return d.Errorf("missing required field \"call_methods\" in Allow")
+	}
+	if seen&(1<<2) == 0 {
+		return d.Errorf("missing required field \"errors\" in Allow")
+	}
+	if seen&(1<<3) == 0 {
+		return d.Errorf("missing required field \"historical_balance_lookup\" in Allow")
+	}
+	if seen&(1<<4) == 0 {
+		return d.Errorf("missing required field \"mempool_coins\" in Allow")
+	}
+	if seen&(1<<5) == 0 {
+		return d.Errorf("missing required field \"operation_statuses\" in Allow")
+	}
+	if seen&(1<<6) == 0 {
+		return d.Errorf("missing required field \"operation_types\" in Allow")
+	}
+	return nil
+}
+
+// Diff returns the differences between two Allow values.
+func (v Allow) Diff(o Allow) []Difference {
+	return v.appendDiffs(nil, "", o)
+}
+
+func (v Allow) appendDiffs(d []Difference, path string, o Allow) []Difference {
+	for i := 0; i < len(v.BalanceExemptions) || i < len(o.BalanceExemptions); i++ {
+		p := diffIndex(diffPath(path, "balance_exemptions"), i)
+		switch {
+		case i >= len(o.BalanceExemptions):
+			d = append(d, Difference{A: string(v.BalanceExemptions[i].EncodeJSON(nil)), Path: p})
+		case i >= len(v.BalanceExemptions):
+			d = append(d, Difference{B: string(o.BalanceExemptions[i].EncodeJSON(nil)), Path: p})
+		default:
+			d = v.BalanceExemptions[i].appendDiffs(d, p, o.BalanceExemptions[i])
+		}
+	}
+	d = appendStringSliceDiffs(d, diffPath(path, "call_methods"), v.CallMethods, o.CallMethods)
+	for i := 0; i < len(v.Errors) || i < len(o.Errors); i++ {
+		p := diffIndex(diffPath(path, "errors"), i)
+		switch {
+		case i >= len(o.Errors):
+			d = append(d, Difference{A: string(v.Errors[i].EncodeJSON(nil)), Path: p})
+		case i >= len(v.Errors):
+			d = append(d, Difference{B: string(o.Errors[i].EncodeJSON(nil)), Path: p})
+		default:
+			d = v.Errors[i].appendDiffs(d, p, o.Errors[i])
+		}
+	}
+	if v.HistoricalBalanceLookup != o.HistoricalBalanceLookup {
+		d = append(d, Difference{
+			A:    string(json.AppendBool(nil, v.HistoricalBalanceLookup)),
+			B:    string(json.AppendBool(nil, o.HistoricalBalanceLookup)),
+			Path: diffPath(path, "historical_balance_lookup"),
+		})
+	}
+	if v.MempoolCoins != o.MempoolCoins {
+		d = append(d, Difference{
+			A:    string(json.AppendBool(nil, v.MempoolCoins)),
+			B:    string(json.AppendBool(nil, o.MempoolCoins)),
+			Path: diffPath(path, "mempool_coins"),
+		})
+	}
+	for i := 0; i < len(v.OperationStatuses) || i < len(o.OperationStatuses); i++ {
+		p := diffIndex(diffPath(path, "operation_statuses"), i)
+		switch {
+		case i >= len(o.OperationStatuses):
+			d = append(d, Difference{A: string(v.OperationStatuses[i].EncodeJSON(nil)), Path: p})
+		case i >= len(v.OperationStatuses):
+			d = append(d, Difference{B: string(o.OperationStatuses[i].EncodeJSON(nil)), Path: p})
+		default:
+			d = v.OperationStatuses[i].appendDiffs(d, p, o.OperationStatuses[i])
+		}
+	}
+	d = appendStringSliceDiffs(d, diffPath(path, "operation_types"), v.OperationTypes, o.OperationTypes)
+	switch {
+	case v.TimestampStartIndex.Set && o.TimestampStartIndex.Set:
+		if v.TimestampStartIndex.Value != o.TimestampStartIndex.Value {
+			d = append(d, Difference{
+				A:    string(json.AppendInt(nil, v.TimestampStartIndex.Value)),
+				B:    string(json.AppendInt(nil, o.TimestampStartIndex.Value)),
+				Path: diffPath(path, "timestamp_start_index"),
+			})
+		}
+	case v.TimestampStartIndex.Set:
+		d = append(d, Difference{A: string(json.AppendInt(nil, v.TimestampStartIndex.Value)), Path: diffPath(path, "timestamp_start_index")})
+	case o.TimestampStartIndex.Set:
+		d = append(d, Difference{B: string(json.AppendInt(nil, o.TimestampStartIndex.Value)), Path: diffPath(path, "timestamp_start_index")})
+	}
+	return d
+}
+
 // EncodeJSON encodes Allow into JSON.
 func (v Allow) EncodeJSON(b []byte) []byte {
 	b = append(b, '{', '"', 'b', 'a', 'l', 'a', 'n', 'c', 'e', '_', 'e', 'x', 'e', 'm', 'p', 't', 'i', 'o', 'n', 's', '"', ':', '[')
@@ -683,8 +3454,8 @@ func (v Allow) Equal(o Allow) bool {
 		operationStatusSliceEqual(v.OperationStatuses, o.OperationStatuses) &&
 		len(v.OperationTypes) == len(o.OperationTypes) &&
 		stringSliceEqual(v.OperationTypes, o.OperationTypes) &&
-		v.TimestampStartIndex.Value == o.TimestampStartIndex.Value &&
-		v.TimestampStartIndex.Set == o.TimestampStartIndex.Set
+		v.TimestampStartIndex.Set == o.TimestampStartIndex.Set &&
+		v.TimestampStartIndex.Value == o.TimestampStartIndex.Value
 }
 
 // Reset resets Allow so that it can be reused.
@@ -706,12 +3477,84 @@ type Amount struct {
 	Currency Currency
 	Metadata MapObject
 	// Value of the transaction in atomic units represented as an
-	// arbitrary-sized signed integer.
-	//
-	// For example, 1 BTC would be represented by a value of 100000000.
+	// arbitrary-sized signed integer. For example, 1 BTC would be represented
+	// by a value of 100000000.
 	Value string
 }
 
+// DecodeJSON decodes an Amount value from JSON.
+func (v *Amount) DecodeJSON(d *json.Decoder) error {
+	more, err := d.ObjectStart()
+	if err != nil {
+		return err
+	}
+	seen := uint64(0)
+	for more {
+		var key []byte
+		if key, err = d.Key(); err != nil {
+			return err
+		}
+		switch string(key) {
+		case "currency":
+			err = v.Currency.DecodeJSON(d)
+			seen |= 1 << 0
+		case "metadata":
+			if d.Null() {
+				v.Metadata = v.Metadata[:0]
+				err = d.Report(json.NullOptional)
+				break
+			}
+			v.Metadata, err = decodeMapObject(d, v.Metadata)
+		case "value":
+			var s []byte
+			s, err = d.String()
+			v.Value = string(s)
+			seen |= 1 << 1
+		default:
+			if err = d.Report(json.UnknownKey); err == nil {
+				err = d.Skip()
+			}
+		}
+		if err != nil {
+			return err
+		}
+		if more, err = d.ObjectNext(); err != nil {
+			return err
+		}
+	}
+	if seen&(1<<0) == 0 {
+		return d.Errorf("missing required field \"currency\" in Amount")
+	}
+	if seen&(1<<1) == 0 {
+		return d.Errorf("missing required field \"value\" in Amount")
+	}
+	return nil
+}
+
+// Diff returns the differences between two Amount values.
+func (v Amount) Diff(o Amount) []Difference {
+	return v.appendDiffs(nil, "", o)
+}
+
+func (v Amount) appendDiffs(d []Difference, path string, o Amount) []Difference {
+	d = v.Currency.appendDiffs(d, diffPath(path, "currency"), o.Currency)
+	if string(v.Metadata) != string(o.Metadata) {
+		d = append(d, Difference{
+			A:    string(appendMapObject(nil, v.Metadata)),
+			B:    string(appendMapObject(nil, o.Metadata)),
+			Path: diffPath(path, "metadata"),
+		})
+	}
+	if v.Value != o.Value {
+		d = append(d, Difference{
+			A:    string(json.AppendString(nil, v.Value)),
+			B:    string(json.AppendString(nil, o.Value)),
+			Path: diffPath(path, "value"),
+		})
+	}
+	return d
+}
+
 // EncodeJSON encodes Amount into JSON.
 func (v Amount) EncodeJSON(b []byte) []byte {
 	b = append(b, `{"currency":`...)
@@ -743,20 +3586,15 @@ func (v *Amount) Reset() {
 
 // BalanceExemption indicates that the balance for an exempt account could
 // change without a corresponding Operation. This typically occurs with staking
-// rewards, vesting balances, and Currencies with a dynamic supply.
-//
-// Currently, it is possible to exempt an account from strict reconciliation by
+// rewards, vesting balances, and Currencies with a dynamic supply. Currently,
+// it is possible to exempt an account from strict reconciliation by
 // SubAccountIdentifier.Address or by Currency. This means that any account with
 // SubAccountIdentifier.Address would be exempt or any balance of a particular
-// Currency would be exempt, respectively.
-//
-// BalanceExemptions should be used sparingly as they may introduce significant
-// complexity for integrators that attempt to reconcile all account balance
-// changes.
-//
-// If your implementation relies on any BalanceExemptions, you MUST implement
-// historical balance lookup (the ability to query an account balance at any
-// BlockIdentifier).
+// Currency would be exempt, respectively. BalanceExemptions should be used
+// sparingly as they may introduce significant complexity for integrators that
+// attempt to reconcile all account balance changes. If your implementation
+// relies on any BalanceExemptions, you MUST implement historical balance lookup
+// (the ability to query an account balance at any BlockIdentifier).
 type BalanceExemption struct {
 	Currency      OptionalCurrencyType
 	ExemptionType OptionalExemptionTypeType
@@ -766,6 +3604,106 @@ type BalanceExemption struct {
 	SubAccountAddress OptionalStringType
 }
 
+// DecodeJSON decodes a BalanceExemption value from JSON.
+func (v *BalanceExemption) DecodeJSON(d *json.Decoder) error {
+	more, err := d.ObjectStart()
+	if err != nil {
+		return err
+	}
+	for more {
+		var key []byte
+		if key, err = d.Key(); err != nil {
+			return err
+		}
+		switch string(key) {
+		case "currency":
+			if d.Null() {
+				v.Currency.Set = false
+				err = d.Report(json.NullOptional)
+				break
+			}
+			v.Currency.Set = true
+			err = v.Currency.Value.DecodeJSON(d)
+		case "exemption_type":
+			if d.Null() {
+				v.ExemptionType.Set = false
+				err = d.Report(json.NullOptional)
+				break
+			}
+			v.ExemptionType.Set = true
+			var s []byte
+			s, err = d.String()
+			v.ExemptionType.Value = ExemptionType(s)
+		case "sub_account_address":
+			if d.Null() {
+				v.SubAccountAddress.Set = false
+				err = d.Report(json.NullOptional)
+				break
+			}
+			v.SubAccountAddress.Set = true
+			var s []byte
+			s, err = d.String()
+			v.SubAccountAddress.Value = string(s)
+		default:
+			if err = d.Report(json.UnknownKey); err == nil {
+				err = d.Skip()
+			}
+		}
+		if err != nil {
+			return err
+		}
+		if more, err = d.ObjectNext(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Diff returns the differences between two BalanceExemption values.
+func (v BalanceExemption) Diff(o BalanceExemption) []Difference {
+	return v.appendDiffs(nil, "", o)
+}
+
+func (v BalanceExemption) appendDiffs(d []Difference, path string, o BalanceExemption) []Difference {
+	switch {
+	case v.Currency.Set && o.Currency.Set:
+		d = v.Currency.Value.appendDiffs(d, diffPath(path, "currency"), o.Currency.Value)
+	case v.Currency.Set:
+		d = append(d, Difference{A: string(v.Currency.Value.EncodeJSON(nil)), Path: diffPath(path, "currency")})
+	case o.Currency.Set:
+		d = append(d, Difference{B: string(o.Currency.Value.EncodeJSON(nil)), Path: diffPath(path, "currency")})
+	}
+	switch {
+	case v.ExemptionType.Set && o.ExemptionType.Set:
+		if v.ExemptionType.Value != o.ExemptionType.Value {
+			d = append(d, Difference{
+				A:    string(json.AppendString(nil, string(v.ExemptionType.Value))),
+				B:    string(json.AppendString(nil, string(o.ExemptionType.Value))),
+				Path: diffPath(path, "exemption_type"),
+			})
+		}
+	case v.ExemptionType.Set:
+		d = append(d, Difference{A: string(json.AppendString(nil, string(v.ExemptionType.Value))), Path: diffPath(path, "exemption_type")})
+	case o.ExemptionType.Set:
+		d = append(d, Difference{B: string(json.AppendString(nil, string(o.ExemptionType.Value))), Path: diffPath(path, "exemption_type")})
+	}
+	switch {
+	case v.SubAccountAddress.Set && o.SubAccountAddress.Set:
+		if v.SubAccountAddress.Value != o.SubAccountAddress.Value {
+			d = append(d, Difference{
+				A:    string(json.AppendString(nil, v.SubAccountAddress.Value)),
+				B:    string(json.AppendString(nil, o.SubAccountAddress.Value)),
+				Path: diffPath(path, "sub_account_address"),
+			})
+		}
+	case v.SubAccountAddress.Set:
+		d = append(d, Difference{A: string(json.AppendString(nil, v.SubAccountAddress.Value)), Path: diffPath(path, "sub_account_address")})
+	case o.SubAccountAddress.Set:
+		d = append(d, Difference{B: string(json.AppendString(nil, o.SubAccountAddress.Value)), Path: diffPath(path, "sub_account_address")})
+	}
+	return d
+}
+
 // EncodeJSON encodes BalanceExemption into JSON.
 func (v BalanceExemption) EncodeJSON(b []byte) []byte {
 	b = append(b, "{"...)
@@ -784,18 +3722,21 @@ func (v BalanceExemption) EncodeJSON(b []byte) []byte {
 		b = json.AppendString(b, v.SubAccountAddress.Value)
 		b = append(b, ","...)
 	}
+	if b[len(b)-1] == '{' {
+		return append(b, "}"...)
+	}
 	b[len(b)-1] = '}'
 	return b
 }
 
 // Equal returns whether two BalanceExemption values are equal.
 func (v BalanceExemption) Equal(o BalanceExemption) bool {
-	return v.Currency.Value.Equal(o.Currency.Value) &&
-		v.Currency.Set == o.Currency.Set &&
-		v.ExemptionType.Value == o.ExemptionType.Value &&
+	return v.Currency.Set == o.Currency.Set &&
+		v.Currency.Value.Equal(o.Currency.Value) &&
 		v.ExemptionType.Set == o.ExemptionType.Set &&
-		v.SubAccountAddress.Value == o.SubAccountAddress.Value &&
-		v.SubAccountAddress.Set == o.SubAccountAddress.Set
+		v.ExemptionType.Value == o.ExemptionType.Value &&
+		v.SubAccountAddress.Set == o.SubAccountAddress.Set &&
+		v.SubAccountAddress.Value == o.SubAccountAddress.Value
 }
 
 // Reset resets BalanceExemption so that it can be reused.
@@ -811,12 +3752,11 @@ func (v *BalanceExemption) Reset() {
 // Block type.
 //
 // Blocks contain an array of Transactions that occurred at a particular
-// BlockIdentifier.
-//
-// A hard requirement for blocks returned by Rosetta implementations is that
-// they MUST be _inalterable_: once a client has requested and received a block
-// identified by a specific BlockIndentifier, all future calls for that same
-// BlockIdentifier must return the same block contents.
+// BlockIdentifier. A hard requirement for blocks returned by Rosetta
+// implementations is that they MUST be _inalterable_: once a client has
+// requested and received a block identified by a specific BlockIndentifier, all
+// future calls for that same BlockIdentifier must return the same block
+// contents.
 type Block struct {
 	BlockIdentifier       BlockIdentifier
 	Metadata              MapObject
@@ -825,6 +3765,125 @@ type Block struct {
 	Transactions          []Transaction
 }
 
+// DecodeJSON decodes a Block value from JSON.
+func (v *Block) DecodeJSON(d *json.Decoder) error {
+	more, err := d.ObjectStart()
+	if err != nil {
+		return err
+	}
+	seen := uint64(0)
+	for more {
+		var key []byte
+		if key, err = d.Key(); err != nil {
+			return err
+		}
+		switch string(key) {
+		case "block_identifier":
+			err = v.BlockIdentifier.DecodeJSON(d)
+			seen |= 1 << 0
+		case "metadata":
+			if d.Null() {
+				v.Metadata = v.Metadata[:0]
+				err = d.Report(json.NullOptional)
+				break
+			}
+			v.Metadata, err = decodeMapObject(d, v.Metadata)
+		case "parent_block_identifier":
+			err = v.ParentBlockIdentifier.DecodeJSON(d)
+			seen |= 1 << 1
+		case "timestamp":
+			var n int64
+			n, err = d.Int64()
+			v.Timestamp = Timestamp(n)
+			seen |= 1 << 2
+		case "transactions":
+			v.Transactions = v.Transactions[:0]
+			if d.Null() {
+				break
+			}
+			var next bool
+			if next, err = d.ArrayStart(); err != nil {
+				break
+			}
+			for next {
+				n := len(v.Transactions)
+				if n < cap(v.Transactions) {
+					v.Transactions = v.Transactions[:n+1]
+					v.Transactions[n].Reset()
+				} else {
+					v.Transactions = append(v.Transactions, Transaction{})
+				}
+				if err = v.Transactions[n].DecodeJSON(d); err != nil {
+					break
+				}
+				if next, err = d.ArrayNext(); err != nil {
+					break
+				}
+			}
+			seen |= 1 << 3
+		default:
+			if err = d.Report(json.UnknownKey); err == nil {
+				err = d.Skip()
+			}
+		}
+		if err != nil {
+			return err
+		}
+		if more, err = d.ObjectNext(); err != nil {
+			return err
+		}
+	}
+	if seen&(1<<0) == 0 {
+		return d.Errorf("missing required field \"block_identifier\" in Block")
+	}
+	if seen&(1<<1) == 0 {
+		return d.Errorf("missing required field \"parent_block_identifier\" in Block")
+	}
+	if seen&(1<<2) == 0 {
+		return d.Errorf("missing required field \"timestamp\" in Block")
+	}
+	if seen&(1<<3) == 0 {
+		return d.Errorf("missing required field \"transactions\" in Block")
+	}
+	return nil
+}
+
+// Diff returns the differences between two Block values.
+func (v Block) Diff(o Block) []Difference {
+	return v.appendDiffs(nil, "", o)
+}
+
+func (v Block) appendDiffs(d []Difference, path string, o Block) []Difference {
+	d = v.BlockIdentifier.appendDiffs(d, diffPath(path, "block_identifier"), o.BlockIdentifier)
+	if string(v.Metadata) != string(o.Metadata) {
+		d = append(d, Difference{
+			A:    string(appendMapObject(nil, v.Metadata)),
+			B:    string(appendMapObject(nil, o.Metadata)),
+			Path: diffPath(path, "metadata"),
+		})
+	}
+	d = v.ParentBlockIdentifier.appendDiffs(d, diffPath(path, "parent_block_identifier"), o.ParentBlockIdentifier)
+	if v.Timestamp != o.Timestamp {
+		d = append(d, Difference{
+			A:    string(json.AppendInt(nil, int64(v.Timestamp))),
+			B:    string(json.AppendInt(nil, int64(o.Timestamp))),
+			Path: diffPath(path, "timestamp"),
+		})
+	}
+	for i := 0; i < len(v.Transactions) || i < len(o.Transactions); i++ {
+		p := diffIndex(diffPath(path, "transactions"), i)
+		switch {
+		case i >= len(o.Transactions):
+			d = append(d, Difference{A: string(v.Transactions[i].EncodeJSON(nil)), Path: p})
+		case i >= len(v.Transactions):
+			d = append(d, Difference{B: string(o.Transactions[i].EncodeJSON(nil)), Path: p})
+		default:
+			d = v.Transactions[i].appendDiffs(d, p, o.Transactions[i])
+		}
+	}
+	return d
+}
+
 // EncodeJSON encodes Block into JSON.
 func (v Block) EncodeJSON(b []byte) []byte {
 	b = append(b, '{', '"', 'b', 'l', 'o', 'c', 'k', '_', 'i', 'd', 'e', 'n', 't', 'i', 'f', 'i', 'e', 'r', '"', ':')
@@ -879,6 +3938,78 @@ type BlockEvent struct {
 	Type     BlockEventType
 }
 
+// DecodeJSON decodes a BlockEvent value from JSON.
+func (v *BlockEvent) DecodeJSON(d *json.Decoder) error {
+	more, err := d.ObjectStart()
+	if err != nil {
+		return err
+	}
+	seen := uint64(0)
+	for more {
+		var key []byte
+		if key, err = d.Key(); err != nil {
+			return err
+		}
+		switch string(key) {
+		case "block_identifier":
+			err = v.BlockIdentifier.DecodeJSON(d)
+			seen |= 1 << 0
+		case "sequence":
+			v.Sequence, err = d.Int64()
+			seen |= 1 << 1
+		case "type":
+			var s []byte
+			s, err = d.String()
+			v.Type = BlockEventType(s)
+			seen |= 1 << 2
+		default:
+			if err = d.Report(json.UnknownKey); err == nil {
+				err = d.Skip()
+			}
+		}
+		if err != nil {
+			return err
+		}
+		if more, err = d.ObjectNext(); err != nil {
+			return err
+		}
+	}
+	if seen&(1<<0) == 0 {
+		return d.Errorf("missing required field \"block_identifier\" in BlockEvent")
+	}
+	if seen&(1<<1) == 0 {
+		return d.Errorf("missing required field \"sequence\" in BlockEvent")
+	}
+	if seen&(1<<2) == 0 {
+		return d.Errorf("missing required field \"type\" in BlockEvent")
+	}
+	return nil
+}
+
+// Diff returns the differences between two BlockEvent values.
+func (v BlockEvent) Diff(o BlockEvent) []Difference {
+	return v.appendDiffs(nil, "", o)
+}
+
+func (v BlockEvent) appendDiffs(d []Difference, path string, o BlockEvent) []Difference {
+	d = v.BlockIdentifier.appendDiffs(d, diffPath(path, "block_identifier"), o.BlockIdentifier)
+	if v.Sequence != o.Sequence {
+		d = append(d, Difference{
+			A:    string(json.AppendInt(nil, v.Sequence)),
+			B:    string(json.AppendInt(nil, o.Sequence)),
+			Path: diffPath(path, "sequence"),
+		})
+	}
+	if v.Type != o.Type {
+		d = append(d, Difference{
+			A:    string(json.AppendString(nil, string(v.Type))),
+			B:    string(json.AppendString(nil, string(o.Type))),
+			Path: diffPath(path, "type"),
+		})
+	}
+	return d
+}
+
 // EncodeJSON encodes BlockEvent into JSON.
 func (v BlockEvent) EncodeJSON(b []byte) []byte {
 	b = append(b, '{', '"', 'b', 'l', 'o', 'c', 'k', '_', 'i', 'd', 'e', 'n', 't', 'i', 'f', 'i', 'e', 'r', '"', ':')
@@ -925,6 +4056,71 @@ type BlockIdentifier struct {
 	Index int64
 }
 
+// DecodeJSON decodes a BlockIdentifier value from JSON.
+func (v *BlockIdentifier) DecodeJSON(d *json.Decoder) error {
+	more, err := d.ObjectStart()
+	if err != nil {
+		return err
+	}
+	seen := uint64(0)
+	for more {
+		var key []byte
+		if key, err = d.Key(); err != nil {
+			return err
+		}
+		switch string(key) {
+		case "hash":
+			var s []byte
+			s, err = d.String()
+			v.Hash = string(s)
+			seen |= 1 << 0
+		case "index":
+			v.Index, err = d.Int64()
+			seen |= 1 << 1
+		default:
+			if err = d.Report(json.UnknownKey); err == nil {
+				err = d.Skip()
+			}
+		}
+		if err != nil {
+			return err
+		}
+		if more, err = d.ObjectNext(); err != nil {
+			return err
+		}
+	}
+	if seen&(1<<0) == 0 {
+		return d.Errorf("missing required field \"hash\" in BlockIdentifier")
+	}
+	if seen&(1<<1) == 0 {
+		return d.Errorf("missing required field \"index\" in BlockIdentifier")
+	}
+	return nil
+}
+
+// Diff returns the differences between two BlockIdentifier values.
+func (v BlockIdentifier) Diff(o BlockIdentifier) []Difference {
+	return v.appendDiffs(nil, "", o)
+}
+
+func (v BlockIdentifier) appendDiffs(d []Difference, path string, o BlockIdentifier) []Difference {
+	if v.Hash != o.Hash {
+		d = append(d, Difference{
+			A:    string(json.AppendString(nil, v.Hash)),
+			B:    string(json.AppendString(nil, o.Hash)),
+			Path: diffPath(path, "hash"),
+		})
+	}
+	if v.Index != o.Index {
+		d = append(d, Difference{
+			A:    string(json.AppendInt(nil, v.Index)),
+			B:    string(json.AppendInt(nil, o.Index)),
+			Path: diffPath(path, "index"),
+		})
+	}
+	return d
+}
+
 // EncodeJSON encodes BlockIdentifier into JSON.
 func (v BlockIdentifier) EncodeJSON(b []byte) []byte {
 	b = append(b, `{"hash":`...)
@@ -953,6 +4149,56 @@ type BlockRequest struct {
 	BlockIdentifier PartialBlockIdentifier
 }
 
+// DecodeJSON decodes a BlockRequest value from JSON.
+func (v *BlockRequest) DecodeJSON(d *json.Decoder, network *NetworkIdentifier) error {
+	more, err := d.ObjectStart()
+	if err != nil {
+		return err
+	}
+	seen := uint64(0)
+	for more {
+		var key []byte
+		if key, err = d.Key(); err != nil {
+			return err
+		}
+		switch string(key) {
+		case "network_identifier":
+			if network == nil {
+				err = d.Skip()
+			} else {
+				err = network.DecodeJSON(d)
+			}
+		case "block_identifier":
+			err = v.BlockIdentifier.DecodeJSON(d)
+			seen |= 1 << 0
+		default:
+			if err = d.Report(json.UnknownKey); err == nil {
+				err = d.Skip()
+			}
+		}
+		if err != nil {
+			return err
+		}
+		if more, err = d.ObjectNext(); err != nil {
+			return err
+		}
+	}
+	if seen&(1<<0) == 0 {
+		return d.Errorf("missing required field \"block_identifier\" in BlockRequest")
+	}
+	return nil
+}
+
+// Diff returns the differences between two BlockRequest values.
+func (v BlockRequest) Diff(o BlockRequest) []Difference {
+	return v.appendDiffs(nil, "", o)
+}
+
+func (v BlockRequest) appendDiffs(d []Difference, path string, o BlockRequest) []Difference {
+	d = v.BlockIdentifier.appendDiffs(d, diffPath(path, "block_identifier"), o.BlockIdentifier)
+	return d
+}
+
 // EncodeJSON encodes BlockRequest into JSON.
 func (v BlockRequest) EncodeJSON(b []byte, network []byte) []byte {
 	b = append(b, network...)
@@ -974,17 +4220,14 @@ func (v *BlockRequest) Reset() {
 // BlockResponse type.
 //
 // A BlockResponse includes a fully-populated block or a partially-populated
-// block with a list of other transactions to fetch (other_transactions).
-//
-// As a result of the consensus algorithm of some blockchains, blocks can be
-// omitted (i.e. certain block indices can be skipped). If a query for one of
-// these omitted indices is made, the response should not include a `Block`
-// object.
-//
-// It is VERY important to note that blocks MUST still form a canonical,
-// connected chain of blocks where each block has a unique index. In other
-// words, the `PartialBlockIdentifier` of a block after an omitted block should
-// reference the last non-omitted block.
+// block with a list of other transactions to fetch (other_transactions). As a
+// result of the consensus algorithm of some blockchains, blocks can be omitted
+// (i.e. certain block indices can be skipped). If a query for one of these
+// omitted indices is made, the response should not include a `Block` object. It
+// is VERY important to note that blocks MUST still form a canonical, connected
+// chain of blocks where each block has a unique index. In other words, the
+// `PartialBlockIdentifier` of a block after an omitted block should reference
+// the last non-omitted block.
 type BlockResponse struct {
 	Block OptionalBlockType
 	// Some blockchains may require additional transactions to be fetched that
@@ -995,6 +4238,98 @@ type BlockResponse struct {
 	OtherTransactions []TransactionIdentifier
 }
 
+// DecodeJSON decodes a BlockResponse value from JSON.
+func (v *BlockResponse) DecodeJSON(d *json.Decoder) error {
+	more, err := d.ObjectStart()
+	if err != nil {
+		return err
+	}
+	for more {
+		var key []byte
+		if key, err = d.Key(); err != nil {
+			return err
+		}
+		switch string(key) {
+		case "block":
+			if d.Null() {
+				v.Block.Set = false
+				err = d.Report(json.NullOptional)
+				break
+			}
+			v.Block.Set = true
+			err = v.Block.Value.DecodeJSON(d)
+		case "other_transactions":
+			if d.Null() {
+				v.OtherTransactions = v.OtherTransactions[:0]
+				err = d.Report(json.NullOptional)
+				break
+			}
+			v.OtherTransactions = v.OtherTransactions[:0]
+			if d.Null() {
+				break
+			}
+			var next bool
+			if next, err = d.ArrayStart(); err != nil {
+				break
+			}
+			for next {
+				n := len(v.OtherTransactions)
+				if n < cap(v.OtherTransactions) {
+					v.OtherTransactions = v.OtherTransactions[:n+1]
+					v.OtherTransactions[n].Reset()
+				} else {
+					v.OtherTransactions = append(v.OtherTransactions, TransactionIdentifier{})
+				}
+				if err = v.OtherTransactions[n].DecodeJSON(d); err != nil {
+					break
+				}
+				if next, err = d.ArrayNext(); err != nil {
+					break
+				}
+			}
+		default:
+			if err = d.Report(json.UnknownKey); err == nil {
+				err = d.Skip()
+			}
+		}
+		if err != nil {
+			return err
+		}
+		if more, err = d.ObjectNext(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Diff returns the differences between two BlockResponse values.
+func (v BlockResponse) Diff(o BlockResponse) []Difference {
+	return v.appendDiffs(nil, "", o)
+}
+
+func (v BlockResponse) appendDiffs(d []Difference, path string, o BlockResponse) []Difference {
+	switch {
+	case v.Block.Set && o.Block.Set:
+		d = v.Block.Value.appendDiffs(d, diffPath(path, "block"), o.Block.Value)
+	case v.Block.Set:
+		d = append(d, Difference{A: string(v.Block.Value.EncodeJSON(nil)), Path: diffPath(path, "block")})
+	case o.Block.Set:
+		d = append(d, Difference{B: string(o.Block.Value.EncodeJSON(nil)), Path: diffPath(path, "block")})
+	}
+	for i := 0; i < len(v.OtherTransactions) || i < len(o.OtherTransactions); i++ {
+		p := diffIndex(diffPath(path, "other_transactions"), i)
+		switch {
+		case i >= len(o.OtherTransactions):
+			d = append(d, Difference{A: string(v.OtherTransactions[i].EncodeJSON(nil)), Path: p})
+		case i >= len(v.OtherTransactions):
+			d = append(d, Difference{B: string(o.OtherTransactions[i].EncodeJSON(nil)), Path: p})
+		default:
+			d = v.OtherTransactions[i].appendDiffs(d, p, o.OtherTransactions[i])
+		}
+	}
+	return d
+}
+
 // EncodeJSON encodes BlockResponse into JSON.
 func (v BlockResponse) EncodeJSON(b []byte) []byte {
 	b = append(b, "{"...)
@@ -1013,14 +4348,17 @@ func (v BlockResponse) EncodeJSON(b []byte) []byte {
 		}
 		b = append(b, "],"...)
 	}
+	if b[len(b)-1] == '{' {
+		return append(b, "}"...)
+	}
 	b[len(b)-1] = '}'
 	return b
 }
 
 // Equal returns whether two BlockResponse values are equal.
 func (v BlockResponse) Equal(o BlockResponse) bool {
-	return v.Block.Value.Equal(o.Block.Value) &&
-		v.Block.Set == o.Block.Set &&
+	return v.Block.Set == o.Block.Set &&
+		v.Block.Value.Equal(o.Block.Value) &&
 		len(v.OtherTransactions) == len(o.OtherTransactions) &&
 		transactionIdentifierSliceEqual(v.OtherTransactions, o.OtherTransactions)
 }
@@ -1039,6 +4377,57 @@ type BlockTransaction struct {
 	Transaction     Transaction
 }
 
+// DecodeJSON decodes a BlockTransaction value from JSON.
+func (v *BlockTransaction) DecodeJSON(d *json.Decoder) error {
+	more, err := d.ObjectStart()
+	if err != nil {
+		return err
+	}
+	seen := uint64(0)
+	for more {
+		var key []byte
+		if key, err = d.Key(); err != nil {
+			return err
+		}
+		switch string(key) {
+		case "block_identifier":
+			err = v.BlockIdentifier.DecodeJSON(d)
+			seen |= 1 << 0
+		case "transaction":
+			err = v.Transaction.DecodeJSON(d)
+			seen |= 1 << 1
+		default:
+			if err = d.Report(json.UnknownKey); err == nil {
+				err = d.Skip()
+			}
+		}
+		if err != nil {
+			return err
+		}
+		if more, err = d.ObjectNext(); err != nil {
+			return err
+		}
+	}
+	if seen&(1<<0) == 0 {
+		return d.Errorf("missing required field \"block_identifier\" in BlockTransaction")
+	}
+	if seen&(1<<1) == 0 {
+		return d.Errorf("missing required field \"transaction\" in BlockTransaction")
+	}
+	return nil
+}
+
+// Diff returns the differences between two BlockTransaction values.
+func (v BlockTransaction) Diff(o BlockTransaction) []Difference {
+	return v.appendDiffs(nil, "", o)
+}
+
+func (v BlockTransaction) appendDiffs(d []Difference, path string, o BlockTransaction) []Difference {
+	d = v.BlockIdentifier.appendDiffs(d, diffPath(path, "block_identifier"), o.BlockIdentifier)
+	d = v.Transaction.appendDiffs(d, diffPath(path, "transaction"), o.Transaction)
+	return d
+}
+
 // EncodeJSON encodes BlockTransaction into JSON.
 func (v BlockTransaction) EncodeJSON(b []byte) []byte {
 	b = append(b, '{', '"', 'b', 'l', 'o', 'c', 'k', '_', 'i', 'd', 'e', 'n', 't', 'i', 'f', 'i', 'e', 'r', '"', ':')
@@ -1069,6 +4458,63 @@ type BlockTransactionRequest struct {
 	TransactionIdentifier TransactionIdentifier
 }
 
+// DecodeJSON decodes a BlockTransactionRequest value from JSON.
+func (v *BlockTransactionRequest) DecodeJSON(d *json.Decoder, network *NetworkIdentifier) error {
+	more, err := d.ObjectStart()
+	if err != nil {
+		return err
+	}
+	seen := uint64(0)
+	for more {
+		var key []byte
+		if key, err = d.Key(); err != nil {
+			return err
+		}
+		switch string(key) {
+		case "network_identifier":
+			if network == nil {
+				err = d.Skip()
+			} else {
+				err = network.DecodeJSON(d)
+			}
+		case "block_identifier":
+			err = v.BlockIdentifier.DecodeJSON(d)
+			seen |= 1 << 0
+		case "transaction_identifier":
+			err = v.TransactionIdentifier.DecodeJSON(d)
+			seen |= 1 << 1
+		default:
+			if err = d.Report(json.UnknownKey); err == nil {
+				err = d.Skip()
+			}
+		}
+		if err != nil {
+			return err
+		}
+		if more, err = d.ObjectNext(); err != nil {
+			return err
+		}
+	}
+	if seen&(1<<0) == 0 {
+		return d.Errorf("missing required field \"block_identifier\" in BlockTransactionRequest")
+	}
+	if seen&(1<<1) == 0 {
+		return d.Errorf("missing required field \"transaction_identifier\" in BlockTransactionRequest")
+	}
+	return nil
+}
+
+// Diff returns the differences between two BlockTransactionRequest values.
+func (v BlockTransactionRequest) Diff(o BlockTransactionRequest) []Difference {
+	return v.appendDiffs(nil, "", o)
+}
+
+func (v BlockTransactionRequest) appendDiffs(d []Difference, path string, o BlockTransactionRequest) []Difference {
+	d = v.BlockIdentifier.appendDiffs(d, diffPath(path, "block_identifier"), o.BlockIdentifier)
+	d = v.TransactionIdentifier.appendDiffs(d, diffPath(path, "transaction_identifier"), o.TransactionIdentifier)
+	return d
+}
+
 // EncodeJSON encodes BlockTransactionRequest into JSON.
 func (v BlockTransactionRequest) EncodeJSON(b []byte, network []byte) []byte {
 	b = append(b, network...)
@@ -1098,6 +4544,50 @@ type BlockTransactionResponse struct {
 	Transaction Transaction
 }
 
+// DecodeJSON decodes a BlockTransactionResponse value from JSON.
+func (v *BlockTransactionResponse) DecodeJSON(d *json.Decoder) error {
+	more, err := d.ObjectStart()
+	if err != nil {
+		return err
+	}
+	seen := uint64(0)
+	for more {
+		var key []byte
+		if key, err = d.Key(); err != nil {
+			return err
+		}
+		switch string(key) {
+		case "transaction":
+			err = v.Transaction.DecodeJSON(d)
+			seen |= 1 << 0
+		default:
+			if err = d.Report(json.UnknownKey); err == nil {
+				err = d.Skip()
+			}
+		}
+		if err != nil {
+			return err
+		}
+		if more, err = d.ObjectNext(); err != nil {
+			return err
+		}
+	}
+	if seen&(1<<0) == 0 {
+		return d.Errorf("missing required field \"transaction\" in BlockTransactionResponse")
+	}
+	return nil
+}
+
+// Diff returns the differences between two BlockTransactionResponse values.
+func (v BlockTransactionResponse) Diff(o BlockTransactionResponse) []Difference {
+	return v.appendDiffs(nil, "", o)
+}
+
+func (v BlockTransactionResponse) appendDiffs(d []Difference, path string, o BlockTransactionResponse) []Difference {
+	d = v.Transaction.appendDiffs(d, diffPath(path, "transaction"), o.Transaction)
+	return d
+}
+
 // EncodeJSON encodes BlockTransactionResponse into JSON.
 func (v BlockTransactionResponse) EncodeJSON(b []byte) []byte {
 	b = append(b, `{"transaction":`...)
@@ -1119,11 +4609,9 @@ func (v *BlockTransactionResponse) Reset() {
 type CallRequest struct {
 	// Method is some network-specific procedure call. This method could map to
 	// a network-specific RPC endpoint, a method in an SDK generated from a
-	// smart contract, or some hybrid of the two.
-	//
-	// The implementation must define all available methods in the Allow object.
-	// However, it is up to the caller to determine which parameters to provide
-	// when invoking `/call`.
+	// smart contract, or some hybrid of the two. The implementation must define
+	// all available methods in the Allow object. However, it is up to the
+	// caller to determine which parameters to provide when invoking `/call`.
 	Method string
 	// Parameters is some network-specific argument for a method. It is up to
 	// the caller to determine which parameters to provide when invoking
@@ -1131,6 +4619,77 @@ type CallRequest struct {
 	Parameters MapObject
 }
 
+// DecodeJSON decodes a CallRequest value from JSON.
+func (v *CallRequest) DecodeJSON(d *json.Decoder, network *NetworkIdentifier) error {
+	more, err := d.ObjectStart()
+	if err != nil {
+		return err
+	}
+	seen := uint64(0)
+	for more {
+		var key []byte
+		if key, err = d.Key(); err != nil {
+			return err
+		}
+		switch string(key) {
+		case "network_identifier":
+			if network == nil {
+				err = d.Skip()
+			} else {
+				err = network.DecodeJSON(d)
+			}
+		case "method":
+			var s []byte
+			s, err = d.String()
+			v.Method = string(s)
+			seen |= 1 << 0
+		case "parameters":
+			v.Parameters, err = decodeMapObject(d, v.Parameters)
+			seen |= 1 << 1
+		default:
+			if err = d.Report(json.UnknownKey); err == nil {
+				err = d.Skip()
+			}
+		}
+		if err != nil {
+			return err
+		}
+		if more, err = d.ObjectNext(); err != nil {
+			return err
+		}
+	}
+	if seen&(1<<0) == 0 {
+		return d.Errorf("missing required field \"method\" in CallRequest")
+	}
+	if seen&(1<<1) == 0 {
+		return d.Errorf("missing required field \"parameters\" in CallRequest")
+	}
+	return nil
+}
+
+// Diff returns the differences between two CallRequest values.
+func (v CallRequest) Diff(o CallRequest) []Difference {
+	return v.appendDiffs(nil, "", o)
+}
+
+func (v CallRequest) appendDiffs(d []Difference, path string, o CallRequest) []Difference {
+	if v.Method != o.Method {
+		d = append(d, Difference{
+			A:    string(json.AppendString(nil, v.Method)),
+			B:    string(json.AppendString(nil, o.Method)),
+			Path: diffPath(path, "method"),
+		})
+	}
+	if string(v.Parameters) != string(o.Parameters) {
+		d = append(d, Difference{
+			A:    string(appendMapObject(nil, v.Parameters)),
+			B:    string(appendMapObject(nil, o.Parameters)),
+			Path: diffPath(path, "parameters"),
+		})
+	}
+	return d
+}
+
 // EncodeJSON encodes CallRequest into JSON.
 func (v CallRequest) EncodeJSON(b []byte, network []byte) []byte {
 	b = append(b, network...)
@@ -1157,7 +4716,6 @@ func (v *CallRequest) Reset() {
 type CallResponse struct {
 	// Idempotent indicates that if `/call` is invoked with the same CallRequest
 	// again, at any point in time, it will return the same CallResponse.
-	//
 	// Integrators may cache the CallResponse if this is set to true to avoid
 	// making unnecessary calls to the Rosetta implementation. For this reason,
 	// implementers should be very conservative about returning true here or
@@ -1169,6 +4727,69 @@ type CallResponse struct {
 	Result MapObject
 }
 
+// DecodeJSON decodes a CallResponse value from JSON.
+func (v *CallResponse) DecodeJSON(d *json.Decoder) error {
+	more, err := d.ObjectStart()
+	if err != nil {
+		return err
+	}
+	seen := uint64(0)
+	for more {
+		var key []byte
+		if key, err = d.Key(); err != nil {
+			return err
+		}
+		switch string(key) {
+		case "idempotent":
+			v.Idempotent, err = d.Bool()
+			seen |= 1 << 0
+		case "result":
+			v.Result, err = decodeMapObject(d, v.Result)
+			seen |= 1 << 1
+		default:
+			if err = d.Report(json.UnknownKey); err == nil {
+				err = d.Skip()
+			}
+		}
+		if err != nil {
+			return err
+		}
+		if more, err = d.ObjectNext(); err != nil {
+			return err
+		}
+	}
+	if seen&(1<<0) == 0 {
+		return d.Errorf("missing required field \"idempotent\" in CallResponse")
+	}
+	if seen&(1<<1) == 0 {
+		return d.Errorf("missing required field \"result\" in CallResponse")
+	}
+	return nil
+}
+
+// Diff returns the differences between two CallResponse values.
+func (v CallResponse) Diff(o CallResponse) []Difference {
+	return v.appendDiffs(nil, "", o)
+}
+
+func (v CallResponse) appendDiffs(d []Difference, path string, o CallResponse) []Difference {
+	if v.Idempotent != o.Idempotent {
+		d = append(d, Difference{
+			A:    string(json.AppendBool(nil, v.Idempotent)),
+			B:    string(json.AppendBool(nil, o.Idempotent)),
+			Path: diffPath(path, "idempotent"),
+		})
+	}
+	if string(v.Result) != string(o.Result) {
+		d = append(d, Difference{
+			A:    string(appendMapObject(nil, v.Result)),
+			B:    string(appendMapObject(nil, o.Result)),
+			Path: diffPath(path, "result"),
+		})
+	}
+	return d
+}
+
 // EncodeJSON encodes CallResponse into JSON.
 func (v CallResponse) EncodeJSON(b []byte) []byte {
 	b = append(b, `{"idempotent":`...)
@@ -1196,6 +4817,57 @@ type Coin struct {
 	CoinIdentifier CoinIdentifier
 }
 
+// DecodeJSON decodes a Coin value from JSON.
+func (v *Coin) DecodeJSON(d *json.Decoder) error {
+	more, err := d.ObjectStart()
+	if err != nil {
+		return err
+	}
+	seen := uint64(0)
+	for more {
+		var key []byte
+		if key, err = d.Key(); err != nil {
+			return err
+		}
+		switch string(key) {
+		case "amount":
+			err = v.Amount.DecodeJSON(d)
+			seen |= 1 << 0
+		case "coin_identifier":
+			err = v.CoinIdentifier.DecodeJSON(d)
+			seen |= 1 << 1
+		default:
+			if err = d.Report(json.UnknownKey); err == nil {
+				err = d.Skip()
+			}
+		}
+		if err != nil {
+			return err
+		}
+		if more, err = d.ObjectNext(); err != nil {
+			return err
+		}
+	}
+	if seen&(1<<0) == 0 {
+		return d.Errorf("missing required field \"amount\" in Coin")
+	}
+	if seen&(1<<1) == 0 {
+		return d.Errorf("missing required field \"coin_identifier\" in Coin")
+	}
+	return nil
+}
+
+// Diff returns the differences between two Coin values.
+func (v Coin) Diff(o Coin) []Difference {
+	return v.appendDiffs(nil, "", o)
+}
+
+func (v Coin) appendDiffs(d []Difference, path string, o Coin) []Difference {
+	d = v.Amount.appendDiffs(d, diffPath(path, "amount"), o.Amount)
+	d = v.CoinIdentifier.appendDiffs(d, diffPath(path, "coin_identifier"), o.CoinIdentifier)
+	return d
+}
+
 // EncodeJSON encodes Coin into JSON.
 func (v Coin) EncodeJSON(b []byte) []byte {
 	b = append(b, `{"amount":`...)
@@ -1234,16 +4906,74 @@ func (v CoinAction) Validate() error {
 
 // CoinChange is used to represent a change in state of a some coin identified
 // by a coin_identifier. This object is part of the Operation model and must be
-// populated for UTXO-based blockchains.
-//
-// Coincidentally, this abstraction of UTXOs allows for supporting both
-// account-based transfers and UTXO-based transfers on the same blockchain (when
-// a transfer is account-based, don't populate this model).
+// populated for UTXO-based blockchains. Coincidentally, this abstraction of
+// UTXOs allows for supporting both account-based transfers and UTXO-based
+// transfers on the same blockchain (when a transfer is account-based, don't
+// populate this model).
 type CoinChange struct {
 	CoinAction     CoinAction
 	CoinIdentifier CoinIdentifier
 }
 
+// DecodeJSON decodes a CoinChange value from JSON.
+func (v *CoinChange) DecodeJSON(d *json.Decoder) error {
+	more, err := d.ObjectStart()
+	if err != nil {
+		return err
+	}
+	seen := uint64(0)
+	for more {
+		var key []byte
+		if key, err = d.Key(); err != nil {
+			return err
+		}
+		switch string(key) {
+		case "coin_action":
+			var s []byte
+			s, err = d.String()
+			v.CoinAction = CoinAction(s)
+			seen |= 1 << 0
+		case "coin_identifier":
+			err = v.CoinIdentifier.DecodeJSON(d)
+			seen |= 1 << 1
+		default:
+			if err = d.Report(json.UnknownKey); err == nil {
+				err = d.Skip()
+			}
+		}
+		if err != nil {
+			return err
+		}
+		if more, err = d.ObjectNext(); err != nil {
+			return err
+		}
+	}
+	if seen&(1<<0) == 0 {
+		return d.Errorf("missing required field \"coin_action\" in CoinChange")
+	}
+	if seen&(1<<1) == 0 {
+		return d.Errorf("missing required field \"coin_identifier\" in CoinChange")
+	}
+	return nil
+}
+
+// Diff returns the differences between two CoinChange values.
+func (v CoinChange) Diff(o CoinChange) []Difference {
+	return v.appendDiffs(nil, "", o)
+}
+
+func (v CoinChange) appendDiffs(d []Difference, path string, o CoinChange) []Difference {
+	if v.CoinAction != o.CoinAction {
+		d = append(d, Difference{
+			A:    string(json.AppendString(nil, string(v.CoinAction))),
+			B:    string(json.AppendString(nil, string(o.CoinAction))),
+			Path: diffPath(path, "coin_action"),
+		})
+	}
+	d = v.CoinIdentifier.appendDiffs(d, diffPath(path, "coin_identifier"), o.CoinIdentifier)
+	return d
+}
+
 // EncodeJSON encodes CoinChange into JSON.
 func (v CoinChange) EncodeJSON(b []byte) []byte {
 	b = append(b, `{"coin_action":`...)
@@ -1272,6 +5002,58 @@ type CoinIdentifier struct {
 	Identifier string
 }
 
+// DecodeJSON decodes a CoinIdentifier value from JSON.
+func (v *CoinIdentifier) DecodeJSON(d *json.Decoder) error {
+	more, err := d.ObjectStart()
+	if err != nil {
+		return err
+	}
+	seen := uint64(0)
+	for more {
+		var key []byte
+		if key, err = d.Key(); err != nil {
+			return err
+		}
+		switch string(key) {
+		case "identifier":
+			var s []byte
+			s, err = d.String()
+			v.Identifier = string(s)
+			seen |= 1 << 0
+		default:
+			if err = d.Report(json.UnknownKey); err == nil {
+				err = d.Skip()
+			}
+		}
+		if err != nil {
+			return err
+		}
+		if more, err = d.ObjectNext(); err != nil {
+			return err
+		}
+	}
+	if seen&(1<<0) == 0 {
+		return d.Errorf("missing required field \"identifier\" in CoinIdentifier")
+	}
+	return nil
+}
+
+// Diff returns the differences between two CoinIdentifier values.
+func (v CoinIdentifier) Diff(o CoinIdentifier) []Difference {
+	return v.appendDiffs(nil, "", o)
+}
+
+func (v CoinIdentifier) appendDiffs(d []Difference, path string, o CoinIdentifier) []Difference {
+	if v.Identifier != o.Identifier {
+		d = append(d, Difference{
+			A:    string(json.AppendString(nil, v.Identifier)),
+			B:    string(json.AppendString(nil, o.Identifier)),
+			Path: diffPath(path, "identifier"),
+		})
+	}
+	return d
+}
+
 // EncodeJSON encodes CoinIdentifier into JSON.
 func (v CoinIdentifier) EncodeJSON(b []byte) []byte {
 	b = append(b, `{"identifier":`...)
@@ -1298,6 +5080,103 @@ type ConstructionCombineRequest struct {
 	UnsignedTransaction string
 }
 
+// DecodeJSON decodes a ConstructionCombineRequest value from JSON.
+func (v *ConstructionCombineRequest) DecodeJSON(d *json.Decoder, network *NetworkIdentifier) error {
+	more, err := d.ObjectStart()
+	if err != nil {
+		return err
+	}
+	seen := uint64(0)
+	for more {
+		var key []byte
+		if key, err = d.Key(); err != nil {
+			return err
+		}
+		switch string(key) {
+		case "network_identifier":
+			if network == nil {
+				err = d.Skip()
+			} else {
+				err = network.DecodeJSON(d)
+			}
+		case "signatures":
+			v.Signatures = v.Signatures[:0]
+			if d.Null() {
+				break
+			}
+			var next bool
+			if next, err = d.ArrayStart(); err != nil {
+				break
+			}
+			for next {
+				n := len(v.Signatures)
+				if n < cap(v.Signatures) {
+					v.Signatures = v.Signatures[:n+1]
+					v.Signatures[n].Reset()
+				} else {
+					v.Signatures = append(v.Signatures, Signature{})
+				}
+				if err = v.Signatures[n].DecodeJSON(d); err != nil {
+					break
+				}
+				if next, err = d.ArrayNext(); err != nil {
+					break
+				}
+			}
+			seen |= 1 << 0
+		case "unsigned_transaction":
+			var s []byte
+			s, err = d.String()
+			v.UnsignedTransaction = string(s)
+			seen |= 1 << 1
+		default:
+			if err = d.Report(json.UnknownKey); err == nil {
+				err = d.Skip()
+			}
+		}
+		if err != nil {
+			return err
+		}
+		if more, err = d.ObjectNext(); err != nil {
+			return err
+		}
+	}
+	if seen&(1<<0) == 0 {
+		return d.Errorf("missing required field \"signatures\" in ConstructionCombineRequest")
+	}
+	if seen&(1<<1) == 0 {
+		return d.Errorf("missing required field \"unsigned_transaction\" in ConstructionCombineRequest")
+	}
+	return nil
+}
+
+// Diff returns the differences between two ConstructionCombineRequest values.
+func (v ConstructionCombineRequest) Diff(o ConstructionCombineRequest) []Difference {
+	return v.appendDiffs(nil, "", o)
+}
+
+func (v ConstructionCombineRequest) appendDiffs(d []Difference, path string, o ConstructionCombineRequest) []Difference {
+	for i := 0; i < len(v.Signatures) || i < len(o.Signatures); i++ {
+		p := diffIndex(diffPath(path, "signatures"), i)
+		switch {
+		case i >= len(o.Signatures):
+			d = append(d, Difference{A: string(v.Signatures[i].EncodeJSON(nil)), Path: p})
+		case i >= len(v.Signatures):
+			d = append(d, Difference{B: string(o.Signatures[i].EncodeJSON(nil)), Path: p})
+		default:
+			d = v.Signatures[i].appendDiffs(d, p, o.Signatures[i])
+		}
+	}
+	if v.UnsignedTransaction != o.UnsignedTransaction {
+		d = append(d, Difference{
+			A:    string(json.AppendString(nil, v.UnsignedTransaction)),
+			B:    string(json.AppendString(nil, o.UnsignedTransaction)),
+			Path: diffPath(path, "unsigned_transaction"),
+		})
+	}
+	return d
+}
+
 // EncodeJSON encodes ConstructionCombineRequest into JSON.
 func (v ConstructionCombineRequest) EncodeJSON(b []byte, network []byte) []byte {
 	b = append(b, network...)
@@ -1326,10 +5205,62 @@ func (v *ConstructionCombineRequest) Reset() {
 	v.UnsignedTransaction = ""
 }
 
-// ConstructionCombineResponse is returned by `/construction/combine`. The
-// network payload will be sent directly to the `construction/submit` endpoint.
-type ConstructionCombineResponse struct {
-	SignedTransaction string
+// ConstructionCombineResponse is returned by `/construction/combine`. The
+// network payload will be sent directly to the `construction/submit` endpoint.
+type ConstructionCombineResponse struct {
+	SignedTransaction string
+}
+
+// DecodeJSON decodes a ConstructionCombineResponse value from JSON.
+func (v *ConstructionCombineResponse) DecodeJSON(d *json.Decoder) error {
+	more, err := d.ObjectStart()
+	if err != nil {
+		return err
+	}
+	seen := uint64(0)
+	for more {
+		var key []byte
+		if key, err = d.Key(); err != nil {
+			return err
+		}
+		switch string(key) {
+		case "signed_transaction":
+			var s []byte
+			s, err = d.String()
+			v.SignedTransaction = string(s)
+			seen |= 1 << 0
+		default:
+			if err = d.Report(json.UnknownKey); err == nil {
+				err = d.Skip()
+			}
+		}
+		if err != nil {
+			return err
+		}
+		if more, err = d.ObjectNext(); err != nil {
+			return err
+		}
+	}
+	if seen&(1<<0) == 0 {
+		return d.Errorf("missing required field \"signed_transaction\" in ConstructionCombineResponse")
+	}
+	return nil
+}
+
+// Diff returns the differences between two ConstructionCombineResponse values.
+func (v ConstructionCombineResponse) Diff(o ConstructionCombineResponse) []Difference {
+	return v.appendDiffs(nil, "", o)
+}
+
+func (v ConstructionCombineResponse) appendDiffs(d []Difference, path string, o ConstructionCombineResponse) []Difference {
+	if v.SignedTransaction != o.SignedTransaction {
+		d = append(d, Difference{
+			A:    string(json.AppendString(nil, v.SignedTransaction)),
+			B:    string(json.AppendString(nil, o.SignedTransaction)),
+			Path: diffPath(path, "signed_transaction"),
+		})
+	}
+	return d
 }
 
 // EncodeJSON encodes ConstructionCombineResponse into JSON.
@@ -1359,6 +5290,70 @@ type ConstructionDeriveRequest struct {
 	PublicKey PublicKey
 }
 
+// DecodeJSON decodes a ConstructionDeriveRequest value from JSON.
+func (v *ConstructionDeriveRequest) DecodeJSON(d *json.Decoder, network *NetworkIdentifier) error {
+	more, err := d.ObjectStart()
+	if err != nil {
+		return err
+	}
+	seen := uint64(0)
+	for more {
+		var key []byte
+		if key, err = d.Key(); err != nil {
+			return err
+		}
+		switch string(key) {
+		case "network_identifier":
+			if network == nil {
+				err = d.Skip()
+			} else {
+				err = network.DecodeJSON(d)
+			}
+		case "metadata":
+			if d.Null() {
+				v.Metadata = v.Metadata[:0]
+				err = d.Report(json.NullOptional)
+				break
+			}
+			v.Metadata, err = decodeMapObject(d, v.Metadata)
+		case "public_key":
+			err = v.PublicKey.DecodeJSON(d)
+			seen |= 1 << 0
+		default:
+			if err = d.Report(json.UnknownKey); err == nil {
+				err = d.Skip()
+			}
+		}
+		if err != nil {
+			return err
+		}
+		if more, err = d.ObjectNext(); err != nil {
+			return err
+		}
+	}
+	if seen&(1<<0) == 0 {
+		return d.Errorf("missing required field \"public_key\" in ConstructionDeriveRequest")
+	}
+	return nil
+}
+
+// Diff returns the differences between two ConstructionDeriveRequest values.
+func (v ConstructionDeriveRequest) Diff(o ConstructionDeriveRequest) []Difference {
+	return v.appendDiffs(nil, "", o)
+}
+
+func (v ConstructionDeriveRequest) appendDiffs(d []Difference, path string, o ConstructionDeriveRequest) []Difference {
+	if string(v.Metadata) != string(o.Metadata) {
+		d = append(d, Difference{
+			A:    string(appendMapObject(nil, v.Metadata)),
+			B:    string(appendMapObject(nil, o.Metadata)),
+			Path: diffPath(path, "metadata"),
+		})
+	}
+	d = v.PublicKey.appendDiffs(d, diffPath(path, "public_key"), o.PublicKey)
+	return d
+}
+
 // EncodeJSON encodes ConstructionDeriveRequest into JSON.
 func (v ConstructionDeriveRequest) EncodeJSON(b []byte, network []byte) []byte {
 	b = append(b, network...)
@@ -1388,12 +5383,102 @@ func (v *ConstructionDeriveRequest) Reset() {
 // endpoint.
 type ConstructionDeriveResponse struct {
 	AccountIdentifier OptionalAccountIdentifierType
-	// [DEPRECATED by `account_identifier` in `v1.4.4`] Address in
-	// network-specific format.
+	// [DEPRECATED by account_identifier in v1.4.4] Address in network-specific
+	// format.
 	Address  OptionalStringType
 	Metadata MapObject
 }
 
+// DecodeJSON decodes a ConstructionDeriveResponse value from JSON.
+func (v *ConstructionDeriveResponse) DecodeJSON(d *json.Decoder) error {
+	more, err := d.ObjectStart()
+	if err != nil {
+		return err
+	}
+	for more {
+		var key []byte
+		if key, err = d.Key(); err != nil {
+			return err
+		}
+		switch string(key) {
+		case "account_identifier":
+			if d.Null() {
+				v.AccountIdentifier.Set = false
+				err = d.Report(json.NullOptional)
+				break
+			}
+			v.AccountIdentifier.Set = true
+			err = v.AccountIdentifier.Value.DecodeJSON(d)
+		case "address":
+			if d.Null() {
+				v.Address.Set = false
+				err = d.Report(json.NullOptional)
+				break
+			}
+			v.Address.Set = true
+			var s []byte
+			s, err = d.String()
+			v.Address.Value = string(s)
+		case "metadata":
+			if d.Null() {
+				v.Metadata = v.Metadata[:0]
+				err = d.Report(json.NullOptional)
+				break
+			}
+			v.Metadata, err = decodeMapObject(d, v.Metadata)
+		default:
+			if err = d.Report(json.UnknownKey); err == nil {
+				err = d.Skip()
+			}
+		}
+		if err != nil {
+			return err
+		}
+		if more, err = d.ObjectNext(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Diff returns the differences between two ConstructionDeriveResponse values.
+func (v ConstructionDeriveResponse) Diff(o ConstructionDeriveResponse) []Difference {
+	return v.appendDiffs(nil, "", o)
+}
+
+func (v ConstructionDeriveResponse) appendDiffs(d []Difference, path string, o ConstructionDeriveResponse) []Difference {
+	switch {
+	case v.AccountIdentifier.Set && o.AccountIdentifier.Set:
+		d = v.AccountIdentifier.Value.appendDiffs(d, diffPath(path, "account_identifier"), o.AccountIdentifier.Value)
+	case v.AccountIdentifier.Set:
+		d = append(d, Difference{A: string(v.AccountIdentifier.Value.EncodeJSON(nil)), Path: diffPath(path, "account_identifier")})
+	case o.AccountIdentifier.Set:
+		d = append(d, Difference{B: string(o.AccountIdentifier.Value.EncodeJSON(nil)), Path: diffPath(path, "account_identifier")})
+	}
+	switch {
+	case v.Address.Set && o.Address.Set:
+		if v.Address.Value != o.Address.Value {
+			d = append(d, Difference{
+				A:    string(json.AppendString(nil, v.Address.Value)),
+				B:    string(json.AppendString(nil, o.Address.Value)),
+				Path: diffPath(path, "address"),
+			})
+		}
+	case v.Address.Set:
+		d = append(d, Difference{A: string(json.AppendString(nil, v.Address.Value)), Path: diffPath(path, "address")})
+	case o.Address.Set:
+		d = append(d, Difference{B: string(json.AppendString(nil, o.Address.Value)), Path: diffPath(path, "address")})
+	}
+	if string(v.Metadata) != string(o.Metadata) {
+		d = append(d, Difference{
+			A:    string(appendMapObject(nil, v.Metadata)),
+			B:    string(appendMapObject(nil, o.Metadata)),
+			Path: diffPath(path, "metadata"),
+		})
+	}
+	return d
+}
+
 // EncodeJSON encodes ConstructionDeriveResponse into JSON.
 func (v ConstructionDeriveResponse) EncodeJSON(b []byte) []byte {
 	b = append(b, "{"...)
@@ -1412,16 +5497,19 @@ func (v ConstructionDeriveResponse) EncodeJSON(b []byte) []byte {
 		b = append(b, v.Metadata...)
 		b = append(b, ","...)
 	}
+	if b[len(b)-1] == '{' {
+		return append(b, "}"...)
+	}
 	b[len(b)-1] = '}'
 	return b
 }
 
 // Equal returns whether two ConstructionDeriveResponse values are equal.
 func (v ConstructionDeriveResponse) Equal(o ConstructionDeriveResponse) bool {
-	return v.AccountIdentifier.Value.Equal(o.AccountIdentifier.Value) &&
-		v.AccountIdentifier.Set == o.AccountIdentifier.Set &&
-		v.Address.Value == o.Address.Value &&
+	return v.AccountIdentifier.Set == o.AccountIdentifier.Set &&
+		v.AccountIdentifier.Value.Equal(o.AccountIdentifier.Value) &&
 		v.Address.Set == o.Address.Set &&
+		v.Address.Value == o.Address.Value &&
 		string(v.Metadata) == string(o.Metadata)
 }
 
@@ -1439,6 +5527,64 @@ type ConstructionHashRequest struct {
 	SignedTransaction string
 }
 
+// DecodeJSON decodes a ConstructionHashRequest value from JSON.
+func (v *ConstructionHashRequest) DecodeJSON(d *json.Decoder, network *NetworkIdentifier) error {
+	more, err := d.ObjectStart()
+	if err != nil {
+		return err
+	}
+	seen := uint64(0)
+	for more {
+		var key []byte
+		if key, err = d.Key(); err != nil {
+			return err
+		}
+		switch string(key) {
+		case "network_identifier":
+			if network == nil {
+				err = d.Skip()
+			} else {
+				err = network.DecodeJSON(d)
+			}
+		case "signed_transaction":
+			var s []byte
+			s, err = d.String()
+			v.SignedTransaction = string(s)
+			seen |= 1 << 0
+		default:
+			if err = d.Report(json.UnknownKey); err == nil {
+				err = d.Skip()
+			}
+		}
+		if err != nil {
+			return err
+		}
+		if more, err = d.ObjectNext(); err != nil {
+			return err
+		}
+	}
+	if seen&(1<<0) == 0 {
+		return d.Errorf("missing required field \"signed_transaction\" in ConstructionHashRequest")
+	}
+	return nil
+}
+
+// Diff returns the differences between two ConstructionHashRequest values.
+func (v ConstructionHashRequest) Diff(o ConstructionHashRequest) []Difference {
+	return v.appendDiffs(nil, "", o)
+}
+
+func (v ConstructionHashRequest) appendDiffs(d []Difference, path string, o ConstructionHashRequest) []Difference {
+	if v.SignedTransaction != o.SignedTransaction {
+		d = append(d, Difference{
+			A:    string(json.AppendString(nil, v.SignedTransaction)),
+			B:    string(json.AppendString(nil, o.SignedTransaction)),
+			Path: diffPath(path, "signed_transaction"),
+		})
+	}
+	return d
+}
+
 // EncodeJSON encodes ConstructionHashRequest into JSON.
 func (v ConstructionHashRequest) EncodeJSON(b []byte, network []byte) []byte {
 	b = append(b, network...)
@@ -1460,14 +5606,12 @@ func (v *ConstructionHashRequest) Reset() {
 // ConstructionMetadataRequest type.
 //
 // A ConstructionMetadataRequest is utilized to get information required to
-// construct a transaction.
-//
-// The Options object used to specify which metadata to return is left purposely
-// unstructured to allow flexibility for implementers. Options is not required
-// in the case that there is network-wide metadata of interest.
-//
-// Optionally, the request can also include an array of PublicKeys associated
-// with the AccountIdentifiers returned in ConstructionPreprocessResponse.
+// construct a transaction. The Options object used to specify which metadata to
+// return is left purposely unstructured to allow flexibility for implementers.
+// Options is not required in the case that there is network-wide metadata of
+// interest. Optionally, the request can also include an array of PublicKeys
+// associated with the AccountIdentifiers returned in
+// ConstructionPreprocessResponse.
 type ConstructionMetadataRequest struct {
 	// Some blockchains require different metadata for different types of
 	// transaction construction (ex: delegation versus a transfer). Instead of
@@ -1479,6 +5623,102 @@ type ConstructionMetadataRequest struct {
 	PublicKeys []PublicKey
 }
 
+// DecodeJSON decodes a ConstructionMetadataRequest value from JSON.
+func (v *ConstructionMetadataRequest) DecodeJSON(d *json.Decoder, network *NetworkIdentifier) error {
+	more, err := d.ObjectStart()
+	if err != nil {
+		return err
+	}
+	for more {
+		var key []byte
+		if key, err = d.Key(); err != nil {
+			return err
+		}
+		switch string(key) {
+		case "network_identifier":
+			if network == nil {
+				err = d.Skip()
+			} else {
+				err = network.DecodeJSON(d)
+			}
+		case "options":
+			if d.Null() {
+				v.Options = v.Options[:0]
+				err = d.Report(json.NullOptional)
+				break
+			}
+			v.Options, err = decodeMapObject(d, v.Options)
+		case "public_keys":
+			if d.Null() {
+				v.PublicKeys = v.PublicKeys[:0]
+				err = d.Report(json.NullOptional)
+				break
+			}
+			v.PublicKeys = v.PublicKeys[:0]
+			if d.Null() {
+				break
+			}
+			var next bool
+			if next, err = d.ArrayStart(); err != nil {
+				break
+			}
+			for next {
+				n := len(v.PublicKeys)
+				if n < cap(v.PublicKeys) {
+					v.PublicKeys = v.PublicKeys[:n+1]
+					v.PublicKeys[n].Reset()
+				} else {
+					v.PublicKeys = append(v.PublicKeys, PublicKey{})
+				}
+				if err = v.PublicKeys[n].DecodeJSON(d); err != nil {
+					break
+				}
+				if next, err = d.ArrayNext(); err != nil {
+					break
+				}
+			}
+		default:
+			if err = d.Report(json.UnknownKey); err == nil {
+				err = d.Skip()
+			}
+		}
+		if err != nil {
+			return err
+		}
+		if more, err = d.ObjectNext(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Diff returns the differences between two ConstructionMetadataRequest values.
+func (v ConstructionMetadataRequest) Diff(o ConstructionMetadataRequest) []Difference {
+	return v.appendDiffs(nil, "", o)
+}
+
+func (v ConstructionMetadataRequest) appendDiffs(d []Difference, path string, o ConstructionMetadataRequest) []Difference {
+	if string(v.Options) != string(o.Options) {
+		d = append(d, Difference{
+			A:    string(appendMapObject(nil, v.Options)),
+			B:    string(appendMapObject(nil, o.Options)),
+			Path: diffPath(path, "options"),
+		})
+	}
+	for i := 0; i < len(v.PublicKeys) || i < len(o.PublicKeys); i++ {
+		p := diffIndex(diffPath(path, "public_keys"), i)
+		switch {
+		case i >= len(o.PublicKeys):
+			d = append(d, Difference{A: string(v.PublicKeys[i].EncodeJSON(nil)), Path: p})
+		case i >= len(v.PublicKeys):
+			d = append(d, Difference{B: string(o.PublicKeys[i].EncodeJSON(nil)), Path: p})
+		default:
+			d = v.PublicKeys[i].appendDiffs(d, p, o.PublicKeys[i])
+		}
+	}
+	return d
+}
+
 // EncodeJSON encodes ConstructionMetadataRequest into JSON.
 func (v ConstructionMetadataRequest) EncodeJSON(b []byte, network []byte) []byte {
 	b = append(b, network...)
@@ -1517,18 +5757,107 @@ func (v *ConstructionMetadataRequest) Reset() {
 // ConstructionMetadataResponse type.
 //
 // The ConstructionMetadataResponse returns network-specific metadata used for
-// transaction construction.
-//
-// Optionally, the implementer can return the suggested fee associated with the
-// transaction being constructed. The caller may use this info to adjust the
-// intent of the transaction or to create a transaction with a different account
-// that can pay the suggested fee. Suggested fee is an array in case fee payment
-// must occur in multiple currencies.
+// transaction construction. Optionally, the implementer can return the
+// suggested fee associated with the transaction being constructed. The caller
+// may use this info to adjust the intent of the transaction or to create a
+// transaction with a different account that can pay the suggested fee.
+// Suggested fee is an array in case fee payment must occur in multiple
+// currencies.
 type ConstructionMetadataResponse struct {
 	Metadata     MapObject
 	SuggestedFee []Amount
 }
 
+// DecodeJSON decodes a ConstructionMetadataResponse value from JSON.
+func (v *ConstructionMetadataResponse) DecodeJSON(d *json.Decoder) error {
+	more, err := d.ObjectStart()
+	if err != nil {
+		return err
+	}
+	seen := uint64(0)
+	for more {
+		var key []byte
+		if key, err = d.Key(); err != nil {
+			return err
+		}
+		switch string(key) {
+		case "metadata":
+			v.Metadata, err = decodeMapObject(d, v.Metadata)
+			seen |= 1 << 0
+		case "suggested_fee":
+			if d.Null() {
+				v.SuggestedFee = v.SuggestedFee[:0]
+				err = d.Report(json.NullOptional)
+				break
+			}
+			v.SuggestedFee = v.SuggestedFee[:0]
+			if d.Null() {
+				break
+			}
+			var next bool
+			if next, err = d.ArrayStart(); err != nil {
+				break
+			}
+			for next {
+				n := len(v.SuggestedFee)
+				if n < cap(v.SuggestedFee) {
+					v.SuggestedFee = v.SuggestedFee[:n+1]
+					v.SuggestedFee[n].Reset()
+				} else {
+					v.SuggestedFee = append(v.SuggestedFee, Amount{})
+				}
+				if err = v.SuggestedFee[n].DecodeJSON(d); err != nil {
+					break
+				}
+				if next, err = d.ArrayNext(); err != nil {
+					break
+				}
+			}
+		default:
+			if err = d.Report(json.UnknownKey); err == nil {
+				err = d.Skip()
+			}
+		}
+		if err != nil {
+			return err
+		}
+		if more, err = d.ObjectNext(); err != nil {
+			return err
+		}
+	}
+	if seen&(1<<0) == 0 {
+		return d.Errorf("missing required field \"metadata\" in ConstructionMetadataResponse")
+	}
+	return nil
+}
+
+// Diff returns the differences between two ConstructionMetadataResponse values.
+func (v ConstructionMetadataResponse) Diff(o ConstructionMetadataResponse) []Difference {
+	return v.appendDiffs(nil, "", o)
+}
+
+func (v ConstructionMetadataResponse) appendDiffs(d []Difference, path string, o ConstructionMetadataResponse) []Difference {
+	if string(v.Metadata) != string(o.Metadata) {
+		d = append(d, Difference{
+			A:    string(appendMapObject(nil, v.Metadata)),
+			B:    string(appendMapObject(nil, o.Metadata)),
+			Path: diffPath(path, "metadata"),
+		})
+	}
+	for i := 0; i < len(v.SuggestedFee) || i < len(o.SuggestedFee); i++ {
+		p := diffIndex(diffPath(path, "suggested_fee"), i)
+		switch {
+		case i >= len(o.SuggestedFee):
+			d = append(d, Difference{A: string(v.SuggestedFee[i].EncodeJSON(nil)), Path: p})
+		case i >= len(v.SuggestedFee):
+			d = append(d, Difference{B: string(o.SuggestedFee[i].EncodeJSON(nil)), Path: p})
+		default:
+			d = v.SuggestedFee[i].appendDiffs(d, p, o.SuggestedFee[i])
+		}
+	}
+	return d
+}
+
 // EncodeJSON encodes ConstructionMetadataResponse into JSON.
 func (v ConstructionMetadataResponse) EncodeJSON(b []byte) []byte {
 	b = append(b, `{"metadata":`...)
@@ -1572,6 +5901,77 @@ type ConstructionParseRequest struct {
 	Transaction string
 }
 
+// DecodeJSON decodes a ConstructionParseRequest value from JSON.
+func (v *ConstructionParseRequest) DecodeJSON(d *json.Decoder, network *NetworkIdentifier) error {
+	more, err := d.ObjectStart()
+	if err != nil {
+		return err
+	}
+	seen := uint64(0)
+	for more {
+		var key []byte
+		if key, err = d.Key(); err != nil {
+			return err
+		}
+		switch string(key) {
+		case "network_identifier":
+			if network == nil {
+				err = d.Skip()
+			} else {
+				err = network.DecodeJSON(d)
+			}
+		case "signed":
+			v.Signed, err = d.Bool()
+			seen |= 1 << 0
+		case "transaction":
+			var s []byte
+			s, err = d.String()
+			v.Transaction = string(s)
+			seen |= 1 << 1
+		default:
+			if err = d.Report(json.UnknownKey); err == nil {
+				err = d.Skip()
+			}
+		}
+		if err != nil {
+			return err
+		}
+		if more, err = d.ObjectNext(); err != nil {
+			return err
+		}
+	}
+	if seen&(1<<0) == 0 {
+		return d.Errorf("missing required field \"signed\" in ConstructionParseRequest")
+	}
+	if seen&(1<<1) == 0 {
+		return d.Errorf("missing required field \"transaction\" in ConstructionParseRequest")
+	}
+	return nil
+}
+
+// Diff returns the differences between two ConstructionParseRequest values.
+func (v ConstructionParseRequest) Diff(o ConstructionParseRequest) []Difference {
+	return v.appendDiffs(nil, "", o)
+}
+
+func (v ConstructionParseRequest) appendDiffs(d []Difference, path string, o ConstructionParseRequest) []Difference {
+	if v.Signed != o.Signed {
+		d = append(d, Difference{
+			A:    string(json.AppendBool(nil, v.Signed)),
+			B:    string(json.AppendBool(nil, o.Signed)),
+			Path: diffPath(path, "signed"),
+		})
+	}
+	if v.Transaction != o.Transaction {
+		d = append(d, Difference{
+			A:    string(json.AppendString(nil, v.Transaction)),
+			B:    string(json.AppendString(nil, o.Transaction)),
+			Path: diffPath(path, "transaction"),
+		})
+	}
+	return d
+}
+
 // EncodeJSON encodes ConstructionParseRequest into JSON.
 func (v ConstructionParseRequest) EncodeJSON(b []byte, network []byte) []byte {
 	b = append(b, network...)
@@ -1601,12 +6001,150 @@ type ConstructionParseResponse struct {
 	AccountIdentifierSigners []AccountIdentifier
 	Metadata                 MapObject
 	Operations               []Operation
-	// [DEPRECATED by `account_identifier_signers` in `v1.4.4`] All signers
+	// [DEPRECATED by account_identifier_signers in v1.4.4] All signers
 	// (addresses) of a particular transaction. If the transaction is unsigned,
 	// it should be empty.
 	Signers []string
 }
 
+// DecodeJSON decodes a ConstructionParseResponse value from JSON.
+func (v *ConstructionParseResponse) DecodeJSON(d *json.Decoder) error {
+	more, err := d.ObjectStart()
+	if err != nil {
+		return err
+	}
+	seen := uint64(0)
+	for more {
+		var key []byte
+		if key, err = d.Key(); err != nil {
+			return err
+		}
+		switch string(key) {
+		case "account_identifier_signers":
+			if d.Null() {
+				v.AccountIdentifierSigners = v.AccountIdentifierSigners[:0]
+				err = d.Report(json.NullOptional)
+				break
+			}
+			v.AccountIdentifierSigners = v.AccountIdentifierSigners[:0]
+			if d.Null() {
+				break
+			}
+			var next bool
+			if next, err = d.ArrayStart(); err != nil {
+				break
+			}
+			for next {
+				n := len(v.AccountIdentifierSigners)
+				if n < cap(v.AccountIdentifierSigners) {
+					v.AccountIdentifierSigners = v.AccountIdentifierSigners[:n+1]
+					v.AccountIdentifierSigners[n].Reset()
+				} else {
+					v.AccountIdentifierSigners = append(v.AccountIdentifierSigners, AccountIdentifier{})
+				}
+				if err = v.AccountIdentifierSigners[n].DecodeJSON(d); err != nil {
+					break
+				}
+				if next, err = d.ArrayNext(); err != nil {
+					break
+				}
+			}
+		case "metadata":
+			if d.Null() {
+				v.Metadata = v.Metadata[:0]
+				err = d.Report(json.NullOptional)
+				break
+			}
+			v.Metadata, err = decodeMapObject(d, v.Metadata)
+		case "operations":
+			v.Operations = v.Operations[:0]
+			if d.Null() {
+				break
+			}
+			var next bool
+			if next, err = d.ArrayStart(); err != nil {
+				break
+			}
+			for next {
+				n := len(v.Operations)
+				if n < cap(v.Operations) {
+					v.Operations = v.Operations[:n+1]
+					v.Operations[n].Reset()
+				} else {
+					v.Operations = append(v.Operations, Operation{})
+				}
+				if err = v.Operations[n].DecodeJSON(d); err != nil {
+					break
+				}
+				if next, err = d.ArrayNext(); err != nil {
+					break
+				}
+			}
+			seen |= 1 << 0
+		case "signers":
+			if d.Null() {
+				v.Signers = v.Signers[:0]
+				err = d.Report(json.NullOptional)
+				break
+			}
+			v.Signers, err = decodeStrings(d, v.Signers[:0])
+		default:
+			if err = d.Report(json.UnknownKey); err == nil {
+				err = d.Skip()
+			}
+		}
+		if err != nil {
+			return err
+		}
+		if more, err = d.ObjectNext(); err != nil {
+			return err
+		}
+	}
+	if seen&(1<<0) == 0 {
+		return d.Errorf("missing required field \"operations\" in ConstructionParseResponse")
+	}
+	return nil
+}
+
+// Diff returns the differences between two ConstructionParseResponse values.
+func (v ConstructionParseResponse) Diff(o ConstructionParseResponse) []Difference {
+	return v.appendDiffs(nil, "", o)
+}
+
+func (v ConstructionParseResponse) appendDiffs(d []Difference, path string, o ConstructionParseResponse) []Difference {
+	for i := 0; i < len(v.AccountIdentifierSigners) || i < len(o.AccountIdentifierSigners); i++ {
+		p := diffIndex(diffPath(path, "account_identifier_signers"), i)
+		switch {
+		case i >= len(o.AccountIdentifierSigners):
+			d = append(d, Difference{A: string(v.AccountIdentifierSigners[i].EncodeJSON(nil)), Path: p})
+		case i >= len(v.AccountIdentifierSigners):
+			d = append(d, Difference{B: string(o.AccountIdentifierSigners[i].EncodeJSON(nil)), Path: p})
+		default:
+			d = v.AccountIdentifierSigners[i].appendDiffs(d, p, o.AccountIdentifierSigners[i])
+		}
+	}
+	if string(v.Metadata) != string(o.Metadata) {
+		d = append(d, Difference{
+			A:    string(appendMapObject(nil, v.Metadata)),
+			B:    string(appendMapObject(nil, o.Metadata)),
+			Path: diffPath(path, "metadata"),
+		})
+	}
+	for i := 0; i < len(v.Operations) || i < len(o.Operations); i++ {
+		p := diffIndex(diffPath(path, "operations"), i)
+		switch {
+		case i >= len(o.Operations):
+			d = append(d, Difference{A: string(v.Operations[i].EncodeJSON(nil)), Path: p})
+		case i >= len(v.Operations):
+			d = append(d, Difference{B: string(o.Operations[i].EncodeJSON(nil)), Path: p})
+		default:
+			d = v.Operations[i].appendDiffs(d, p, o.Operations[i])
+		}
+	}
+	d = appendStringSliceDiffs(d, diffPath(path, "signers"), v.Signers, o.Signers)
+	return d
+}
+
 // EncodeJSON encodes ConstructionParseResponse into JSON.
 func (v ConstructionParseResponse) EncodeJSON(b []byte) []byte {
 	b = append(b, "{"...)
@@ -1668,16 +6206,151 @@ func (v *ConstructionParseResponse) Reset() {
 
 // ConstructionPayloadsRequest is the request to `/construction/payloads`. It
 // contains the network, a slice of operations, and arbitrary metadata that was
-// returned by the call to `/construction/metadata`.
-//
-// Optionally, the request can also include an array of PublicKeys associated
-// with the AccountIdentifiers returned in ConstructionPreprocessResponse.
+// returned by the call to `/construction/metadata`. Optionally, the request can
+// also include an array of PublicKeys associated with the AccountIdentifiers
+// returned in ConstructionPreprocessResponse.
 type ConstructionPayloadsRequest struct {
 	Metadata   MapObject
 	Operations []Operation
 	PublicKeys []PublicKey
 }
 
+// DecodeJSON decodes a ConstructionPayloadsRequest value from JSON.
+func (v *ConstructionPayloadsRequest) DecodeJSON(d *json.Decoder, network *NetworkIdentifier) error {
+	more, err := d.ObjectStart()
+	if err != nil {
+		return err
+	}
+	seen := uint64(0)
+	for more {
+		var key []byte
+		if key, err = d.Key(); err != nil {
+			return err
+		}
+		switch string(key) {
+		case "network_identifier":
+			if network == nil {
+				err = d.Skip()
+			} else {
+				err = network.DecodeJSON(d)
+			}
+		case "metadata":
+			if d.Null() {
+				v.Metadata = v.Metadata[:0]
+				err = d.Report(json.NullOptional)
+				break
+			}
+			v.Metadata, err = decodeMapObject(d, v.Metadata)
+		case "operations":
+			v.Operations = v.Operations[:0]
+			if d.Null() {
+				break
+			}
+			var next bool
+			if next, err = d.ArrayStart(); err != nil {
+				break
+			}
+			for next {
+				n := len(v.Operations)
+				if n < cap(v.Operations) {
+					v.Operations = v.Operations[:n+1]
+					v.Operations[n].Reset()
+				} else {
+					v.Operations = append(v.Operations, Operation{})
+				}
+				if err = v.Operations[n].DecodeJSON(d); err != nil {
+					break
+				}
+				if next, err = d.ArrayNext(); err != nil {
+					break
+				}
+			}
+			seen |= 1 << 0
+		case "public_keys":
+			if d.Null() {
+				v.PublicKeys = v.PublicKeys[:0]
+				err = d.Report(json.NullOptional)
+				break
+			}
+			v.PublicKeys = v.PublicKeys[:0]
+			if d.Null() {
+				break
+			}
+			var next bool
+			if next, err = d.ArrayStart(); err != nil {
+				break
+			}
+			for next {
+				n := len(v.PublicKeys)
+				if n < cap(v.PublicKeys) {
+					v.PublicKeys = v.PublicKeys[:n+1]
+					v.PublicKeys[n].Reset()
+				} else {
+					v.PublicKeys = append(v.PublicKeys, PublicKey{})
+				}
+				if err = v.PublicKeys[n].DecodeJSON(d); err != nil {
+					break
+				}
+				if next, err = d.ArrayNext(); err != nil {
+					break
+				}
+			}
+		default:
+			if err = d.Report(json.UnknownKey); err == nil {
+				err = d.Skip()
+			}
+		}
+		if err != nil {
+			return err
+		}
+		if more, err = d.ObjectNext(); err != nil {
+			return err
+		}
+	}
+	if seen&(1<<0) == 0 {
+		return d.Errorf("missing required field \"operations\" in ConstructionPayloadsRequest")
+	}
+	return nil
+}
+
+// Diff returns the differences between two ConstructionPayloadsRequest values.
+func (v ConstructionPayloadsRequest) Diff(o ConstructionPayloadsRequest) []Difference {
+	return v.appendDiffs(nil, "", o)
+}
+
+func (v ConstructionPayloadsRequest) appendDiffs(d []Difference, path string, o ConstructionPayloadsRequest) []Difference {
+	if string(v.Metadata) != string(o.Metadata) {
+		d = append(d, Difference{
+			A:    string(appendMapObject(nil, v.Metadata)),
+			B:    string(appendMapObject(nil, o.Metadata)),
+			Path: diffPath(path, "metadata"),
+		})
+	}
+	for i := 0; i < len(v.Operations) || i < len(o.Operations); i++ {
+		p := diffIndex(diffPath(path, "operations"), i)
+		switch {
+		case i >= len(o.Operations):
+			d = append(d, Difference{A: string(v.Operations[i].EncodeJSON(nil)), Path: p})
+		case i >= len(v.Operations):
+			d = append(d, Difference{B: string(o.Operations[i].EncodeJSON(nil)), Path: p})
+		default:
+			d = v.Operations[i].appendDiffs(d, p, o.Operations[i])
+		}
+	}
+	for i := 0; i < len(v.PublicKeys) || i < len(o.PublicKeys); i++ {
+		p := diffIndex(diffPath(path, "public_keys"), i)
+		switch {
+		case i >= len(o.PublicKeys):
+			d = append(d, Difference{A: string(v.PublicKeys[i].EncodeJSON(nil)), Path: p})
+		case i >= len(v.PublicKeys):
+			d = append(d, Difference{B: string(o.PublicKeys[i].EncodeJSON(nil)), Path: p})
+		default:
+			d = v.PublicKeys[i].appendDiffs(d, p, o.PublicKeys[i])
+		}
+	}
+	return d
+}
+
 // EncodeJSON encodes ConstructionPayloadsRequest into JSON.
 func (v ConstructionPayloadsRequest) EncodeJSON(b []byte, network []byte) []byte {
 	b = append(b, network...)
@@ -1735,6 +6408,97 @@ type ConstructionPayloadsResponse struct {
 	UnsignedTransaction string
 }
 
+// DecodeJSON decodes a ConstructionPayloadsResponse value from JSON.
+func (v *ConstructionPayloadsResponse) DecodeJSON(d *json.Decoder) error {
+	more, err := d.ObjectStart()
+	if err != nil {
+		return err
+	}
+	seen := uint64(0)
+	for more {
+		var key []byte
+		if key, err = d.Key(); err != nil {
+			return err
+		}
+		switch string(key) {
+		case "payloads":
+			v.Payloads = v.Payloads[:0]
+			if d.Null() {
+				break
+			}
+			var next bool
+			if next, err = d.ArrayStart(); err != nil {
+				break
+			}
+			for next {
+				n := len(v.Payloads)
+				if n < cap(v.Payloads) {
+					v.Payloads = v.Payloads[:n+1]
+					v.Payloads[n].Reset()
+				} else {
+					v.Payloads = append(v.Payloads, SigningPayload{})
+				}
+				if err = v.Payloads[n].DecodeJSON(d); err != nil {
+					break
+				}
+				if next, err = d.ArrayNext(); err != nil {
+					break
+				}
+			}
+			seen |= 1 << 0
+		case "unsigned_transaction":
+			var s []byte
+			s, err = d.String()
+			v.UnsignedTransaction = string(s)
+			seen |= 1 << 1
+		default:
+			if err = d.Report(json.UnknownKey); err == nil {
+				err = d.Skip()
+			}
+		}
+		if err != nil {
+			return err
+		}
+		if more, err = d.ObjectNext(); err != nil {
+			return err
+		}
+	}
+	if seen&(1<<0) == 0 {
+		return d.Errorf("missing required field \"payloads\" in ConstructionPayloadsResponse")
+	}
+	if seen&(1<<1) == 0 {
+		return d.Errorf("missing required field \"unsigned_transaction\" in ConstructionPayloadsResponse")
+	}
+	return nil
+}
+
+// Diff returns the differences between two ConstructionPayloadsResponse values.
+func (v ConstructionPayloadsResponse) Diff(o ConstructionPayloadsResponse) []Difference {
+	return v.appendDiffs(nil, "", o)
+}
+
+func (v ConstructionPayloadsResponse) appendDiffs(d []Difference, path string, o ConstructionPayloadsResponse) []Difference {
+	for i := 0; i < len(v.Payloads) || i < len(o.Payloads); i++ {
+		p := diffIndex(diffPath(path, "payloads"), i)
+		switch {
+		case i >= len(o.Payloads):
+			d = append(d, Difference{A: string(v.Payloads[i].EncodeJSON(nil)), Path: p})
+		case i >= len(v.Payloads):
+			d = append(d, Difference{B: string(o.Payloads[i].EncodeJSON(nil)), Path: p})
+		default:
+			d = v.Payloads[i].appendDiffs(d, p, o.Payloads[i])
+		}
+	}
+	if v.UnsignedTransaction != o.UnsignedTransaction {
+		d = append(d, Difference{
+			A:    string(json.AppendString(nil, v.UnsignedTransaction)),
+			B:    string(json.AppendString(nil, o.UnsignedTransaction)),
+			Path: diffPath(path, "unsigned_transaction"),
+		})
+	}
+	return d
+}
+
 // EncodeJSON encodes ConstructionPayloadsResponse into JSON.
 func (v ConstructionPayloadsResponse) EncodeJSON(b []byte) []byte {
 	b = append(b, `{"payloads":[`...)
@@ -1764,27 +6528,21 @@ func (v *ConstructionPayloadsResponse) Reset() {
 
 // ConstructionPreprocessRequest is passed to the `/construction/preprocess`
 // endpoint so that a Rosetta implementation can determine which metadata it
-// needs to request for construction.
-//
-// Metadata provided in this object should NEVER be a product of live data (i.e.
-// the caller must follow some network-specific data fetching strategy outside
-// of the Construction API to populate required Metadata). If live data is
-// required for construction, it MUST be fetched in the call to
-// `/construction/metadata`.
-//
-// The caller can provide a max fee they are willing to pay for a transaction.
-// This is an array in the case fees must be paid in multiple currencies.
-//
-// The caller can also provide a suggested fee multiplier to indicate that the
-// suggested fee should be scaled. This may be used to set higher fees for
-// urgent transactions or to pay lower fees when there is less urgency. It is
-// assumed that providing a very low multiplier (like 0.0001) will never lead to
-// a transaction being created with a fee less than the minimum network fee (if
-// applicable).
-//
-// In the case that the caller provides both a max fee and a suggested fee
-// multiplier, the max fee will set an upper bound on the suggested fee
-// (regardless of the multiplier provided).
+// needs to request for construction. Metadata provided in this object should
+// NEVER be a product of live data (i.e. the caller must follow some
+// network-specific data fetching strategy outside of the Construction API to
+// populate required Metadata). If live data is required for construction, it
+// MUST be fetched in the call to `/construction/metadata`. The caller can
+// provide a max fee they are willing to pay for a transaction. This is an array
+// in the case fees must be paid in multiple currencies. The caller can also
+// provide a suggested fee multiplier to indicate that the suggested fee should
+// be scaled. This may be used to set higher fees for urgent transactions or to
+// pay lower fees when there is less urgency. It is assumed that providing a
+// very low multiplier (like 0.0001) will never lead to a transaction being
+// created with a fee less than the minimum network fee (if applicable). In the
+// case that the caller provides both a max fee and a suggested fee multiplier,
+// the max fee will set an upper bound on the suggested fee (regardless of the
+// multiplier provided).
 type ConstructionPreprocessRequest struct {
 	MaxFee                 []Amount
 	Metadata               MapObject
@@ -1792,6 +6550,164 @@ type ConstructionPreprocessRequest struct {
 	SuggestedFeeMultiplier OptionalFloat64Type
 }
 
+// DecodeJSON decodes a ConstructionPreprocessRequest value from JSON.
+func (v *ConstructionPreprocessRequest) DecodeJSON(d *json.Decoder, network *NetworkIdentifier) error {
+	more, err := d.ObjectStart()
+	if err != nil {
+		return err
+	}
+	seen := uint64(0)
+	for more {
+		var key []byte
+		if key, err = d.Key(); err != nil {
+			return err
+		}
+		switch string(key) {
+		case "network_identifier":
+			if network == nil {
+				err = d.Skip()
+			} else {
+				err = network.DecodeJSON(d)
+			}
+		case "max_fee":
+			if d.Null() {
+				v.MaxFee = v.MaxFee[:0]
+				err = d.Report(json.NullOptional)
+				break
+			}
+			v.MaxFee = v.MaxFee[:0]
+			if d.Null() {
+				break
+			}
+			var next bool
+			if next, err = d.ArrayStart(); err != nil {
+				break
+			}
+			for next {
+				n := len(v.MaxFee)
+				if n < cap(v.MaxFee) {
+					v.MaxFee = v.MaxFee[:n+1]
+					v.MaxFee[n].Reset()
+				} else {
+					v.MaxFee = append(v.MaxFee, Amount{})
+				}
+				if err = v.MaxFee[n].DecodeJSON(d); err != nil {
+					break
+				}
+				if next, err = d.ArrayNext(); err != nil {
+					break
+				}
+			}
+		case "metadata":
+			if d.Null() {
+				v.Metadata = v.Metadata[:0]
+				err = d.Report(json.NullOptional)
+				break
+			}
+			v.Metadata, err = decodeMapObject(d, v.Metadata)
+		case "operations":
+			v.Operations = v.Operations[:0]
+			if d.Null() {
+				break
+			}
+			var next bool
+			if next, err = d.ArrayStart(); err != nil {
+				break
+			}
+			for next {
+				n := len(v.Operations)
+				if n < cap(v.Operations) {
+					v.Operations = v.Operations[:n+1]
+					v.Operations[n].Reset()
+				} else {
+					v.Operations = append(v.Operations, Operation{})
+				}
+				if err = v.Operations[n].DecodeJSON(d); err != nil {
+					break
+				}
+				if next, err = d.ArrayNext(); err != nil {
+					break
+				}
+			}
+			seen |= 1 << 0
+		case "suggested_fee_multiplier":
+			if d.Null() {
+				v.SuggestedFeeMultiplier.Set = false
+				err = d.Report(json.NullOptional)
+				break
+			}
+			v.SuggestedFeeMultiplier.Set = true
+			v.SuggestedFeeMultiplier.Value, err = d.Float64()
+		default:
+			if err = d.Report(json.UnknownKey); err == nil {
+				err = d.Skip()
+			}
+		}
+		if err != nil {
+			return err
+		}
+		if more, err = d.ObjectNext(); err != nil {
+			return err
+		}
+	}
+	if seen&(1<<0) == 0 {
+		return d.Errorf("missing required field \"operations\" in ConstructionPreprocessRequest")
+	}
+	return nil
+}
+
+// Diff returns the differences between two ConstructionPreprocessRequest values.
+func (v ConstructionPreprocessRequest) Diff(o ConstructionPreprocessRequest) []Difference {
+	return v.appendDiffs(nil, "", o)
+}
+
+func (v ConstructionPreprocessRequest) appendDiffs(d []Difference, path string, o ConstructionPreprocessRequest) []Difference {
+	for i := 0; i < len(v.MaxFee) || i < len(o.MaxFee); i++ {
+		p := diffIndex(diffPath(path, "max_fee"), i)
+		switch {
+		case i >= len(o.MaxFee):
+			d = append(d, Difference{A: string(v.MaxFee[i].EncodeJSON(nil)), Path: p})
+		case i >= len(v.MaxFee):
+			d = append(d, Difference{B: string(o.MaxFee[i].EncodeJSON(nil)), Path: p})
+		default:
+			d = v.MaxFee[i].appendDiffs(d, p, o.MaxFee[i])
+		}
+	}
+	if string(v.Metadata) != string(o.Metadata) {
+		d = append(d, Difference{
+			A:    string(appendMapObject(nil, v.Metadata)),
+			B:    string(appendMapObject(nil, o.Metadata)),
+			Path: diffPath(path, "metadata"),
+		})
+	}
+	for i := 0; i < len(v.Operations) || i < len(o.Operations); i++ {
+		p := diffIndex(diffPath(path, "operations"), i)
+		switch {
+		case i >= len(o.Operations):
+			d = append(d, Difference{A: string(v.Operations[i].EncodeJSON(nil)), Path: p})
+		case i >= len(v.Operations):
+			d = append(d, Difference{B: string(o.Operations[i].EncodeJSON(nil)), Path: p})
+		default:
+			d = v.Operations[i].appendDiffs(d, p, o.Operations[i])
+		}
+	}
+	switch {
+	case v.SuggestedFeeMultiplier.Set && o.SuggestedFeeMultiplier.Set:
+		if v.SuggestedFeeMultiplier.Value != o.SuggestedFeeMultiplier.Value {
+			d = append(d, Difference{
+				A:    string(json.AppendFloat(nil, v.SuggestedFeeMultiplier.Value)),
+				B:    string(json.AppendFloat(nil, o.SuggestedFeeMultiplier.Value)),
+				Path: diffPath(path, "suggested_fee_multiplier"),
+			})
+		}
+	case v.SuggestedFeeMultiplier.Set:
+		d = append(d, Difference{A: string(json.AppendFloat(nil, v.SuggestedFeeMultiplier.Value)), Path: diffPath(path, "suggested_fee_multiplier")})
+	case o.SuggestedFeeMultiplier.Set:
+		d = append(d, Difference{B: string(json.AppendFloat(nil, o.SuggestedFeeMultiplier.Value)), Path: diffPath(path, "suggested_fee_multiplier")})
+	}
+	return d
+}
+
 // EncodeJSON encodes ConstructionPreprocessRequest into JSON.
 func (v ConstructionPreprocessRequest) EncodeJSON(b []byte, network []byte) []byte {
 	b = append(b, network...)
@@ -1834,8 +6750,8 @@ func (v ConstructionPreprocessRequest) Equal(o ConstructionPreprocessRequest) bo
 		string(v.Metadata) == string(o.Metadata) &&
 		len(v.Operations) == len(o.Operations) &&
 		operationSliceEqual(v.Operations, o.Operations) &&
-		v.SuggestedFeeMultiplier.Value == o.SuggestedFeeMultiplier.Value &&
-		v.SuggestedFeeMultiplier.Set == o.SuggestedFeeMultiplier.Set
+		v.SuggestedFeeMultiplier.Set == o.SuggestedFeeMultiplier.Set &&
+		v.SuggestedFeeMultiplier.Value == o.SuggestedFeeMultiplier.Value
 }
 
 // Reset resets ConstructionPreprocessRequest so that it can be reused.
@@ -1849,9 +6765,8 @@ func (v *ConstructionPreprocessRequest) Reset() {
 
 // ConstructionPreprocessResponse contains `options` that will be sent
 // unmodified to `/construction/metadata`. If it is not necessary to make a
-// request to `/construction/metadata`, `options` should be omitted.
-//
-// Some blockchains require the PublicKey of particular AccountIdentifiers to
+// request to `/construction/metadata`, `options` should be omitted. Some
+// blockchains require the PublicKey of particular AccountIdentifiers to
 // construct a valid transaction. To fetch these PublicKeys, populate
 // `required_public_keys` with the AccountIdentifiers associated with the
 // desired PublicKeys. If it is not necessary to retrieve any PublicKeys for
@@ -1863,6 +6778,96 @@ type ConstructionPreprocessResponse struct {
 	RequiredPublicKeys []AccountIdentifier
 }
 
+// DecodeJSON decodes a ConstructionPreprocessResponse value from JSON.
+func (v *ConstructionPreprocessResponse) DecodeJSON(d *json.Decoder) error {
+	more, err := d.ObjectStart()
+	if err != nil {
+		return err
+	}
+	for more {
+		var key []byte
+		if key, err = d.Key(); err != nil {
+			return err
+		}
+		switch string(key) {
+		case "options":
+			if d.Null() {
+				v.Options = v.Options[:0]
+				err = d.Report(json.NullOptional)
+				break
+			}
+			v.Options, err = decodeMapObject(d, v.Options)
+		case "required_public_keys":
+			if d.Null() {
+				v.RequiredPublicKeys = v.RequiredPublicKeys[:0]
+				err = d.Report(json.NullOptional)
+				break
+			}
+			v.RequiredPublicKeys = v.RequiredPublicKeys[:0]
+			if d.Null() {
+				break
+			}
+			var next bool
+			if next, err = d.ArrayStart(); err != nil {
+				break
+			}
+			for next {
+				n := len(v.RequiredPublicKeys)
+				if n < cap(v.RequiredPublicKeys) {
+					v.RequiredPublicKeys = v.RequiredPublicKeys[:n+1]
+					v.RequiredPublicKeys[n].Reset()
+				} else {
+					v.RequiredPublicKeys = append(v.RequiredPublicKeys, AccountIdentifier{})
+				}
+				if err = v.RequiredPublicKeys[n].DecodeJSON(d); err != nil {
+					break
+				}
+				if next, err = d.ArrayNext(); err != nil {
+					break
+				}
+			}
+		default:
+			if err = d.Report(json.UnknownKey); err == nil {
+				err = d.Skip()
+			}
+		}
+		if err != nil {
+			return err
+		}
+		if more, err = d.ObjectNext(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Diff returns the differences between two ConstructionPreprocessResponse values.
+func (v ConstructionPreprocessResponse) Diff(o ConstructionPreprocessResponse) []Difference {
+	return v.appendDiffs(nil, "", o)
+}
+
+func (v ConstructionPreprocessResponse) appendDiffs(d []Difference, path string, o ConstructionPreprocessResponse) []Difference {
+	if string(v.Options) != string(o.Options) {
+		d = append(d, Difference{
+			A:    string(appendMapObject(nil, v.Options)),
+			B:    string(appendMapObject(nil, o.Options)),
+			Path: diffPath(path, "options"),
+		})
+	}
+	for i := 0; i < len(v.RequiredPublicKeys) || i < len(o.RequiredPublicKeys); i++ {
+		p := diffIndex(diffPath(path, "required_public_keys"), i)
+		switch {
+		case i >= len(o.RequiredPublicKeys):
+			d = append(d, Difference{A: string(v.RequiredPublicKeys[i].EncodeJSON(nil)), Path: p})
+		case i >= len(v.RequiredPublicKeys):
+			d = append(d, Difference{B: string(o.RequiredPublicKeys[i].EncodeJSON(nil)), Path: p})
+		default:
+			d = v.RequiredPublicKeys[i].appendDiffs(d, p, o.RequiredPublicKeys[i])
+		}
+	}
+	return d
+}
+
 // EncodeJSON encodes ConstructionPreprocessResponse into JSON.
 func (v ConstructionPreprocessResponse) EncodeJSON(b []byte) []byte {
 	b = append(b, "{"...)
@@ -1881,6 +6886,9 @@ func (v ConstructionPreprocessResponse) EncodeJSON(b []byte) []byte {
 		}
 		b = append(b, "],"...)
 	}
+	if b[len(b)-1] == '{' {
+		return append(b, "}"...)
+	}
 	b[len(b)-1] = '}'
 	return b
 }
@@ -1905,6 +6913,64 @@ type ConstructionSubmitRequest struct {
 	SignedTransaction string
 }
 
+// DecodeJSON decodes a ConstructionSubmitRequest value from JSON.
+func (v *ConstructionSubmitRequest) DecodeJSON(d *json.Decoder, network *NetworkIdentifier) error {
+	more, err := d.ObjectStart()
+	if err != nil {
+		return err
+	}
+	seen := uint64(0)
+	for more {
+		var key []byte
+		if key, err = d.Key(); err != nil {
+			return err
+		}
+		switch string(key) {
+		case "network_identifier":
+			if network == nil {
+				err = d.Skip()
+			} else {
+				err = network.DecodeJSON(d)
+			}
+		case "signed_transaction":
+			var s []byte
+			s, err = d.String()
+			v.SignedTransaction = string(s)
+			seen |= 1 << 0
+		default:
+			if err = d.Report(json.UnknownKey); err == nil {
+				err = d.Skip()
+			}
+		}
+		if err != nil {
+			return err
+		}
+		if more, err = d.ObjectNext(); err != nil {
+			return err
+		}
+	}
+	if seen&(1<<0) == 0 {
+		return d.Errorf("missing required field \"signed_transaction\" in ConstructionSubmitRequest")
+	}
+	return nil
+}
+
+// Diff returns the differences between two ConstructionSubmitRequest values.
+func (v ConstructionSubmitRequest) Diff(o ConstructionSubmitRequest) []Difference {
+	return v.appendDiffs(nil, "", o)
+}
+
+func (v ConstructionSubmitRequest) appendDiffs(d []Difference, path string, o ConstructionSubmitRequest) []Difference {
+	if v.SignedTransaction != o.SignedTransaction {
+		d = append(d, Difference{
+			A:    string(json.AppendString(nil, v.SignedTransaction)),
+			B:    string(json.AppendString(nil, o.SignedTransaction)),
+			Path: diffPath(path, "signed_transaction"),
+		})
+	}
+	return d
+}
+
 // EncodeJSON encodes ConstructionSubmitRequest into JSON.
 func (v ConstructionSubmitRequest) EncodeJSON(b []byte, network []byte) []byte {
 	b = append(b, network...)
@@ -1928,20 +6994,96 @@ func (v *ConstructionSubmitRequest) Reset() {
 // units (Bitcoins).
 type Currency struct {
 	// Number of decimal places in the standard unit representation of the
-	// amount.
-	//
-	// For example, BTC has 8 decimals. Note that it is not possible to
+	// amount. For example, BTC has 8 decimals. Note that it is not possible to
 	// represent the value of some currency in atomic units that is not base 10.
 	Decimals int32
-	// Any additional information related to the currency itself.
-	//
-	// For example, it would be useful to populate this object with the contract
-	// address of an ERC-20 token.
+	// Any additional information related to the currency itself. For example,
+	// it would be useful to populate this object with the contract address of
+	// an ERC-20 token.
 	Metadata MapObject
 	// Canonical symbol associated with a currency.
 	Symbol string
 }
 
+// DecodeJSON decodes a Currency value from JSON.
+func (v *Currency) DecodeJSON(d *json.Decoder) error {
+	more, err := d.ObjectStart()
+	if err != nil {
+		return err
+	}
+	seen := uint64(0)
+	for more {
+		var key []byte
+		if key, err = d.Key(); err != nil {
+			return err
+		}
+		switch string(key) {
+		case "decimals":
+			v.Decimals, err = d.Int32()
+			seen |= 1 << 0
+		case "metadata":
+			if d.Null() {
+				v.Metadata = v.Metadata[:0]
+				err = d.Report(json.NullOptional)
+				break
+			}
+			v.Metadata, err = decodeMapObject(d, v.Metadata)
+		case "symbol":
+			var s []byte
+			s, err = d.String()
+			v.Symbol = string(s)
+			seen |= 1 << 1
+		default:
+			if err = d.Report(json.UnknownKey); err == nil {
+				err = d.Skip()
+			}
+		}
+		if err != nil {
+			return err
+		}
+		if more, err = d.ObjectNext(); err != nil {
+			return err
+		}
+	}
+	if seen&(1<<0) == 0 {
+		return d.Errorf("missing required field \"decimals\" in Currency")
+	}
+	if seen&(1<<1) == 0 {
+		return d.Errorf("missing required field \"symbol\" in Currency")
+	}
+	return nil
+}
+
+// Diff returns the differences between two Currency values.
+func (v Currency) Diff(o Currency) []Difference {
+	return v.appendDiffs(nil, "", o)
+}
+
+func (v Currency) appendDiffs(d []Difference, path string, o Currency) []Difference {
+	if v.Decimals != o.Decimals {
+		d = append(d, Difference{
+			A:    string(json.AppendInt(nil, int64(v.Decimals))),
+			B:    string(json.AppendInt(nil, int64(o.Decimals))),
+			Path: diffPath(path, "decimals"),
+		})
+	}
+	if string(v.Metadata) != string(o.Metadata) {
+		d = append(d, Difference{
+			A:    string(appendMapObject(nil, v.Metadata)),
+			B:    string(appendMapObject(nil, o.Metadata)),
+			Path: diffPath(path, "metadata"),
+		})
+	}
+	if v.Symbol != o.Symbol {
+		d = append(d, Difference{
+			A:    string(json.AppendString(nil, v.Symbol)),
+			B:    string(json.AppendString(nil, o.Symbol)),
+			Path: diffPath(path, "symbol"),
+		})
+	}
+	return d
+}
+
 // EncodeJSON encodes Currency into JSON.
 func (v Currency) EncodeJSON(b []byte) []byte {
 	b = append(b, `{"decimals":`...)
@@ -1971,12 +7113,14 @@ func (v *Currency) Reset() {
 	v.Symbol = ""
 }
 
-// CurveType is the type of cryptographic curve associated with a PublicKey.
-//
-// * secp256k1: SEC compressed - `33 bytes` (https://secg.org/sec1-v2.pdf#subsubsection.2.3.3)
-// * secp256r1: SEC compressed - `33 bytes` (https://secg.org/sec1-v2.pdf#subsubsection.2.3.3)
-// * edwards25519: `y (255-bits) || x-sign-bit (1-bit)` - `32 bytes` (https://ed25519.cr.yp.to/ed25519-20110926.pdf)
-// * tweedle: 1st pk : Fq.t (32 bytes) || 2nd pk : Fq.t (32 bytes) (https://github.com/CodaProtocol/coda/blob/develop/rfcs/0038-rosetta-construction-api.md#marshal-keys)
+// CurveType is the type of cryptographic curve associated with a PublicKey. *
+// secp256k1: SEC compressed - `33 bytes`
+// (https://secg.org/sec1-v2.pdf#subsubsection.2.3.3) * secp256r1: SEC
+// compressed - `33 bytes` (https://secg.org/sec1-v2.pdf#subsubsection.2.3.3) *
+// edwards25519: `y (255-bits) || x-sign-bit (1-bit)` - `32 bytes`
+// (https://ed25519.cr.yp.to/ed25519-20110926.pdf) * tweedle: 1st pk : Fq.t (32
+// bytes) || 2nd pk : Fq.t (32 bytes)
+// (https://github.com/CodaProtocol/coda/blob/develop/rfcs/0038-rosetta-construction-api.md#marshal-keys)
 type CurveType string
 
 // Validate the CurveType value.
@@ -2006,40 +7150,152 @@ func (v Direction) Validate() error {
 // Error type.
 //
 // Instead of utilizing HTTP status codes to describe node errors (which often
-// do not have a good analog), rich errors are returned using this object.
-//
-// Both the code and message fields can be individually used to correctly
-// identify an error. Implementations MUST use unique values for both fields.
+// do not have a good analog), rich errors are returned using this object. Both
+// the code and message fields can be individually used to correctly identify an
+// error. Implementations MUST use unique values for both fields.
 type Error struct {
 	// Code is a network-specific error code. If desired, this code can be
 	// equivalent to an HTTP status code.
 	Code int32
 	// Description allows the implementer to optionally provide additional
 	// information about an error. In many cases, the content of this field will
-	// be a copy-and-paste from existing developer documentation.
-	//
-	// Description can ONLY be populated with generic information about a
-	// particular type of error. It MUST NOT be populated with information about
-	// a particular instantiation of an error (use `details` for this).
-	//
-	// Whereas the content of Error.Message should stay stable across releases,
-	// the content of Error.Description will likely change across releases (as
-	// implementers improve error documentation). For this reason, the content
-	// in this field is not part of any type assertion (unlike Error.Message).
+	// be a copy-and-paste from existing developer documentation. Description
+	// can ONLY be populated with generic information about a particular type of
+	// error. It MUST NOT be populated with information about a particular
+	// instantiation of an error (use `details` for this). Whereas the content
+	// of Error.Message should stay stable across releases, the content of
+	// Error.Description will likely change across releases (as implementers
+	// improve error documentation). For this reason, the content in this field
+	// is not part of any type assertion (unlike Error.Message).
 	Description OptionalStringType
 	// Often times it is useful to return context specific to the request that
 	// caused the error (i.e. a sample of the stack trace or impacted account)
 	// in addition to the standard error message.
 	Details MapObject
-	// Message is a network-specific error message.
-	//
-	// The message MUST NOT change for a given code. In particular, this means
-	// that any contextual information should be included in the details field.
+	// Message is a network-specific error message. The message MUST NOT change
+	// for a given code. In particular, this means that any contextual
+	// information should be included in the details field.
 	Message string
 	// An error is retriable if the same request may succeed if submitted again.
 	Retriable bool
 }
 
+// DecodeJSON decodes an Error value from JSON.
+func (v *Error) DecodeJSON(d *json.Decoder) error {
+	more, err := d.ObjectStart()
+	if err != nil {
+		return err
+	}
+	seen := uint64(0)
+	for more {
+		var key []byte
+		if key, err = d.Key(); err != nil {
+			return err
+		}
+		switch string(key) {
+		case "code":
+			v.Code, err = d.Int32()
+			seen |= 1 << 0
+		case "description":
+			if d.Null() {
+				v.Description.Set = false
+				err = d.Report(json.NullOptional)
+				break
+			}
+			v.Description.Set = true
+			var s []byte
+			s, err = d.String()
+			v.Description.Value = string(s)
+		case "details":
+			if d.Null() {
+				v.Details = v.Details[:0]
+				err = d.Report(json.NullOptional)
+				break
+			}
+			v.Details, err = decodeMapObject(d, v.Details)
+		case "message":
+			var s []byte
+			s, err = d.String()
+			v.Message = string(s)
+			seen |= 1 << 1
+		case "retriable":
+			v.Retriable, err = d.Bool()
+			seen |= 1 << 2
+		default:
+			if err = d.Report(json.UnknownKey); err == nil {
+				err = d.Skip()
+			}
+		}
+		if err != nil {
+			return err
+		}
+		if more, err = d.ObjectNext(); err != nil {
+			return err
+		}
+	}
+	if seen&(1<<0) == 0 {
+		return d.Errorf("missing required field \"code\" in Error")
+	}
+	if seen&(1<<1) == 0 {
+		return d.Errorf("missing required field \"message\" in Error")
+	}
+	if seen&(1<<2) == 0 {
+		return d.Errorf("missing required field \"retriable\" in Error")
+	}
+	return nil
+}
+
+// Diff returns the differences between two Error values.
+func (v Error) Diff(o Error) []Difference {
+	return v.appendDiffs(nil, "", o)
+}
+
+func (v Error) appendDiffs(d []Difference, path string, o Error) []Difference {
+	if v.Code != o.Code {
+		d = append(d, Difference{
+			A:    string(json.AppendInt(nil, int64(v.Code))),
+			B:    string(json.AppendInt(nil, int64(o.Code))),
+			Path: diffPath(path, "code"),
+		})
+	}
+	switch {
+	case v.Description.Set && o.Description.Set:
+		if v.Description.Value != o.Description.Value {
+			d = append(d, Difference{
+				A:    string(json.AppendString(nil, v.Description.Value)),
+				B:    string(json.AppendString(nil, o.Description.Value)),
+				Path: diffPath(path, "description"),
+			})
+		}
+	case v.Description.Set:
+		d = append(d, Difference{A: string(json.AppendString(nil, v.Description.Value)), Path: diffPath(path, "description")})
+	case o.Description.Set:
+		d = append(d, Difference{B: string(json.AppendString(nil, o.Description.Value)), Path: diffPath(path, "description")})
+	}
+	if string(v.Details) != string(o.Details) {
+		d = append(d, Difference{
+			A:    string(appendMapObject(nil, v.Details)),
+			B:    string(appendMapObject(nil, o.Details)),
+			Path: diffPath(path, "details"),
+		})
+	}
+	if v.Message != o.Message {
+		d = append(d, Difference{
+			A:    string(json.AppendString(nil, v.Message)),
+			B:    string(json.AppendString(nil, o.Message)),
+			Path: diffPath(path, "message"),
+		})
+	}
+	if v.Retriable != o.Retriable {
+		d = append(d, Difference{
+			A:    string(json.AppendBool(nil, v.Retriable)),
+			B:    string(json.AppendBool(nil, o.Retriable)),
+			Path: diffPath(path, "retriable"),
+		})
+	}
+	return d
+}
+
 // EncodeJSON encodes Error into JSON.
 func (v Error) EncodeJSON(b []byte) []byte {
 	b = append(b, `{"code":`...)
@@ -2065,8 +7321,8 @@ func (v Error) EncodeJSON(b []byte) []byte {
 // Equal returns whether two Error values are equal.
 func (v Error) Equal(o Error) bool {
 	return v.Code == o.Code &&
-		v.Description.Value == o.Description.Value &&
 		v.Description.Set == o.Description.Set &&
+		v.Description.Value == o.Description.Value &&
 		string(v.Details) == string(o.Details) &&
 		v.Message == o.Message &&
 		v.Retriable == o.Retriable
@@ -2094,6 +7350,92 @@ type EventsBlocksRequest struct {
 	Offset OptionalInt64Type
 }
 
+// DecodeJSON decodes an EventsBlocksRequest value from JSON.
+func (v *EventsBlocksRequest) DecodeJSON(d *json.Decoder, network *NetworkIdentifier) error {
+	more, err := d.ObjectStart()
+	if err != nil {
+		return err
+	}
+	for more {
+		var key []byte
+		if key, err = d.Key(); err != nil {
+			return err
+		}
+		switch string(key) {
+		case "network_identifier":
+			if network == nil {
+				err = d.Skip()
+			} else {
+				err = network.DecodeJSON(d)
+			}
+		case "limit":
+			if d.Null() {
+				v.Limit.Set = false
+				err = d.Report(json.NullOptional)
+				break
+			}
+			v.Limit.Set = true
+			v.Limit.Value, err = d.Int64()
+		case "offset":
+			if d.Null() {
+				v.Offset.Set = false
+				err = d.Report(json.NullOptional)
+				break
+			}
+			v.Offset.Set = true
+			v.Offset.Value, err = d.Int64()
+		default:
+			if err = d.Report(json.UnknownKey); err == nil {
+				err = d.Skip()
+			}
+		}
+		if err != nil {
+			return err
+		}
+		if more, err = d.ObjectNext(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Diff returns the differences between two EventsBlocksRequest values.
+func (v EventsBlocksRequest) Diff(o EventsBlocksRequest) []Difference {
+	return v.appendDiffs(nil, "", o)
+}
+
+func (v EventsBlocksRequest) appendDiffs(d []Difference, path string, o EventsBlocksRequest) []Difference {
+	switch {
+	case v.Limit.Set && o.Limit.Set:
+		if v.Limit.Value != o.Limit.Value {
+			d = append(d, Difference{
+				A:    string(json.AppendInt(nil, v.Limit.Value)),
+				B:    string(json.AppendInt(nil, o.Limit.Value)),
+				Path: diffPath(path, "limit"),
+			})
+		}
+	case v.Limit.Set:
+		d = append(d, Difference{A: string(json.AppendInt(nil, v.Limit.Value)), Path: diffPath(path, "limit")})
+	case o.Limit.Set:
+		d = append(d, Difference{B: string(json.AppendInt(nil, o.Limit.Value)), Path: diffPath(path, "limit")})
+	}
+	switch {
+	case v.Offset.Set && o.Offset.Set:
+		if v.Offset.Value != o.Offset.Value {
+			d = append(d, Difference{
+				A:    string(json.AppendInt(nil, v.Offset.Value)),
+				B:    string(json.AppendInt(nil, o.Offset.Value)),
+				Path: diffPath(path, "offset"),
+			})
+		}
+	case v.Offset.Set:
+		d = append(d, Difference{A: string(json.AppendInt(nil, v.Offset.Value)), Path: diffPath(path, "offset")})
+	case o.Offset.Set:
+		d = append(d, Difference{B: string(json.AppendInt(nil, o.Offset.Value)), Path: diffPath(path, "offset")})
+	}
+	return d
+}
+
 // EncodeJSON encodes EventsBlocksRequest into JSON.
 func (v EventsBlocksRequest) EncodeJSON(b []byte, network []byte) []byte {
 	b = append(b, network...)
@@ -2113,10 +7455,10 @@ func (v EventsBlocksRequest) EncodeJSON(b []byte, network []byte) []byte {
 
 // Equal returns whether two EventsBlocksRequest values are equal.
 func (v EventsBlocksRequest) Equal(o EventsBlocksRequest) bool {
-	return v.Limit.Value == o.Limit.Value &&
-		v.Limit.Set == o.Limit.Set &&
-		v.Offset.Value == o.Offset.Value &&
-		v.Offset.Set == o.Offset.Set
+	return v.Limit.Set == o.Limit.Set &&
+		v.Limit.Value == o.Limit.Value &&
+		v.Offset.Set == o.Offset.Set &&
+		v.Offset.Value == o.Offset.Value
 }
 
 // Reset resets EventsBlocksRequest so that it can be reused.
@@ -2139,6 +7481,95 @@ type EventsBlocksResponse struct {
 	MaxSequence int64
 }
 
+// DecodeJSON decodes an EventsBlocksResponse value from JSON.
+func (v *EventsBlocksResponse) DecodeJSON(d *json.Decoder) error {
+	more, err := d.ObjectStart()
+	if err != nil {
+		return err
+	}
+	seen := uint64(0)
+	for more {
+		var key []byte
+		if key, err = d.Key(); err != nil {
+			return err
+		}
+		switch string(key) {
+		case "events":
+			v.Events = v.Events[:0]
+			if d.Null() {
+				break
+			}
+			var next bool
+			if next, err = d.ArrayStart(); err != nil {
+				break
+			}
+			for next {
+				n := len(v.Events)
+				if n < cap(v.Events) {
+					v.Events = v.Events[:n+1]
+					v.Events[n].Reset()
+				} else {
+					v.Events = append(v.Events, BlockEvent{})
+				}
+				if err = v.Events[n].DecodeJSON(d); err != nil {
+					break
+				}
+				if next, err = d.ArrayNext(); err != nil {
+					break
+				}
+			}
+			seen |= 1 << 0
+		case "max_sequence":
+			v.MaxSequence, err = d.Int64()
+			seen |= 1 << 1
+		default:
+			if err = d.Report(json.UnknownKey); err == nil {
+				err = d.Skip()
+			}
+		}
+		if err != nil {
+			return err
+		}
+		if more, err = d.ObjectNext(); err != nil {
+			return err
+		}
+	}
+	if seen&(1<<0) == 0 {
+		return d.Errorf("missing required field \"events\" in EventsBlocksResponse")
+	}
+	if seen&(1<<1) == 0 {
+		return d.Errorf("missing required field \"max_sequence\" in EventsBlocksResponse")
+	}
+	return nil
+}
+
+// Diff returns the differences between two EventsBlocksResponse values.
+func (v EventsBlocksResponse) Diff(o EventsBlocksResponse) []Difference {
+	return v.appendDiffs(nil, "", o)
+}
+
+func (v EventsBlocksResponse) appendDiffs(d []Difference, path string, o EventsBlocksResponse) []Difference {
+	for i := 0; i < len(v.Events) || i < len(o.Events); i++ {
+		p := diffIndex(diffPath(path, "events"), i)
+		switch {
+		case i >= len(o.Events):
+			d = append(d, Difference{A: string(v.Events[i].EncodeJSON(nil)), Path: p})
+		case i >= len(v.Events):
+			d = append(d, Difference{B: string(o.Events[i].EncodeJSON(nil)), Path: p})
+		default:
+			d = v.Events[i].appendDiffs(d, p, o.Events[i])
+		}
+	}
+	if v.MaxSequence != o.MaxSequence {
+		d = append(d, Difference{
+			A:    string(json.AppendInt(nil, v.MaxSequence)),
+			B:    string(json.AppendInt(nil, o.MaxSequence)),
+			Path: diffPath(path, "max_sequence"),
+		})
+	}
+	return d
+}
+
 // EncodeJSON encodes EventsBlocksResponse into JSON.
 func (v EventsBlocksResponse) EncodeJSON(b []byte) []byte {
 	b = append(b, `{"events":[`...)
@@ -2168,14 +7599,13 @@ func (v *EventsBlocksResponse) Reset() {
 
 // ExemptionType is used to indicate if the live balance for an account subject
 // to a BalanceExemption could increase above, decrease below, or equal the
-// computed balance.
-//
-// * greater_or_equal: The live balance may increase above or equal the computed balance. This typically
-// occurs with staking rewards that accrue on each block.
-// * less_or_equal: The live balance may decrease below or equal the computed balance. This typically
-// occurs as balance moves from locked to spendable on a vesting account.
-// * dynamic: The live balance may increase above, decrease below, or equal the computed balance. This
-// typically occurs with tokens that have a dynamic supply.
+// computed balance. * greater_or_equal: The live balance may increase above or
+// equal the computed balance. This typically occurs with staking rewards that
+// accrue on each block. * less_or_equal: The live balance may decrease below or
+// equal the computed balance. This typically occurs as balance moves from
+// locked to spendable on a vesting account. * dynamic: The live balance may
+// increase above, decrease below, or equal the computed balance. This typically
+// occurs with tokens that have a dynamic supply.
 type ExemptionType string
 
 // Validate the ExemptionType value.
@@ -2194,6 +7624,82 @@ type MempoolResponse struct {
 	TransactionIdentifiers []TransactionIdentifier
 }
 
+// DecodeJSON decodes a MempoolResponse value from JSON.
+func (v *MempoolResponse) DecodeJSON(d *json.Decoder) error {
+	more, err := d.ObjectStart()
+	if err != nil {
+		return err
+	}
+	seen := uint64(0)
+	for more {
+		var key []byte
+		if key, err = d.Key(); err != nil {
+			return err
+		}
+		switch string(key) {
+		case "transaction_identifiers":
+			v.TransactionIdentifiers = v.TransactionIdentifiers[:0]
+			if d.Null() {
+				break
+			}
+			var next bool
+			if next, err = d.ArrayStart(); err != nil {
+				break
+			}
+			for next {
+				n := len(v.TransactionIdentifiers)
+				if n < cap(v.TransactionIdentifiers) {
+					v.TransactionIdentifiers = v.TransactionIdentifiers[:n+1]
+					v.TransactionIdentifiers[n].Reset()
+				} else {
+					v.TransactionIdentifiers = append(v.TransactionIdentifiers, TransactionIdentifier{})
+				}
+				if err = v.TransactionIdentifiers[n].DecodeJSON(d); err != nil {
+					break
+				}
+				if next, err = d.ArrayNext(); err != nil {
+					break
+				}
+			}
+			seen |= 1 << 0
+		default:
+			if err = d.Report(json.UnknownKey); err == nil {
+				err = d.Skip()
+			}
+		}
+		if err != nil {
+			return err
+		}
+		if more, err = d.ObjectNext(); err != nil {
+			return err
+		}
+	}
+	if seen&(1<<0) == 0 {
+		return d.Errorf("missing required field \"transaction_identifiers\" in MempoolResponse")
+	}
+	return nil
+}
+
+// Diff returns the differences between two MempoolResponse values.
+func (v MempoolResponse) Diff(o MempoolResponse) []Difference {
+	return v.appendDiffs(nil, "", o)
+}
+
+func (v MempoolResponse) appendDiffs(d []Difference, path string, o MempoolResponse) []Difference {
+	for i := 0; i < len(v.TransactionIdentifiers) || i < len(o.TransactionIdentifiers); i++ {
+		p := diffIndex(diffPath(path, "transaction_identifiers"), i)
+		switch {
+		case i >= len(o.TransactionIdentifiers):
+			d = append(d, Difference{A: string(v.TransactionIdentifiers[i].EncodeJSON(nil)), Path: p})
+		case i >= len(v.TransactionIdentifiers):
+			d = append(d, Difference{B: string(o.TransactionIdentifiers[i].EncodeJSON(nil)), Path: p})
+		default:
+			d = v.TransactionIdentifiers[i].appendDiffs(d, p, o.TransactionIdentifiers[i])
+		}
+	}
+	return d
+}
+
 // EncodeJSON encodes MempoolResponse into JSON.
 func (v MempoolResponse) EncodeJSON(b []byte) []byte {
 	b = append(b, '{', '"', 't', 'r', 'a', 'n', 's', 'a', 'c', 't', 'i', 'o', 'n', '_', 'i', 'd', 'e', 'n', 't', 'i', 'f', 'i', 'e', 'r', 's', '"', ':', '[')
@@ -2225,6 +7731,56 @@ type MempoolTransactionRequest struct {
 	TransactionIdentifier TransactionIdentifier
 }
 
+// DecodeJSON decodes a MempoolTransactionRequest value from JSON.
+func (v *MempoolTransactionRequest) DecodeJSON(d *json.Decoder, network *NetworkIdentifier) error {
+	more, err := d.ObjectStart()
+	if err != nil {
+		return err
+	}
+	seen := uint64(0)
+	for more {
+		var key []byte
+		if key, err = d.Key(); err != nil {
+			return err
+		}
+		switch string(key) {
+		case "network_identifier":
+			if network == nil {
+				err = d.Skip()
+			} else {
+				err = network.DecodeJSON(d)
+			}
+		case "transaction_identifier":
+			err = v.TransactionIdentifier.DecodeJSON(d)
+			seen |= 1 << 0
+		default:
+			if err = d.Report(json.UnknownKey); err == nil {
+				err = d.Skip()
+			}
+		}
+		if err != nil {
+			return err
+		}
+		if more, err = d.ObjectNext(); err != nil {
+			return err
+		}
+	}
+	if seen&(1<<0) == 0 {
+		return d.Errorf("missing required field \"transaction_identifier\" in MempoolTransactionRequest")
+	}
+	return nil
+}
+
+// Diff returns the differences between two MempoolTransactionRequest values.
+func (v MempoolTransactionRequest) Diff(o MempoolTransactionRequest) []Difference {
+	return v.appendDiffs(nil, "", o)
+}
+
+func (v MempoolTransactionRequest) appendDiffs(d []Difference, path string, o MempoolTransactionRequest) []Difference {
+	d = v.TransactionIdentifier.appendDiffs(d, diffPath(path, "transaction_identifier"), o.TransactionIdentifier)
+	return d
+}
+
 // EncodeJSON encodes MempoolTransactionRequest into JSON.
 func (v MempoolTransactionRequest) EncodeJSON(b []byte, network []byte) []byte {
 	b = append(b, network...)
@@ -2253,6 +7809,64 @@ type MempoolTransactionResponse struct {
 	Transaction Transaction
 }
 
+// DecodeJSON decodes a MempoolTransactionResponse value from JSON.
+func (v *MempoolTransactionResponse) DecodeJSON(d *json.Decoder) error {
+	more, err := d.ObjectStart()
+	if err != nil {
+		return err
+	}
+	seen := uint64(0)
+	for more {
+		var key []byte
+		if key, err = d.Key(); err != nil {
+			return err
+		}
+		switch string(key) {
+		case "metadata":
+			if d.Null() {
+				v.Metadata = v.Metadata[:0]
+				err = d.Report(json.NullOptional)
+				break
+			}
+			v.Metadata, err = decodeMapObject(d, v.Metadata)
+		case "transaction":
+			err = v.Transaction.DecodeJSON(d)
+			seen |= 1 << 0
+		default:
+			if err = d.Report(json.UnknownKey); err == nil {
+				err = d.Skip()
+			}
+		}
+		if err != nil {
+			return err
+		}
+		if more, err = d.ObjectNext(); err != nil {
+			return err
+		}
+	}
+	if seen&(1<<0) == 0 {
+		return d.Errorf("missing required field \"transaction\" in MempoolTransactionResponse")
+	}
+	return nil
+}
+
+// Diff returns the differences between two MempoolTransactionResponse values.
+func (v MempoolTransactionResponse) Diff(o MempoolTransactionResponse) []Difference {
+	return v.appendDiffs(nil, "", o)
+}
+
+func (v MempoolTransactionResponse) appendDiffs(d []Difference, path string, o MempoolTransactionResponse) []Difference {
+	if string(v.Metadata) != string(o.Metadata) {
+		d = append(d, Difference{
+			A:    string(appendMapObject(nil, v.Metadata)),
+			B:    string(appendMapObject(nil, o.Metadata)),
+			Path: diffPath(path, "metadata"),
+		})
+	}
+	d = v.Transaction.appendDiffs(d, diffPath(path, "transaction"), o.Transaction)
+	return d
+}
+
 // EncodeJSON encodes MempoolTransactionResponse into JSON.
 func (v MempoolTransactionResponse) EncodeJSON(b []byte) []byte {
 	b = append(b, "{"...)
@@ -2286,6 +7900,56 @@ type MetadataRequest struct {
 	Metadata MapObject
 }
 
+// DecodeJSON decodes a MetadataRequest value from JSON.
+func (v *MetadataRequest) DecodeJSON(d *json.Decoder) error {
+	more, err := d.ObjectStart()
+	if err != nil {
+		return err
+	}
+	for more {
+		var key []byte
+		if key, err = d.Key(); err != nil {
+			return err
+		}
+		switch string(key) {
+		case "metadata":
+			if d.Null() {
+				v.Metadata = v.Metadata[:0]
+				err = d.Report(json.NullOptional)
+				break
+			}
+			v.Metadata, err = decodeMapObject(d, v.Metadata)
+		default:
+			if err = d.Report(json.UnknownKey); err == nil {
+				err = d.Skip()
+			}
+		}
+		if err != nil {
+			return err
+		}
+		if more, err = d.ObjectNext(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Diff returns the differences between two MetadataRequest values.
+func (v MetadataRequest) Diff(o MetadataRequest) []Difference {
+	return v.appendDiffs(nil, "", o)
+}
+
+func (v MetadataRequest) appendDiffs(d []Difference, path string, o MetadataRequest) []Difference {
+	if string(v.Metadata) != string(o.Metadata) {
+		d = append(d, Difference{
+			A:    string(appendMapObject(nil, v.Metadata)),
+			B:    string(appendMapObject(nil, o.Metadata)),
+			Path: diffPath(path, "metadata"),
+		})
+	}
+	return d
+}
+
 // EncodeJSON encodes MetadataRequest into JSON.
 func (v MetadataRequest) EncodeJSON(b []byte) []byte {
 	b = append(b, "{"...)
@@ -2319,6 +7983,89 @@ type NetworkIdentifier struct {
 	SubNetworkIdentifier OptionalSubNetworkIdentifierType
 }
 
+// DecodeJSON decodes a NetworkIdentifier value from JSON.
+func (v *NetworkIdentifier) DecodeJSON(d *json.Decoder) error {
+	more, err := d.ObjectStart()
+	if err != nil {
+		return err
+	}
+	seen := uint64(0)
+	for more {
+		var key []byte
+		if key, err = d.Key(); err != nil {
+			return err
+		}
+		switch string(key) {
+		case "blockchain":
+			var s []byte
+			s, err = d.String()
+			v.Blockchain = string(s)
+			seen |= 1 << 0
+		case "network":
+			var s []byte
+			s, err = d.String()
+			v.Network = string(s)
+			seen |= 1 << 1
+		case "sub_network_identifier":
+			if d.Null() {
+				v.SubNetworkIdentifier.Set = false
+				err = d.Report(json.NullOptional)
+				break
+			}
+			v.SubNetworkIdentifier.Set = true
+			err = v.SubNetworkIdentifier.Value.DecodeJSON(d)
+		default:
+			if err = d.Report(json.UnknownKey); err == nil {
+				err = d.Skip()
+			}
+		}
+		if err != nil {
+			return err
+		}
+		if more, err = d.ObjectNext(); err != nil {
+			return err
+		}
+	}
+	if seen&(1<<0) == 0 {
+		return d.Errorf("missing required field \"blockchain\" in NetworkIdentifier")
+	}
+	if seen&(1<<1) == 0 {
+		return d.Errorf("missing required field \"network\" in NetworkIdentifier")
+	}
+	return nil
+}
+
+// Diff returns the differences between two NetworkIdentifier values.
+func (v NetworkIdentifier) Diff(o NetworkIdentifier) []Difference {
+	return v.appendDiffs(nil, "", o)
+}
+
+func (v NetworkIdentifier) appendDiffs(d []Difference, path string, o NetworkIdentifier) []Difference {
+	if v.Blockchain != o.Blockchain {
+		d = append(d, Difference{
+			A:    string(json.AppendString(nil, v.Blockchain)),
+			B:    string(json.AppendString(nil, o.Blockchain)),
+			Path: diffPath(path, "blockchain"),
+		})
+	}
+	if v.Network != o.Network {
+		d = append(d, Difference{
+			A:    string(json.AppendString(nil, v.Network)),
+			B:    string(json.AppendString(nil, o.Network)),
+			Path: diffPath(path, "network"),
+		})
+	}
+	switch {
+	case v.SubNetworkIdentifier.Set && o.SubNetworkIdentifier.Set:
+		d = v.SubNetworkIdentifier.Value.appendDiffs(d, diffPath(path, "sub_network_identifier"), o.SubNetworkIdentifier.Value)
+	case v.SubNetworkIdentifier.Set:
+		d = append(d, Difference{A: string(v.SubNetworkIdentifier.Value.EncodeJSON(nil)), Path: diffPath(path, "sub_network_identifier")})
+	case o.SubNetworkIdentifier.Set:
+		d = append(d, Difference{B: string(o.SubNetworkIdentifier.Value.EncodeJSON(nil)), Path: diffPath(path, "sub_network_identifier")})
+	}
+	return d
+}
+
 // EncodeJSON encodes NetworkIdentifier into JSON.
 func (v NetworkIdentifier) EncodeJSON(b []byte) []byte {
 	b = append(b, `{"blockchain":`...)
@@ -2339,8 +8086,8 @@ func (v NetworkIdentifier) EncodeJSON(b []byte) []byte {
 func (v NetworkIdentifier) Equal(o NetworkIdentifier) bool {
 	return v.Blockchain == o.Blockchain &&
 		v.Network == o.Network &&
-		v.SubNetworkIdentifier.Value.Equal(o.SubNetworkIdentifier.Value) &&
-		v.SubNetworkIdentifier.Set == o.SubNetworkIdentifier.Set
+		v.SubNetworkIdentifier.Set == o.SubNetworkIdentifier.Set &&
+		v.SubNetworkIdentifier.Value.Equal(o.SubNetworkIdentifier.Value)
 }
 
 // Reset resets NetworkIdentifier so that it can be reused.
@@ -2359,6 +8106,82 @@ type NetworkListResponse struct {
 	NetworkIdentifiers []NetworkIdentifier
 }
 
+// DecodeJSON decodes a NetworkListResponse value from JSON.
+func (v *NetworkListResponse) DecodeJSON(d *json.Decoder) error {
+	more, err := d.ObjectStart()
+	if err != nil {
+		return err
+	}
+	seen := uint64(0)
+	for more {
+		var key []byte
+		if key, err = d.Key(); err != nil {
+			return err
+		}
+		switch string(key) {
+		case "network_identifiers":
+			v.NetworkIdentifiers = v.NetworkIdentifiers[:0]
+			if d.Null() {
+				break
+			}
+			var next bool
+			if next, err = d.ArrayStart(); err != nil {
+				break
+			}
+			for next {
+				n := len(v.NetworkIdentifiers)
+				if n < cap(v.NetworkIdentifiers) {
+					v.NetworkIdentifiers = v.NetworkIdentifiers[:n+1]
+					v.NetworkIdentifiers[n].Reset()
+				} else {
+					v.NetworkIdentifiers = append(v.NetworkIdentifiers, NetworkIdentifier{})
+				}
+				if err = v.NetworkIdentifiers[n].DecodeJSON(d); err != nil {
+					break
+				}
+				if next, err = d.ArrayNext(); err != nil {
+					break
+				}
+			}
+			seen |= 1 << 0
+		default:
+			if err = d.Report(json.UnknownKey); err == nil {
+				err = d.Skip()
+			}
+		}
+		if err != nil {
+			return err
+		}
+		if more, err = d.ObjectNext(); err != nil {
+			return err
+		}
+	}
+	if seen&(1<<0) == 0 {
+		return d.Errorf("missing required field \"network_identifiers\" in NetworkListResponse")
+	}
+	return nil
+}
+
+// Diff returns the differences between two NetworkListResponse values.
+func (v NetworkListResponse) Diff(o NetworkListResponse) []Difference {
+	return v.appendDiffs(nil, "", o)
+}
+
+func (v NetworkListResponse) appendDiffs(d []Difference, path string, o NetworkListResponse) []Difference {
+	for i := 0; i < len(v.NetworkIdentifiers) || i < len(o.NetworkIdentifiers); i++ {
+		p := diffIndex(diffPath(path, "network_identifiers"), i)
+		switch {
+		case i >= len(o.NetworkIdentifiers):
+			d = append(d, Difference{A: string(v.NetworkIdentifiers[i].EncodeJSON(nil)), Path: p})
+		case i >= len(v.NetworkIdentifiers):
+			d = append(d, Difference{B: string(o.NetworkIdentifiers[i].EncodeJSON(nil)), Path: p})
+		default:
+			d = v.NetworkIdentifiers[i].appendDiffs(d, p, o.NetworkIdentifiers[i])
+		}
+	}
+	return d
+}
+
 // EncodeJSON encodes NetworkListResponse into JSON.
 func (v NetworkListResponse) EncodeJSON(b []byte) []byte {
 	b = append(b, '{', '"', 'n', 'e', 't', 'w', 'o', 'r', 'k', '_', 'i', 'd', 'e', 'n', 't', 'i', 'f', 'i', 'e', 'r', 's', '"', ':', '[')
@@ -2382,11 +8205,62 @@ func (v *NetworkListResponse) Reset() {
 	v.NetworkIdentifiers = v.NetworkIdentifiers[:0]
 }
 
-// NetworkOptionsResponse contains information about the versioning of the node
-// and the allowed operation statuses, operation types, and errors.
-type NetworkOptionsResponse struct {
-	Allow   Allow
-	Version Version
+// NetworkOptionsResponse contains information about the versioning of the node
+// and the allowed operation statuses, operation types, and errors.
+type NetworkOptionsResponse struct {
+	Allow   Allow
+	Version Version
+}
+
+// DecodeJSON decodes a NetworkOptionsResponse value from JSON.
+func (v *NetworkOptionsResponse) DecodeJSON(d *json.Decoder) error {
+	more, err := d.ObjectStart()
+	if err != nil {
+		return err
+	}
+	seen := uint64(0)
+	for more {
+		var key []byte
+		if key, err = d.Key(); err != nil {
+			return err
+		}
+		switch string(key) {
+		case "allow":
+			err = v.Allow.DecodeJSON(d)
+			seen |= 1 << 0
+		case "version":
+			err = v.Version.DecodeJSON(d)
+			seen |= 1 << 1
+		default:
+			if err = d.Report(json.UnknownKey); err == nil {
+				err = d.Skip()
+			}
+		}
+		if err != nil {
+			return err
+		}
+		if more, err = d.ObjectNext(); err != nil {
+			return err
+		}
+	}
+	if seen&(1<<0) == 0 {
+		return d.Errorf("missing required field \"allow\" in NetworkOptionsResponse")
+	}
+	if seen&(1<<1) == 0 {
+		return d.Errorf("missing required field \"version\" in NetworkOptionsResponse")
+	}
+	return nil
+}
+
+// Diff returns the differences between two NetworkOptionsResponse values.
+func (v NetworkOptionsResponse) Diff(o NetworkOptionsResponse) []Difference {
+	return v.appendDiffs(nil, "", o)
+}
+
+func (v NetworkOptionsResponse) appendDiffs(d []Difference, path string, o NetworkOptionsResponse) []Difference {
+	d = v.Allow.appendDiffs(d, diffPath(path, "allow"), o.Allow)
+	d = v.Version.appendDiffs(d, diffPath(path, "version"), o.Version)
+	return d
 }
 
 // EncodeJSON encodes NetworkOptionsResponse into JSON.
@@ -2418,14 +8292,72 @@ type NetworkRequest struct {
 	Metadata MapObject
 }
 
+// DecodeJSON decodes a NetworkRequest value from JSON.
+func (v *NetworkRequest) DecodeJSON(d *json.Decoder, network *NetworkIdentifier) error {
+	more, err := d.ObjectStart()
+	if err != nil {
+		return err
+	}
+	for more {
+		var key []byte
+		if key, err = d.Key(); err != nil {
+			return err
+		}
+		switch string(key) {
+		case "network_identifier":
+			if network == nil {
+				err = d.Skip()
+			} else {
+				err = network.DecodeJSON(d)
+			}
+		case "metadata":
+			if d.Null() {
+				v.Metadata = v.Metadata[:0]
+				err = d.Report(json.NullOptional)
+				break
+			}
+			v.Metadata, err = decodeMapObject(d, v.Metadata)
+		default:
+			if err = d.Report(json.UnknownKey); err == nil {
+				err = d.Skip()
+			}
+		}
+		if err != nil {
+			return err
+		}
+		if more, err = d.ObjectNext(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Diff returns the differences between two NetworkRequest values.
+func (v NetworkRequest) Diff(o NetworkRequest) []Difference {
+	return v.appendDiffs(nil, "", o)
+}
+
+func (v NetworkRequest) appendDiffs(d []Difference, path string, o NetworkRequest) []Difference {
+	if string(v.Metadata) != string(o.Metadata) {
+		d = append(d, Difference{
+			A:    string(appendMapObject(nil, v.Metadata)),
+			B:    string(appendMapObject(nil, o.Metadata)),
+			Path: diffPath(path, "metadata"),
+		})
+	}
+	return d
+}
+
 // EncodeJSON encodes NetworkRequest into JSON.
 func (v NetworkRequest) EncodeJSON(b []byte, network []byte) []byte {
 	b = append(b, network...)
 	if len(v.Metadata) > 0 {
 		b = append(b, `"metadata":`...)
 		b = append(b, v.Metadata...)
+		b = append(b, ","...)
 	}
-	return append(b, "}"...)
+	b[len(b)-1] = '}'
+	return b
 }
 
 // Equal returns whether two NetworkRequest values are equal.
@@ -2440,17 +8372,15 @@ func (v *NetworkRequest) Reset() {
 
 // NetworkStatusResponse contains basic information about the node's view of a
 // blockchain network. It is assumed that any BlockIdentifier.Index less than or
-// equal to CurrentBlockIdentifier.Index can be queried.
-//
-// If a Rosetta implementation prunes historical state, it should populate the
-// optional `oldest_block_identifier` field with the oldest block available to
-// query. If this is not populated, it is assumed that the
-// `genesis_block_identifier` is the oldest queryable block.
-//
-// If a Rosetta implementation performs some pre-sync before it is possible to
-// query blocks, sync_status should be populated so that clients can still
-// monitor healthiness. Without this field, it may appear that the
-// implementation is stuck syncing and needs to be terminated.
+// equal to CurrentBlockIdentifier.Index can be queried. If a Rosetta
+// implementation prunes historical state, it should populate the optional
+// `oldest_block_identifier` field with the oldest block available to query. If
+// this is not populated, it is assumed that the `genesis_block_identifier` is
+// the oldest queryable block. If a Rosetta implementation performs some
+// pre-sync before it is possible to query blocks, sync_status should be
+// populated so that clients can still monitor healthiness. Without this field,
+// it may appear that the implementation is stuck syncing and needs to be
+// terminated.
 type NetworkStatusResponse struct {
 	CurrentBlockIdentifier BlockIdentifier
 	CurrentBlockTimestamp  Timestamp
@@ -2460,6 +8390,143 @@ type NetworkStatusResponse struct {
 	SyncStatus             OptionalSyncStatusType
 }
 
+// DecodeJSON decodes a NetworkStatusResponse value from JSON.
+func (v *NetworkStatusResponse) DecodeJSON(d *json.Decoder) error {
+	more, err := d.ObjectStart()
+	if err != nil {
+		return err
+	}
+	seen := uint64(0)
+	for more {
+		var key []byte
+		if key, err = d.Key(); err != nil {
+			return err
+		}
+		switch string(key) {
+		case "current_block_identifier":
+			err = v.CurrentBlockIdentifier.DecodeJSON(d)
+			seen |= 1 << 0
+		case "current_block_timestamp":
+			var n int64
+			n, err = d.Int64()
+			v.CurrentBlockTimestamp = Timestamp(n)
+			seen |= 1 << 1
+		case "genesis_block_identifier":
+			err = v.GenesisBlockIdentifier.DecodeJSON(d)
+			seen |= 1 << 2
+		case "oldest_block_identifier":
+			if d.Null() {
+				v.OldestBlockIdentifier.Set = false
+				err = d.Report(json.NullOptional)
+				break
+			}
+			v.OldestBlockIdentifier.Set = true
+			err = v.OldestBlockIdentifier.Value.DecodeJSON(d)
+		case "peers":
+			v.Peers = v.Peers[:0]
+			if d.Null() {
+				break
+			}
+			var next bool
+			if next, err = d.ArrayStart(); err != nil {
+				break
+			}
+			for next {
+				n := len(v.Peers)
+				if n < cap(v.Peers) {
+					v.Peers = v.Peers[:n+1]
+					v.Peers[n].Reset()
+				} else {
+					v.Peers = append(v.Peers, Peer{})
+				}
+				if err = v.Peers[n].DecodeJSON(d); err != nil {
+					break
+				}
+				if next, err = d.ArrayNext(); err != nil {
+					break
+				}
+			}
+			seen |= 1 << 3
+		case "sync_status":
+			if d.Null() {
+				v.SyncStatus.Set = false
+				err = d.Report(json.NullOptional)
+				break
+			}
+			v.SyncStatus.Set = true
+			err = v.SyncStatus.Value.DecodeJSON(d)
+		default:
+			if err = d.Report(json.UnknownKey); err == nil {
+				err = d.Skip()
+			}
+		}
+		if err != nil {
+			return err
+		}
+		if more, err = d.ObjectNext(); err != nil {
+			return err
+		}
+	}
+	if seen&(1<<0) == 0 {
+		return d.Errorf("missing required field \"current_block_identifier\" in NetworkStatusResponse")
+	}
+	if seen&(1<<1) == 0 {
+		return d.Errorf("missing required field \"current_block_timestamp\" in NetworkStatusResponse")
+	}
+	if seen&(1<<2) == 0 {
+		return d.Errorf("missing required field \"genesis_block_identifier\" in NetworkStatusResponse")
+	}
+	if seen&(1<<3) == 0 {
+		return d.Errorf("missing required field \"peers\" in NetworkStatusResponse")
+	}
+	return nil
+}
+
+// Diff returns the differences between two NetworkStatusResponse values.
+func (v NetworkStatusResponse) Diff(o NetworkStatusResponse) []Difference {
+	return v.appendDiffs(nil, "", o)
+}
+
+func (v NetworkStatusResponse) appendDiffs(d []Difference, path string, o NetworkStatusResponse) []Difference {
+	d = v.CurrentBlockIdentifier.appendDiffs(d, diffPath(path, "current_block_identifier"), o.CurrentBlockIdentifier)
+	if v.CurrentBlockTimestamp != o.CurrentBlockTimestamp {
+		d = append(d, Difference{
+			A:    string(json.AppendInt(nil, int64(v.CurrentBlockTimestamp))),
+			B:    string(json.AppendInt(nil, int64(o.CurrentBlockTimestamp))),
+			Path: diffPath(path, "current_block_timestamp"),
+		})
+	}
+	d = v.GenesisBlockIdentifier.appendDiffs(d, diffPath(path, "genesis_block_identifier"), o.GenesisBlockIdentifier)
+	switch {
+	case v.OldestBlockIdentifier.Set && o.OldestBlockIdentifier.Set:
+		d = v.OldestBlockIdentifier.Value.appendDiffs(d, diffPath(path, "oldest_block_identifier"), o.OldestBlockIdentifier.Value)
+	case v.OldestBlockIdentifier.Set:
+		d = append(d, Difference{A: string(v.OldestBlockIdentifier.Value.EncodeJSON(nil)), Path: diffPath(path, "oldest_block_identifier")})
+	case o.OldestBlockIdentifier.Set:
+		d = append(d, Difference{B: string(o.OldestBlockIdentifier.Value.EncodeJSON(nil)), Path: diffPath(path, "oldest_block_identifier")})
+	}
+	for i := 0; i < len(v.Peers) || i < len(o.Peers); i++ {
+		p := diffIndex(diffPath(path, "peers"), i)
+		switch {
+		case i >= len(o.Peers):
+			d = append(d, Difference{A: string(v.Peers[i].EncodeJSON(nil)), Path: p})
+		case i >= len(v.Peers):
+			d = append(d, Difference{B: string(o.Peers[i].EncodeJSON(nil)), Path: p})
+		default:
+			d = v.Peers[i].appendDiffs(d, p, o.Peers[i])
+		}
+	}
+	switch {
+	case v.SyncStatus.Set && o.SyncStatus.Set:
+		d = v.SyncStatus.Value.appendDiffs(d, diffPath(path, "sync_status"), o.SyncStatus.Value)
+	case v.SyncStatus.Set:
+		d = append(d, Difference{A: string(v.SyncStatus.Value.EncodeJSON(nil)), Path: diffPath(path, "sync_status")})
+	case o.SyncStatus.Set:
+		d = append(d, Difference{B: string(o.SyncStatus.Value.EncodeJSON(nil)), Path: diffPath(path, "sync_status")})
+	}
+	return d
+}
+
 // EncodeJSON encodes NetworkStatusResponse into JSON.
 func (v NetworkStatusResponse) EncodeJSON(b []byte) []byte {
 	b = append(b, '{', '"', 'c', 'u', 'r', 'r', 'e', 'n', 't', '_', 'b', 'l', 'o', 'c', 'k', '_', 'i', 'd', 'e', 'n', 't', 'i', 'f', 'i', 'e', 'r', '"', ':')
@@ -2496,12 +8563,12 @@ func (v NetworkStatusResponse) Equal(o NetworkStatusResponse) bool {
 	return v.CurrentBlockIdentifier.Equal(o.CurrentBlockIdentifier) &&
 		v.CurrentBlockTimestamp == o.CurrentBlockTimestamp &&
 		v.GenesisBlockIdentifier.Equal(o.GenesisBlockIdentifier) &&
-		v.OldestBlockIdentifier.Value.Equal(o.OldestBlockIdentifier.Value) &&
 		v.OldestBlockIdentifier.Set == o.OldestBlockIdentifier.Set &&
+		v.OldestBlockIdentifier.Value.Equal(o.OldestBlockIdentifier.Value) &&
 		len(v.Peers) == len(o.Peers) &&
 		peerSliceEqual(v.Peers, o.Peers) &&
-		v.SyncStatus.Value.Equal(o.SyncStatus.Value) &&
-		v.SyncStatus.Set == o.SyncStatus.Set
+		v.SyncStatus.Set == o.SyncStatus.Set &&
+		v.SyncStatus.Value.Equal(o.SyncStatus.Value)
 }
 
 // Reset resets NetworkStatusResponse so that it can be reused.
@@ -2520,11 +8587,10 @@ func (v *NetworkStatusResponse) Reset() {
 //
 // Operations contain all balance-changing information within a transaction.
 // They are always one-sided (only affect 1 AccountIdentifier) and can succeed
-// or fail independently from a Transaction.
-//
-// Operations are used both to represent on-chain data (Data API) and to
-// construct new transactions (Construction API), creating a standard interface
-// for reading and writing to blockchains.
+// or fail independently from a Transaction. Operations are used both to
+// represent on-chain data (Data API) and to construct new transactions
+// (Construction API), creating a standard interface for reading and writing to
+// blockchains.
 type Operation struct {
 	Account             OptionalAccountIdentifierType
 	Amount              OptionalAmountType
@@ -2533,24 +8599,21 @@ type Operation struct {
 	OperationIdentifier OperationIdentifier
 	// Restrict referenced related_operations to identifier indices < the
 	// current operation_identifier.index. This ensures there exists a clear
-	// DAG-structure of relations.
-	//
-	// Since operations are one-sided, one could imagine relating operations in
-	// a single transfer or linking operations in a call tree.
+	// DAG-structure of relations. Since operations are one-sided, one could
+	// imagine relating operations in a single transfer or linking operations in
+	// a call tree.
 	RelatedOperations []OperationIdentifier
 	// Status is the network-specific status of the operation. Status is not
 	// defined on the transaction object because blockchains with smart
 	// contracts may have transactions that partially apply (some operations are
 	// successful and some are not). Blockchains with atomic transactions (all
 	// operations succeed or all operations fail) will have the same status for
-	// each operation.
-	//
-	// On-chain operations (operations retrieved in the `/block` and
-	// `/block/transaction` endpoints) MUST have a populated status field
+	// each operation. On-chain operations (operations retrieved in the `/block`
+	// and `/block/transaction` endpoints) MUST have a populated status field
 	// (anything on-chain must have succeeded or failed). However, operations
-	// provided during transaction construction (often times called "intent" in
-	// the documentation) MUST NOT have a populated status field (operations yet
-	// to be included on-chain have not yet succeeded or failed).
+	// provided during transaction construction (often times called \"intent\"
+	// in the documentation) MUST NOT have a populated status field (operations
+	// yet to be included on-chain have not yet succeeded or failed).
 	Status OptionalStringType
 	// Type is the network-specific type of the operation. Ensure that any type
 	// that can be returned here is also specified in the
@@ -2559,6 +8622,191 @@ type Operation struct {
 	Type string
 }
 
+// DecodeJSON decodes an Operation value from JSON.
+func (v *Operation) DecodeJSON(d *json.Decoder) error {
+	more, err := d.ObjectStart()
+	if err != nil {
+		return err
+	}
+	seen := uint64(0)
+	for more {
+		var key []byte
+		if key, err = d.Key(); err != nil {
+			return err
+		}
+		switch string(key) {
+		case "account":
+			if d.Null() {
+				v.Account.Set = false
+				err = d.Report(json.NullOptional)
+				break
+			}
+			v.Account.Set = true
+			err = v.Account.Value.DecodeJSON(d)
+		case "amount":
+			if d.Null() {
+				v.Amount.Set = false
+				err = d.Report(json.NullOptional)
+				break
+			}
+			v.Amount.Set = true
+			err = v.Amount.Value.DecodeJSON(d)
+		case "coin_change":
+			if d.Null() {
+				v.CoinChange.Set = false
+				err = d.Report(json.NullOptional)
+				break
+			}
+			v.CoinChange.Set = true
+			err = v.CoinChange.Value.DecodeJSON(d)
+		case "metadata":
+			if d.Null() {
+				v.Metadata = v.Metadata[:0]
+				err = d.Report(json.NullOptional)
+				break
+			}
+			v.Metadata, err = decodeMapObject(d, v.Metadata)
+		case "operation_identifier":
+			err = v.OperationIdentifier.DecodeJSON(d)
+			seen |= 1 << 0
+		case "related_operations":
+			if d.Null() {
+				v.RelatedOperations = v.RelatedOperations[:0]
+				err = d.Report(json.NullOptional)
+				break
+			}
+			v.RelatedOperations = v.RelatedOperations[:0]
+			if d.Null() {
+				break
+			}
+			var next bool
+			if next, err = d.ArrayStart(); err != nil {
+				break
+			}
+			for next {
+				n := len(v.RelatedOperations)
+				if n < cap(v.RelatedOperations) {
+					v.RelatedOperations = v.RelatedOperations[:n+1]
+					v.RelatedOperations[n].Reset()
+				} else {
+					v.RelatedOperations = append(v.RelatedOperations, OperationIdentifier{})
+				}
+				if err = v.RelatedOperations[n].DecodeJSON(d); err != nil {
+					break
+				}
+				if next, err = d.ArrayNext(); err != nil {
+					break
+				}
+			}
+		case "status":
+			if d.Null() {
+				v.Status.Set = false
+				err = d.Report(json.NullOptional)
+				break
+			}
+			v.Status.Set = true
+			var s []byte
+			s, err = d.String()
+			v.Status.Value = string(s)
+		case "type":
+			var s []byte
+			s, err = d.String()
+			v.Type = string(s)
+			seen |= 1 << 1
+		default:
+			if err = d.Report(json.UnknownKey); err == nil {
+				err = d.Skip()
+			}
+		}
+		if err != nil {
+			return err
+		}
+		if more, err = d.ObjectNext(); err != nil {
+			return err
+		}
+	}
+	if seen&(1<<0) == 0 {
+		return d.Errorf("missing required field \"operation_identifier\" in Operation")
+	}
+	if seen&(1<<1) == 0 {
+		return d.Errorf("missing required field \"type\" in Operation")
+	}
+	return nil
+}
+
+// Diff returns the differences between two Operation values.
+func (v Operation) Diff(o Operation) []Difference {
+	return v.appendDiffs(nil, "", o)
+}
+
+func (v Operation) appendDiffs(d []Difference, path string, o Operation) []Difference {
+	switch {
+	case v.Account.Set && o.Account.Set:
+		d = v.Account.Value.appendDiffs(d, diffPath(path, "account"), o.Account.Value)
+	case v.Account.Set:
+		d = append(d, Difference{A: string(v.Account.Value.EncodeJSON(nil)), Path: diffPath(path, "account")})
+	case o.Account.Set:
+		d = append(d, Difference{B: string(o.Account.Value.EncodeJSON(nil)), Path: diffPath(path, "account")})
+	}
+	switch {
+	case v.Amount.Set && o.Amount.Set:
+		d = v.Amount.Value.appendDiffs(d, diffPath(path, "amount"), o.Amount.Value)
+	case v.Amount.Set:
+		d = append(d, Difference{A: string(v.Amount.Value.EncodeJSON(nil)), Path: diffPath(path, "amount")})
+	case o.Amount.Set:
+		d = append(d, Difference{B: string(o.Amount.Value.EncodeJSON(nil)), Path: diffPath(path, "amount")})
+	}
+	switch {
+	case v.CoinChange.Set && o.CoinChange.Set:
+		d = v.CoinChange.Value.appendDiffs(d, diffPath(path, "coin_change"), o.CoinChange.Value)
+	case v.CoinChange.Set:
+		d = append(d, Difference{A: string(v.CoinChange.Value.EncodeJSON(nil)), Path: diffPath(path, "coin_change")})
+	case o.CoinChange.Set:
+		d = append(d, Difference{B: string(o.CoinChange.Value.EncodeJSON(nil)), Path: diffPath(path, "coin_change")})
+	}
+	if string(v.Metadata) != string(o.Metadata) {
+		d = append(d, Difference{
+			A:    string(appendMapObject(nil, v.Metadata)),
+			B:    string(appendMapObject(nil, o.Metadata)),
+			Path: diffPath(path, "metadata"),
+		})
+	}
+	d = v.OperationIdentifier.appendDiffs(d, diffPath(path, "operation_identifier"), o.OperationIdentifier)
+	for i := 0; i < len(v.RelatedOperations) || i < len(o.RelatedOperations); i++ {
+		p := diffIndex(diffPath(path, "related_operations"), i)
+		switch {
+		case i >= len(o.RelatedOperations):
+			d = append(d, Difference{A: string(v.RelatedOperations[i].EncodeJSON(nil)), Path: p})
+		case i >= len(v.RelatedOperations):
+			d = append(d, Difference{B: string(o.RelatedOperations[i].EncodeJSON(nil)), Path: p})
+		default:
+			d = v.RelatedOperations[i].appendDiffs(d, p, o.RelatedOperations[i])
+		}
+	}
+	switch {
+	case v.Status.Set && o.Status.Set:
+		if v.Status.Value != o.Status.Value {
+			d = append(d, Difference{
+				A:    string(json.AppendString(nil, v.Status.Value)),
+				B:    string(json.AppendString(nil, o.Status.Value)),
+				Path: diffPath(path, "status"),
+			})
+		}
+	case v.Status.Set:
+		d = append(d, Difference{A: string(json.AppendString(nil, v.Status.Value)), Path: diffPath(path, "status")})
+	case o.Status.Set:
+		d = append(d, Difference{B: string(json.AppendString(nil, o.Status.Value)), Path: diffPath(path, "status")})
+	}
+	if v.Type != o.Type {
+		d = append(d, Difference{
+			A:    string(json.AppendString(nil, v.Type)),
+			B:    string(json.AppendString(nil, o.Type)),
+			Path: diffPath(path, "type"),
+		})
+	}
+	return d
+}
+
 // EncodeJSON encodes Operation into JSON.
 func (v Operation) EncodeJSON(b []byte) []byte {
 	b = append(b, "{"...)
@@ -2607,18 +8855,18 @@ func (v Operation) EncodeJSON(b []byte) []byte {
 
 // Equal returns whether two Operation values are equal.
 func (v Operation) Equal(o Operation) bool {
-	return v.Account.Value.Equal(o.Account.Value) &&
-		v.Account.Set == o.Account.Set &&
-		v.Amount.Value.Equal(o.Amount.Value) &&
+	return v.Account.Set == o.Account.Set &&
+		v.Account.Value.Equal(o.Account.Value) &&
 		v.Amount.Set == o.Amount.Set &&
-		v.CoinChange.Value.Equal(o.CoinChange.Value) &&
+		v.Amount.Value.Equal(o.Amount.Value) &&
 		v.CoinChange.Set == o.CoinChange.Set &&
+		v.CoinChange.Value.Equal(o.CoinChange.Value) &&
 		string(v.Metadata) == string(o.Metadata) &&
 		v.OperationIdentifier.Equal(o.OperationIdentifier) &&
 		len(v.RelatedOperations) == len(o.RelatedOperations) &&
 		operationIdentifierSliceEqual(v.RelatedOperations, o.RelatedOperations) &&
-		v.Status.Value == o.Status.Value &&
 		v.Status.Set == o.Status.Set &&
+		v.Status.Value == o.Status.Value &&
 		v.Type == o.Type
 }
 
@@ -2646,21 +8894,89 @@ type OperationIdentifier struct {
 	// The operation index is used to ensure each operation has a unique
 	// identifier within a transaction. This index is only relative to the
 	// transaction and NOT GLOBAL. The operations in each transaction should
-	// start from index 0.
-	//
-	// To clarify, there may not be any notion of an operation index in the
-	// blockchain being described.
+	// start from index 0. To clarify, there may not be any notion of an
+	// operation index in the blockchain being described.
 	Index int64
 	// Some blockchains specify an operation index that is essential for client
 	// use. For example, Bitcoin uses a network_index to identify which UTXO was
-	// used in a transaction.
-	//
-	// network_index should not be populated if there is no notion of an
-	// operation index in a blockchain (typically most account-based
-	// blockchains).
+	// used in a transaction. network_index should not be populated if there is
+	// no notion of an operation index in a blockchain (typically most
+	// account-based blockchains).
 	NetworkIndex OptionalInt64Type
 }
 
+// DecodeJSON decodes an OperationIdentifier value from JSON.
+func (v *OperationIdentifier) DecodeJSON(d *json.Decoder) error {
+	more, err := d.ObjectStart()
+	if err != nil {
+		return err
+	}
+	seen := uint64(0)
+	for more {
+		var key []byte
+		if key, err = d.Key(); err != nil {
+			return err
+		}
+		switch string(key) {
+		case "index":
+			v.Index, err = d.Int64()
+			seen |= 1 << 0
+		case "network_index":
+			if d.Null() {
+				v.NetworkIndex.Set = false
+				err = d.Report(json.NullOptional)
+				break
+			}
+			v.NetworkIndex.Set = true
+			v.NetworkIndex.Value, err = d.Int64()
+		default:
+			if err = d.Report(json.UnknownKey); err == nil {
+				err = d.Skip()
+			}
+		}
+		if err != nil {
+			return err
+		}
+		if more, err = d.ObjectNext(); err != nil {
+			return err
+		}
+	}
+	if seen&(1<<0) == 0 {
+		return d.Errorf("missing required field \"index\" in OperationIdentifier")
+	}
+	return nil
+}
+
+// Diff returns the differences between two OperationIdentifier values.
+func (v OperationIdentifier) Diff(o OperationIdentifier) []Difference {
+	return v.appendDiffs(nil, "", o)
+}
+
+func (v OperationIdentifier) appendDiffs(d []Difference, path string, o OperationIdentifier) []Difference {
+	if v.Index != o.Index {
+		d = append(d, Difference{
+			A:    string(json.AppendInt(nil, v.Index)),
+			B:    string(json.AppendInt(nil, o.Index)),
+			Path: diffPath(path, "index"),
+		})
+	}
+	switch {
+	case v.NetworkIndex.Set && o.NetworkIndex.Set:
+		if v.NetworkIndex.Value != o.NetworkIndex.Value {
+			d = append(d, Difference{
+				A:    string(json.AppendInt(nil, v.NetworkIndex.Value)),
+				B:    string(json.AppendInt(nil, o.NetworkIndex.Value)),
+				Path: diffPath(path, "network_index"),
+			})
+		}
+	case v.NetworkIndex.Set:
+		d = append(d, Difference{A: string(json.AppendInt(nil, v.NetworkIndex.Value)), Path: diffPath(path, "network_index")})
+	case o.NetworkIndex.Set:
+		d = append(d, Difference{B: string(json.AppendInt(nil, o.NetworkIndex.Value)), Path: diffPath(path, "network_index")})
+	}
+	return d
+}
+
 // EncodeJSON encodes OperationIdentifier into JSON.
 func (v OperationIdentifier) EncodeJSON(b []byte) []byte {
 	b = append(b, `{"index":`...)
@@ -2678,8 +8994,8 @@ func (v OperationIdentifier) EncodeJSON(b []byte) []byte {
 // Equal returns whether two OperationIdentifier values are equal.
 func (v OperationIdentifier) Equal(o OperationIdentifier) bool {
 	return v.Index == o.Index &&
-		v.NetworkIndex.Value == o.NetworkIndex.Value &&
-		v.NetworkIndex.Set == o.NetworkIndex.Set
+		v.NetworkIndex.Set == o.NetworkIndex.Set &&
+		v.NetworkIndex.Value == o.NetworkIndex.Value
 }
 
 // Reset resets OperationIdentifier so that it can be reused.
@@ -2697,14 +9013,78 @@ type OperationStatus struct {
 	// An Operation is considered successful if the Operation.Amount should
 	// affect the Operation.Account. Some blockchains (like Bitcoin) only
 	// include successful operations in blocks but other blockchains (like
-	// Ethereum) include unsuccessful operations that incur a fee.
-	//
-	// To reconcile the computed balance from the stream of Operations, it is
-	// critical to understand which Operation.Status indicate an Operation is
-	// successful and should affect an Account.
+	// Ethereum) include unsuccessful operations that incur a fee. To reconcile
+	// the computed balance from the stream of Operations, it is critical to
+	// understand which Operation.Status indicate an Operation is successful and
+	// should affect an Account.
 	Successful bool
 }
 
+// DecodeJSON decodes an OperationStatus value from JSON.
+func (v *OperationStatus) DecodeJSON(d *json.Decoder) error {
+	more, err := d.ObjectStart()
+	if err != nil {
+		return err
+	}
+	seen := uint64(0)
+	for more {
+		var key []byte
+		if key, err = d.Key(); err != nil {
+			return err
+		}
+		switch string(key) {
+		case "status":
+			var s []byte
+			s, err = d.String()
+			v.Status = string(s)
+			seen |= 1 << 0
+		case "successful":
+			v.Successful, err = d.Bool()
+			seen |= 1 << 1
+		default:
+			if err = d.Report(json.UnknownKey); err == nil {
+				err = d.Skip()
+			}
+		}
+		if err != nil {
+			return err
+		}
+		if more, err = d.ObjectNext(); err != nil {
+			return err
+		}
+	}
+	if seen&(1<<0) == 0 {
+		return d.Errorf("missing required field \"status\" in OperationStatus")
+	}
+	if seen&(1<<1) == 0 {
+		return d.Errorf("missing required field \"successful\" in OperationStatus")
+	}
+	return nil
+}
+
+// Diff returns the differences between two OperationStatus values.
+func (v OperationStatus) Diff(o OperationStatus) []Difference {
+	return v.appendDiffs(nil, "", o)
+}
+
+func (v OperationStatus) appendDiffs(d []Difference, path string, o OperationStatus) []Difference {
+	if v.Status != o.Status {
+		d = append(d, Difference{
+			A:    string(json.AppendString(nil, v.Status)),
+			B:    string(json.AppendString(nil, o.Status)),
+			Path: diffPath(path, "status"),
+		})
+	}
+	if v.Successful != o.Successful {
+		d = append(d, Difference{
+			A:    string(json.AppendBool(nil, v.Successful)),
+			B:    string(json.AppendBool(nil, o.Successful)),
+			Path: diffPath(path, "successful"),
+		})
+	}
+	return d
+}
+
 // EncodeJSON encodes OperationStatus into JSON.
 func (v OperationStatus) EncodeJSON(b []byte) []byte {
 	b = append(b, `{"status":`...)
@@ -2727,9 +9107,8 @@ func (v *OperationStatus) Reset() {
 }
 
 // Operator is used by query-related endpoints to determine how to apply
-// conditions.
-//
-// If this field is not populated, the default `and` value will be used.
+// conditions. If this field is not populated, the default `and` value will be
+// used.
 type Operator string
 
 // Validate the Operator value.
@@ -2750,6 +9129,88 @@ type PartialBlockIdentifier struct {
 	Index OptionalInt64Type
 }
 
+// DecodeJSON decodes a PartialBlockIdentifier value from JSON.
+func (v *PartialBlockIdentifier) DecodeJSON(d *json.Decoder) error {
+	more, err := d.ObjectStart()
+	if err != nil {
+		return err
+	}
+	for more {
+		var key []byte
+		if key, err = d.Key(); err != nil {
+			return err
+		}
+		switch string(key) {
+		case "hash":
+			if d.Null() {
+				v.Hash.Set = false
+				err = d.Report(json.NullOptional)
+				break
+			}
+			v.Hash.Set = true
+			var s []byte
+			s, err = d.String()
+			v.Hash.Value = string(s)
+		case "index":
+			if d.Null() {
+				v.Index.Set = false
+				err = d.Report(json.NullOptional)
+				break
+			}
+			v.Index.Set = true
+			v.Index.Value, err = d.Int64()
+		default:
+			if err = d.Report(json.UnknownKey); err == nil {
+				err = d.Skip()
+			}
+		}
+		if err != nil {
+			return err
+		}
+		if more, err = d.ObjectNext(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Diff returns the differences between two PartialBlockIdentifier values.
+func (v PartialBlockIdentifier) Diff(o PartialBlockIdentifier) []Difference {
+	return v.appendDiffs(nil, "", o)
+}
+
+func (v PartialBlockIdentifier) appendDiffs(d []Difference, path string, o PartialBlockIdentifier) []Difference {
+	switch {
+	case v.Hash.Set && o.Hash.Set:
+		if v.Hash.Value != o.Hash.Value {
+			d = append(d, Difference{
+				A:    string(json.AppendString(nil, v.Hash.Value)),
+				B:    string(json.AppendString(nil, o.Hash.Value)),
+				Path: diffPath(path, "hash"),
+			})
+		}
+	case v.Hash.Set:
+		d = append(d, Difference{A: string(json.AppendString(nil, v.Hash.Value)), Path: diffPath(path, "hash")})
+	case o.Hash.Set:
+		d = append(d, Difference{B: string(json.AppendString(nil, o.Hash.Value)), Path: diffPath(path, "hash")})
+	}
+	switch {
+	case v.Index.Set && o.Index.Set:
+		if v.Index.Value != o.Index.Value {
+			d = append(d, Difference{
+				A:    string(json.AppendInt(nil, v.Index.Value)),
+				B:    string(json.AppendInt(nil, o.Index.Value)),
+				Path: diffPath(path, "index"),
+			})
+		}
+	case v.Index.Set:
+		d = append(d, Difference{A: string(json.AppendInt(nil, v.Index.Value)), Path: diffPath(path, "index")})
+	case o.Index.Set:
+		d = append(d, Difference{B: string(json.AppendInt(nil, o.Index.Value)), Path: diffPath(path, "index")})
+	}
+	return d
+}
+
 // EncodeJSON encodes PartialBlockIdentifier into JSON.
 func (v PartialBlockIdentifier) EncodeJSON(b []byte) []byte {
 	b = append(b, "{"...)
@@ -2763,16 +9224,19 @@ func (v PartialBlockIdentifier) EncodeJSON(b []byte) []byte {
 		b = json.AppendInt(b, v.Index.Value)
 		b = append(b, ","...)
 	}
+	if b[len(b)-1] == '{' {
+		return append(b, "}"...)
+	}
 	b[len(b)-1] = '}'
 	return b
 }
 
 // Equal returns whether two PartialBlockIdentifier values are equal.
 func (v PartialBlockIdentifier) Equal(o PartialBlockIdentifier) bool {
-	return v.Hash.Value == o.Hash.Value &&
-		v.Hash.Set == o.Hash.Set &&
-		v.Index.Value == o.Index.Value &&
-		v.Index.Set == o.Index.Set
+	return v.Hash.Set == o.Hash.Set &&
+		v.Hash.Value == o.Hash.Value &&
+		v.Index.Set == o.Index.Set &&
+		v.Index.Value == o.Index.Value
 }
 
 // Reset resets PartialBlockIdentifier so that it can be reused.
@@ -2791,6 +9255,72 @@ type Peer struct {
 	PeerID   string
 }
 
+// DecodeJSON decodes a Peer value from JSON.
+func (v *Peer) DecodeJSON(d *json.Decoder) error {
+	more, err := d.ObjectStart()
+	if err != nil {
+		return err
+	}
+	seen := uint64(0)
+	for more {
+		var key []byte
+		if key, err = d.Key(); err != nil {
+			return err
+		}
+		switch string(key) {
+		case "metadata":
+			if d.Null() {
+				v.Metadata = v.Metadata[:0]
+				err = d.Report(json.NullOptional)
+				break
+			}
+			v.Metadata, err = decodeMapObject(d, v.Metadata)
+		case "peer_id":
+			var s []byte
+			s, err = d.String()
+			v.PeerID = string(s)
+			seen |= 1 << 0
+		default:
+			if err = d.Report(json.UnknownKey); err == nil {
+				err = d.Skip()
+			}
+		}
+		if err != nil {
+			return err
+		}
+		if more, err = d.ObjectNext(); err != nil {
+			return err
+		}
+	}
+	if seen&(1<<0) == 0 {
+		return d.Errorf("missing required field \"peer_id\" in Peer")
+	}
+	return nil
+}
+
+// Diff returns the differences between two Peer values.
+func (v Peer) Diff(o Peer) []Difference {
+	return v.appendDiffs(nil, "", o)
+}
+
+func (v Peer) appendDiffs(d []Difference, path string, o Peer) []Difference {
+	if string(v.Metadata) != string(o.Metadata) {
+		d = append(d, Difference{
+			A:    string(appendMapObject(nil, v.Metadata)),
+			B:    string(appendMapObject(nil, o.Metadata)),
+			Path: diffPath(path, "metadata"),
+		})
+	}
+	if v.PeerID != o.PeerID {
+		d = append(d, Difference{
+			A:    string(json.AppendString(nil, v.PeerID)),
+			B:    string(json.AppendString(nil, o.PeerID)),
+			Path: diffPath(path, "peer_id"),
+		})
+	}
+	return d
+}
+
 // EncodeJSON encodes Peer into JSON.
 func (v Peer) EncodeJSON(b []byte) []byte {
 	b = append(b, "{"...)
@@ -2817,16 +9347,78 @@ func (v *Peer) Reset() {
 }
 
 // PublicKey contains a public key byte array for a particular CurveType encoded
-// in hex.
-//
-// Note that there is no PrivateKey struct as this is NEVER the concern of an
-// implementation.
+// in hex. Note that there is no PrivateKey struct as this is NEVER the concern
+// of an implementation.
 type PublicKey struct {
-	// Hex-encoded public key bytes in the format specified by the CurveType.
 	Bytes     []byte
 	CurveType CurveType
 }
 
+// DecodeJSON decodes a PublicKey value from JSON.
+func (v *PublicKey) DecodeJSON(d *json.Decoder) error {
+	more, err := d.ObjectStart()
+	if err != nil {
+		return err
+	}
+	seen := uint64(0)
+	for more {
+		var key []byte
+		if key, err = d.Key(); err != nil {
+			return err
+		}
+		switch string(key) {
+		case "hex_bytes":
+			v.Bytes, err = d.HexBytes(v.Bytes[:0])
+			seen |= 1 << 0
+		case "curve_type":
+			var s []byte
+			s, err = d.String()
+			v.CurveType = CurveType(s)
+			seen |= 1 << 1
+		default:
+			if err = d.Report(json.UnknownKey); err == nil {
+				err = d.Skip()
+			}
+		}
+		if err != nil {
+			return err
+		}
+		if more, err = d.ObjectNext(); err != nil {
+			return err
+		}
+	}
+	if seen&(1<<0) == 0 {
+		return d.Errorf("missing required field \"hex_bytes\" in PublicKey")
+	}
+	if seen&(1<<1) == 0 {
+		return d.Errorf("missing required field \"curve_type\" in PublicKey")
+	}
+	return nil
+}
+
+// Diff returns the differences between two PublicKey values.
+func (v PublicKey) Diff(o PublicKey) []Difference {
+	return v.appendDiffs(nil, "", o)
+}
+
+func (v PublicKey) appendDiffs(d []Difference, path string, o PublicKey) []Difference {
+	if string(v.Bytes) != string(o.Bytes) {
+		d = append(d, Difference{
+			A:    string(json.AppendHexBytes(nil, v.Bytes)),
+			B:    string(json.AppendHexBytes(nil, o.Bytes)),
+			Path: diffPath(path, "hex_bytes"),
+		})
+	}
+	if v.CurveType != o.CurveType {
+		d = append(d, Difference{
+			A:    string(json.AppendString(nil, string(v.CurveType))),
+			B:    string(json.AppendString(nil, string(o.CurveType))),
+			Path: diffPath(path, "curve_type"),
+		})
+	}
+	return d
+}
+
 // EncodeJSON encodes PublicKey into JSON.
 func (v PublicKey) EncodeJSON(b []byte) []byte {
 	b = append(b, `{"hex_bytes":`...)
@@ -2859,6 +9451,81 @@ type RelatedTransaction struct {
 	TransactionIdentifier TransactionIdentifier
 }
 
+// DecodeJSON decodes a RelatedTransaction value from JSON.
+func (v *RelatedTransaction) DecodeJSON(d *json.Decoder) error {
+	more, err := d.ObjectStart()
+	if err != nil {
+		return err
+	}
+	seen := uint64(0)
+	for more {
+		var key []byte
+		if key, err = d.Key(); err != nil {
+			return err
+		}
+		switch string(key) {
+		case "direction":
+			var s []byte
+			s, err = d.String()
+			v.Direction = Direction(s)
+			seen |= 1 << 0
+		case "network_identifier":
+			if d.Null() {
+				v.NetworkIdentifier.Set = false
+				err = d.Report(json.NullOptional)
+				break
+			}
+			v.NetworkIdentifier.Set = true
+			err = v.NetworkIdentifier.Value.DecodeJSON(d)
+		case "transaction_identifier":
+			err = v.TransactionIdentifier.DecodeJSON(d)
+			seen |= 1 << 1
+		default:
+			if err = d.Report(json.UnknownKey); err == nil {
+				err = d.Skip()
+			}
+		}
+		if err != nil {
+			return err
+		}
+		if more, err = d.ObjectNext(); err != nil {
+			return err
+		}
+	}
+	if seen&(1<<0) == 0 {
+		return d.Errorf("missing required field \"direction\" in RelatedTransaction")
+	}
+	if seen&(1<<1) == 0 {
+		return d.Errorf("missing required field \"transaction_identifier\" in RelatedTransaction")
+	}
+	return nil
+}
+
+// Diff returns the differences between two RelatedTransaction values.
+func (v RelatedTransaction) Diff(o RelatedTransaction) []Difference {
+	return v.appendDiffs(nil, "", o)
+}
+
+func (v RelatedTransaction) appendDiffs(d []Difference, path string, o RelatedTransaction) []Difference {
+	if v.Direction != o.Direction {
+		d = append(d, Difference{
+			A:    string(json.AppendString(nil, string(v.Direction))),
+			B:    string(json.AppendString(nil, string(o.Direction))),
+			Path: diffPath(path, "direction"),
+		})
+	}
+	switch {
+	case v.NetworkIdentifier.Set && o.NetworkIdentifier.Set:
+		d = v.NetworkIdentifier.Value.appendDiffs(d, diffPath(path, "network_identifier"), o.NetworkIdentifier.Value)
+	case v.NetworkIdentifier.Set:
+		d = append(d, Difference{A: string(v.NetworkIdentifier.Value.EncodeJSON(nil)), Path: diffPath(path, "network_identifier")})
+	case o.NetworkIdentifier.Set:
+		d = append(d, Difference{B: string(o.NetworkIdentifier.Value.EncodeJSON(nil)), Path: diffPath(path, "network_identifier")})
+	}
+	d = v.TransactionIdentifier.appendDiffs(d, diffPath(path, "transaction_identifier"), o.TransactionIdentifier)
+	return d
+}
+
 // EncodeJSON encodes RelatedTransaction into JSON.
 func (v RelatedTransaction) EncodeJSON(b []byte) []byte {
 	b = append(b, `{"direction":`...)
@@ -2877,8 +9544,8 @@ func (v RelatedTransaction) EncodeJSON(b []byte) []byte {
 // Equal returns whether two RelatedTransaction values are equal.
 func (v RelatedTransaction) Equal(o RelatedTransaction) bool {
 	return v.Direction == o.Direction &&
-		v.NetworkIdentifier.Value.Equal(o.NetworkIdentifier.Value) &&
 		v.NetworkIdentifier.Set == o.NetworkIdentifier.Set &&
+		v.NetworkIdentifier.Value.Equal(o.NetworkIdentifier.Value) &&
 		v.TransactionIdentifier.Equal(o.TransactionIdentifier)
 }
 
@@ -2905,17 +9572,13 @@ type SearchTransactionsRequest struct {
 	Limit OptionalInt64Type
 	// max_block is the largest block index to consider when searching for
 	// transactions. If this field is not populated, the current block is
-	// considered the max_block.
-	//
-	// If you do not specify a max_block, it is possible a newly synced block
-	// will interfere with paginated transaction queries (as the offset could
-	// become invalid with newly added rows).
+	// considered the max_block. If you do not specify a max_block, it is
+	// possible a newly synced block will interfere with paginated transaction
+	// queries (as the offset could become invalid with newly added rows).
 	MaxBlock OptionalInt64Type
 	// offset is the offset into the query result to start returning
-	// transactions.
-	//
-	// If any search conditions are changed, the query offset will change and
-	// you must restart your search iteration.
+	// transactions. If any search conditions are changed, the query offset will
+	// change and you must restart your search iteration.
 	Offset   OptionalInt64Type
 	Operator OptionalOperatorType
 	// status is the network-specific operation type.
@@ -2928,6 +9591,296 @@ type SearchTransactionsRequest struct {
 	Type OptionalStringType
 }
 
+// DecodeJSON decodes a SearchTransactionsRequest value from JSON.
+func (v *SearchTransactionsRequest) DecodeJSON(d *json.Decoder, network *NetworkIdentifier) error {
+	more, err := d.ObjectStart()
+	if err != nil {
+		return err
+	}
+	for more {
+		var key []byte
+		if key, err = d.Key(); err != nil {
+			return err
+		}
+		switch string(key) {
+		case "network_identifier":
+			if network == nil {
+				err = d.Skip()
+			} else {
+				err = network.DecodeJSON(d)
+			}
+		case "account_identifier":
+			if d.Null() {
+				v.AccountIdentifier.Set = false
+				err = d.Report(json.NullOptional)
+				break
+			}
+			v.AccountIdentifier.Set = true
+			err = v.AccountIdentifier.Value.DecodeJSON(d)
+		case "address":
+			if d.Null() {
+				v.Address.Set = false
+				err = d.Report(json.NullOptional)
+				break
+			}
+			v.Address.Set = true
+			var s []byte
+			s, err = d.String()
+			v.Address.Value = string(s)
+		case "coin_identifier":
+			if d.Null() {
+				v.CoinIdentifier.Set = false
+				err = d.Report(json.NullOptional)
+				break
+			}
+			v.CoinIdentifier.Set = true
+			err = v.CoinIdentifier.Value.DecodeJSON(d)
+		case "currency":
+			if d.Null() {
+				v.Currency.Set = false
+				err = d.Report(json.NullOptional)
+				break
+			}
+			v.Currency.Set = true
+			err = v.Currency.Value.DecodeJSON(d)
+		case "limit":
+			if d.Null() {
+				v.Limit.Set = false
+				err = d.Report(json.NullOptional)
+				break
+			}
+			v.Limit.Set = true
+			v.Limit.Value, err = d.Int64()
+		case "max_block":
+			if d.Null() {
+				v.MaxBlock.Set = false
+				err = d.Report(json.NullOptional)
+				break
+			}
+			v.MaxBlock.Set = true
+			v.MaxBlock.Value, err = d.Int64()
+		case "offset":
+			if d.Null() {
+				v.Offset.Set = false
+				err = d.Report(json.NullOptional)
+				break
+			}
+			v.Offset.Set = true
+			v.Offset.Value, err = d.Int64()
+		case "operator":
+			if d.Null() {
+				v.Operator.Set = false
+				err = d.Report(json.NullOptional)
+				break
+			}
+			v.Operator.Set = true
+			var s []byte
+			s, err = d.String()
+			v.Operator.Value = Operator(s)
+		case "status":
+			if d.Null() {
+				v.Status.Set = false
+				err = d.Report(json.NullOptional)
+				break
+			}
+			v.Status.Set = true
+			var s []byte
+			s, err = d.String()
+			v.Status.Value = string(s)
+		case "success":
+			if d.Null() {
+				v.Success.Set = false
+				err = d.Report(json.NullOptional)
+				break
+			}
+			v.Success.Set = true
+			v.Success.Value, err = d.Bool()
+		case "transaction_identifier":
+			if d.Null() {
+				v.TransactionIdentifier.Set = false
+				err = d.Report(json.NullOptional)
+				break
+			}
+			v.TransactionIdentifier.Set = true
+			err = v.TransactionIdentifier.Value.DecodeJSON(d)
+		case "type":
+			if d.Null() {
+				v.Type.Set = false
+				err = d.Report(json.NullOptional)
+				break
+			}
+			v.Type.Set = true
+			var s []byte
+			s, err = d.String()
+			v.Type.Value = string(s)
+		default:
+			if err = d.Report(json.UnknownKey); err == nil {
+				err = d.Skip()
+			}
+		}
+		if err != nil {
+			return err
+		}
+		if more, err = d.ObjectNext(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Diff returns the differences between two SearchTransactionsRequest values.
+func (v SearchTransactionsRequest) Diff(o SearchTransactionsRequest) []Difference {
+	return v.appendDiffs(nil, "", o)
+}
+
+func (v SearchTransactionsRequest) appendDiffs(d []Difference, path string, o SearchTransactionsRequest) []Difference {
+	switch {
+	case v.AccountIdentifier.Set && o.AccountIdentifier.Set:
+		d = v.AccountIdentifier.Value.appendDiffs(d, diffPath(path, "account_identifier"), o.AccountIdentifier.Value)
+	case v.AccountIdentifier.Set:
+		d = append(d, Difference{A: string(v.AccountIdentifier.Value.EncodeJSON(nil)), Path: diffPath(path, "account_identifier")})
+	case o.AccountIdentifier.Set:
+		d = append(d, Difference{B: string(o.AccountIdentifier.Value.EncodeJSON(nil)), Path: diffPath(path, "account_identifier")})
+	}
+	switch {
+	case v.Address.Set && o.Address.Set:
+		if v.Address.Value != o.Address.Value {
+			d = append(d, Difference{
+				A:    string(json.AppendString(nil, v.Address.Value)),
+				B:    string(json.AppendString(nil, o.Address.Value)),
+				Path: diffPath(path, "address"),
+			})
+		}
+	case v.Address.Set:
+		d = append(d, Difference{A: string(json.AppendString(nil, v.Address.Value)), Path: diffPath(path, "address")})
+	case o.Address.Set:
+		d = append(d, Difference{B: string(json.AppendString(nil, o.Address.Value)), Path: diffPath(path, "address")})
+	}
+	switch {
+	case v.CoinIdentifier.Set && o.CoinIdentifier.Set:
+		d = v.CoinIdentifier.Value.appendDiffs(d, diffPath(path, "coin_identifier"), o.CoinIdentifier.Value)
+	case v.CoinIdentifier.Set:
+		d = append(d, Difference{A: string(v.CoinIdentifier.Value.EncodeJSON(nil)), Path: diffPath(path, "coin_identifier")})
+	case o.CoinIdentifier.Set:
+		d = append(d, Difference{B: string(o.CoinIdentifier.Value.EncodeJSON(nil)), Path: diffPath(path, "coin_identifier")})
+	}
+	switch {
+	case v.Currency.Set && o.Currency.Set:
+		d = v.Currency.Value.appendDiffs(d, diffPath(path, "currency"), o.Currency.Value)
+	case v.Currency.Set:
+		d = append(d, Difference{A: string(v.Currency.Value.EncodeJSON(nil)), Path: diffPath(path, "currency")})
+	case o.Currency.Set:
+		d = append(d, Difference{B: string(o.Currency.Value.EncodeJSON(nil)), Path: diffPath(path, "currency")})
+	}
+	switch {
+	case v.Limit.Set && o.Limit.Set:
+		if v.Limit.Value != o.Limit.Value {
+			d = append(d, Difference{
+				A:    string(json.AppendInt(nil, v.Limit.Value)),
+				B:    string(json.AppendInt(nil, o.Limit.Value)),
+				Path: diffPath(path, "limit"),
+			})
+		}
+	case v.Limit.Set:
+		d = append(d, Difference{A: string(json.AppendInt(nil, v.Limit.Value)), Path: diffPath(path, "limit")})
+	case o.Limit.Set:
+		d = append(d, Difference{B: string(json.AppendInt(nil, o.Limit.Value)), Path: diffPath(path, "limit")})
+	}
+	switch {
+	case v.MaxBlock.Set && o.MaxBlock.Set:
+		if v.MaxBlock.Value != o.MaxBlock.Value {
+			d = append(d, Difference{
+				A:    string(json.AppendInt(nil, v.MaxBlock.Value)),
+				B:    string(json.AppendInt(nil, o.MaxBlock.Value)),
+				Path: diffPath(path, "max_block"),
+			})
+		}
+	case v.MaxBlock.Set:
+		d = append(d, Difference{A: string(json.AppendInt(nil, v.MaxBlock.Value)), Path: diffPath(path, "max_block")})
+	case o.MaxBlock.Set:
+		d = append(d, Difference{B: string(json.AppendInt(nil, o.MaxBlock.Value)), Path: diffPath(path, "max_block")})
+	}
+	switch {
+	case v.Offset.Set && o.Offset.Set:
+		if v.Offset.Value != o.Offset.Value {
+			d = append(d, Difference{
+				A:    string(json.AppendInt(nil, v.Offset.Value)),
+				B:    string(json.AppendInt(nil, o.Offset.Value)),
+				Path: diffPath(path, "offset"),
+			})
+		}
+	case v.Offset.Set:
+		d = append(d, Difference{A: string(json.AppendInt(nil, v.Offset.Value)), Path: diffPath(path, "offset")})
+	case o.Offset.Set:
+		d = append(d, Difference{B: string(json.AppendInt(nil, o.Offset.Value)), Path: diffPath(path, "offset")})
+	}
+	switch {
+	case v.Operator.Set && o.Operator.Set:
+		if v.Operator.Value != o.Operator.Value {
+			d = append(d, Difference{
+				A:    string(json.AppendString(nil, string(v.Operator.Value))),
+				B:    string(json.AppendString(nil, string(o.Operator.Value))),
+				Path: diffPath(path, "operator"),
+			})
+		}
+	case v.Operator.Set:
+		d = append(d, Difference{A: string(json.AppendString(nil, string(v.Operator.Value))), Path: diffPath(path, "operator")})
+	case o.Operator.Set:
+		d = append(d, Difference{B: string(json.AppendString(nil, string(o.Operator.Value))), Path: diffPath(path, "operator")})
+	}
+	switch {
+	case v.Status.Set && o.Status.Set:
+		if v.Status.Value != o.Status.Value {
+			d = append(d, Difference{
+				A:    string(json.AppendString(nil, v.Status.Value)),
+				B:    string(json.AppendString(nil, o.Status.Value)),
+				Path: diffPath(path, "status"),
+			})
+		}
+	case v.Status.Set:
+		d = append(d, Difference{A: string(json.AppendString(nil, v.Status.Value)), Path: diffPath(path, "status")})
+	case o.Status.Set:
+		d = append(d, Difference{B: string(json.AppendString(nil, o.Status.Value)), Path: diffPath(path, "status")})
+	}
+	switch {
+	case v.Success.Set && o.Success.Set:
+		if v.Success.Value != o.Success.Value {
+			d = append(d, Difference{
+				A:    string(json.AppendBool(nil, v.Success.Value)),
+				B:    string(json.AppendBool(nil, o.Success.Value)),
+				Path: diffPath(path, "success"),
+			})
+		}
+	case v.Success.Set:
+		d = append(d, Difference{A: string(json.AppendBool(nil, v.Success.Value)), Path: diffPath(path, "success")})
+	case o.Success.Set:
+		d = append(d, Difference{B: string(json.AppendBool(nil, o.Success.Value)), Path: diffPath(path, "success")})
+	}
+	switch {
+	case v.TransactionIdentifier.Set && o.TransactionIdentifier.Set:
+		d = v.TransactionIdentifier.Value.appendDiffs(d, diffPath(path, "transaction_identifier"), o.TransactionIdentifier.Value)
+	case v.TransactionIdentifier.Set:
+		d = append(d, Difference{A: string(v.TransactionIdentifier.Value.EncodeJSON(nil)), Path: diffPath(path, "transaction_identifier")})
+	case o.TransactionIdentifier.Set:
+		d = append(d, Difference{B: string(o.TransactionIdentifier.Value.EncodeJSON(nil)), Path: diffPath(path, "transaction_identifier")})
+	}
+	switch {
+	case v.Type.Set && o.Type.Set:
+		if v.Type.Value != o.Type.Value {
+			d = append(d, Difference{
+				A:    string(json.AppendString(nil, v.Type.Value)),
+				B:    string(json.AppendString(nil, o.Type.Value)),
+				Path: diffPath(path, "type"),
+			})
+		}
+	case v.Type.Set:
+		d = append(d, Difference{A: string(json.AppendString(nil, v.Type.Value)), Path: diffPath(path, "type")})
+	case o.Type.Set:
+		d = append(d, Difference{B: string(json.AppendString(nil, o.Type.Value)), Path: diffPath(path, "type")})
+	}
+	return d
+}
+
 // EncodeJSON encodes SearchTransactionsRequest into JSON.
 func (v SearchTransactionsRequest) EncodeJSON(b []byte, network []byte) []byte {
 	b = append(b, network...)
@@ -2997,30 +9950,30 @@ func (v SearchTransactionsRequest) EncodeJSON(b []byte, network []byte) []byte {
 
 // Equal returns whether two SearchTransactionsRequest values are equal.
 func (v SearchTransactionsRequest) Equal(o SearchTransactionsRequest) bool {
-	return v.AccountIdentifier.Value.Equal(o.AccountIdentifier.Value) &&
-		v.AccountIdentifier.Set == o.AccountIdentifier.Set &&
-		v.Address.Value == o.Address.Value &&
+	return v.AccountIdentifier.Set == o.AccountIdentifier.Set &&
+		v.AccountIdentifier.Value.Equal(o.AccountIdentifier.Value) &&
 		v.Address.Set == o.Address.Set &&
-		v.CoinIdentifier.Value.Equal(o.CoinIdentifier.Value) &&
+		v.Address.Value == o.Address.Value &&
 		v.CoinIdentifier.Set == o.CoinIdentifier.Set &&
-		v.Currency.Value.Equal(o.Currency.Value) &&
+		v.CoinIdentifier.Value.Equal(o.CoinIdentifier.Value) &&
 		v.Currency.Set == o.Currency.Set &&
-		v.Limit.Value == o.Limit.Value &&
+		v.Currency.Value.Equal(o.Currency.Value) &&
 		v.Limit.Set == o.Limit.Set &&
-		v.MaxBlock.Value == o.MaxBlock.Value &&
+		v.Limit.Value == o.Limit.Value &&
 		v.MaxBlock.Set == o.MaxBlock.Set &&
-		v.Offset.Value == o.Offset.Value &&
+		v.MaxBlock.Value == o.MaxBlock.Value &&
 		v.Offset.Set == o.Offset.Set &&
-		v.Operator.Value == o.Operator.Value &&
+		v.Offset.Value == o.Offset.Value &&
 		v.Operator.Set == o.Operator.Set &&
-		v.Status.Value == o.Status.Value &&
+		v.Operator.Value == o.Operator.Value &&
 		v.Status.Set == o.Status.Set &&
-		v.Success.Value == o.Success.Value &&
+		v.Status.Value == o.Status.Value &&
 		v.Success.Set == o.Success.Set &&
-		v.TransactionIdentifier.Value.Equal(o.TransactionIdentifier.Value) &&
+		v.Success.Value == o.Success.Value &&
 		v.TransactionIdentifier.Set == o.TransactionIdentifier.Set &&
-		v.Type.Value == o.Type.Value &&
-		v.Type.Set == o.Type.Set
+		v.TransactionIdentifier.Value.Equal(o.TransactionIdentifier.Value) &&
+		v.Type.Set == o.Type.Set &&
+		v.Type.Value == o.Type.Value
 }
 
 // Reset resets SearchTransactionsRequest so that it can be reused.
@@ -3065,14 +10018,123 @@ type SearchTransactionsResponse struct {
 	TotalCount int64
 	// transactions is an array of BlockTransactions sorted by most recent
 	// BlockIdentifier (meaning that transactions in recent blocks appear
-	// first).
-	//
-	// If there are many transactions for a particular search, transactions may
-	// not contain all matching transactions. It is up to the caller to paginate
-	// these transactions using the max_block field.
+	// first). If there are many transactions for a particular search,
+	// transactions may not contain all matching transactions. It is up to the
+	// caller to paginate these transactions using the max_block field.
 	Transactions []BlockTransaction
 }
 
+// DecodeJSON decodes a SearchTransactionsResponse value from JSON.
+func (v *SearchTransactionsResponse) DecodeJSON(d *json.Decoder) error {
+	more, err := d.ObjectStart()
+	if err != nil {
+		return err
+	}
+	seen := uint64(0)
+	for more {
+		var key []byte
+		if key, err = d.Key(); err != nil {
+			return err
+		}
+		switch string(key) {
+		case "next_offset":
+			if d.Null() {
+				v.NextOffset.Set = false
+				err = d.Report(json.NullOptional)
+				break
+			}
+			v.NextOffset.Set = true
+			v.NextOffset.Value, err = d.Int64()
+		case "total_count":
+			v.TotalCount, err = d.Int64()
+			seen |= 1 << 0
+		case "transactions":
+			v.Transactions = v.Transactions[:0]
+			if d.Null() {
+				break
+			}
+			var next bool
+			if next, err = d.ArrayStart(); err != nil {
+				break
+			}
+			for next {
+				n := len(v.Transactions)
+				if n < cap(v.Transactions) {
+					v.Transactions = v.Transactions[:n+1]
+					v.Transactions[n].Reset()
+				} else {
+					v.Transactions = append(v.Transactions, BlockTransaction{})
+				}
+				if err = v.Transactions[n].DecodeJSON(d); err != nil {
+					break
+				}
+				if next, err = d.ArrayNext(); err != nil {
+					break
+				}
+			}
+			seen |= 1 << 1
+		default:
+			if err = d.Report(json.UnknownKey); err == nil {
+				err = d.Skip()
+			}
+		}
+		if err != nil {
+			return err
+		}
+		if more, err = d.ObjectNext(); err != nil {
+			return err
+		}
+	}
+	if seen&(1<<0) == 0 {
+		return d.Errorf("missing required field \"total_count\" in SearchTransactionsResponse")
+	}
+	if seen&(1<<1) == 0 {
+		return d.Errorf("missing required field \"transactions\" in SearchTransactionsResponse")
+	}
+	return nil
+}
+
+// Diff returns the differences between two SearchTransactionsResponse values.
+func (v SearchTransactionsResponse) Diff(o SearchTransactionsResponse) []Difference {
+	return v.appendDiffs(nil, "", o)
+}
+
+func (v SearchTransactionsResponse) appendDiffs(d []Difference, path string, o SearchTransactionsResponse) []Difference {
+	switch {
+	case v.NextOffset.Set && o.NextOffset.Set:
+		if v.NextOffset.Value != o.NextOffset.Value {
+			d = append(d, Difference{
+				A:    string(json.AppendInt(nil, v.NextOffset.Value)),
+				B:    string(json.AppendInt(nil, o.NextOffset.Value)),
+				Path: diffPath(path, "next_offset"),
+			})
+		}
+	case v.NextOffset.Set:
+		d = append(d, Difference{A: string(json.AppendInt(nil, v.NextOffset.Value)), Path: diffPath(path, "next_offset")})
+	case o.NextOffset.Set:
+		d = append(d, Difference{B: string(json.AppendInt(nil, o.NextOffset.Value)), Path: diffPath(path, "next_offset")})
+	}
+	if v.TotalCount != o.TotalCount {
+		d = append(d, Difference{
+			A:    string(json.AppendInt(nil, v.TotalCount)),
+			B:    string(json.AppendInt(nil, o.TotalCount)),
+			Path: diffPath(path, "total_count"),
+		})
+	}
+	for i := 0; i < len(v.Transactions) || i < len(o.Transactions); i++ {
+		p := diffIndex(diffPath(path, "transactions"), i)
+		switch {
+		case i >= len(o.Transactions):
+			d = append(d, Difference{A: string(v.Transactions[i].EncodeJSON(nil)), Path: p})
+		case i >= len(v.Transactions):
+			d = append(d, Difference{B: string(o.Transactions[i].EncodeJSON(nil)), Path: p})
+		default:
+			d = v.Transactions[i].appendDiffs(d, p, o.Transactions[i])
+		}
+	}
+	return d
+}
+
 // EncodeJSON encodes SearchTransactionsResponse into JSON.
 func (v SearchTransactionsResponse) EncodeJSON(b []byte) []byte {
 	b = append(b, "{"...)
@@ -3095,8 +10157,8 @@ func (v SearchTransactionsResponse) EncodeJSON(b []byte) []byte {
 
 // Equal returns whether two SearchTransactionsResponse values are equal.
 func (v SearchTransactionsResponse) Equal(o SearchTransactionsResponse) bool {
-	return v.NextOffset.Value == o.NextOffset.Value &&
-		v.NextOffset.Set == o.NextOffset.Set &&
+	return v.NextOffset.Set == o.NextOffset.Set &&
+		v.NextOffset.Value == o.NextOffset.Value &&
 		v.TotalCount == o.TotalCount &&
 		len(v.Transactions) == len(o.Transactions) &&
 		blockTransactionSliceEqual(v.Transactions, o.Transactions)
@@ -3112,10 +10174,8 @@ func (v *SearchTransactionsResponse) Reset() {
 
 // Signature contains the payload that was signed, the public keys of the
 // keypairs used to produce the signature, the signature (encoded in hex), and
-// the SignatureType.
-//
-// PublicKey is often times not known during construction of the signing
-// payloads but may be needed to combine signatures properly.
+// the SignatureType. PublicKey is often times not known during construction of
+// the signing payloads but may be needed to combine signatures properly.
 type Signature struct {
 	Bytes          []byte
 	PublicKey      PublicKey
@@ -3123,6 +10183,85 @@ type Signature struct {
 	SigningPayload SigningPayload
 }
 
+// DecodeJSON decodes a Signature value from JSON.
+func (v *Signature) DecodeJSON(d *json.Decoder) error {
+	more, err := d.ObjectStart()
+	if err != nil {
+		return err
+	}
+	seen := uint64(0)
+	for more {
+		var key []byte
+		if key, err = d.Key(); err != nil {
+			return err
+		}
+		switch string(key) {
+		case "hex_bytes":
+			v.Bytes, err = d.HexBytes(v.Bytes[:0])
+			seen |= 1 << 0
+		case "public_key":
+			err = v.PublicKey.DecodeJSON(d)
+			seen |= 1 << 1
+		case "signature_type":
+			var s []byte
+			s, err = d.String()
+			v.SignatureType = SignatureType(s)
+			seen |= 1 << 2
+		case "signing_payload":
+			err = v.SigningPayload.DecodeJSON(d)
+			seen |= 1 << 3
+		default:
+			if err = d.Report(json.UnknownKey); err == nil {
+				err = d.Skip()
+			}
+		}
+		if err != nil {
+			return err
+		}
+		if more, err = d.ObjectNext(); err != nil {
+			return err
+		}
+	}
+	if seen&(1<<0) == 0 {
+		return d.Errorf("missing required field \"hex_bytes\" in Signature")
+	}
+	if seen&(1<<1) == 0 {
+		return d.Errorf("missing required field \"public_key\" in Signature")
+	}
+	if seen&(1<<2) == 0 {
+		return d.Errorf("missing required field \"signature_type\" in Signature")
+	}
+	if seen&(1<<3) == 0 {
+		return d.Errorf("missing required field \"signing_payload\" in Signature")
+	}
+	return nil
+}
+
+// Diff returns the differences between two Signature values.
+func (v Signature) Diff(o Signature) []Difference {
+	return v.appendDiffs(nil, "", o)
+}
+
+func (v Signature) appendDiffs(d []Difference, path string, o Signature) []Difference {
+	if string(v.Bytes) != string(o.Bytes) {
+		d = append(d, Difference{
+			A:    string(json.AppendHexBytes(nil, v.Bytes)),
+			B:    string(json.AppendHexBytes(nil, o.Bytes)),
+			Path: diffPath(path, "hex_bytes"),
+		})
+	}
+	d = v.PublicKey.appendDiffs(d, diffPath(path, "public_key"), o.PublicKey)
+	if v.SignatureType != o.SignatureType {
+		d = append(d, Difference{
+			A:    string(json.AppendString(nil, string(v.SignatureType))),
+			B:    string(json.AppendString(nil, string(o.SignatureType))),
+			Path: diffPath(path, "signature_type"),
+		})
+	}
+	d = v.SigningPayload.appendDiffs(d, diffPath(path, "signing_payload"), o.SigningPayload)
+	return d
+}
+
 // EncodeJSON encodes Signature into JSON.
 func (v Signature) EncodeJSON(b []byte) []byte {
 	b = append(b, `{"hex_bytes":`...)
@@ -3152,13 +10291,17 @@ func (v *Signature) Reset() {
 	v.SigningPayload.Reset()
 }
 
-// SignatureType is the type of a cryptographic signature.
-//
-// * ecdsa: `r (32-bytes) || s (32-bytes)` - `64 bytes`
-// * ecdsa_recovery: `r (32-bytes) || s (32-bytes) || v (1-byte)` - `65 bytes`
-// * ed25519: `R (32-byte) || s (32-bytes)` - `64 bytes`
-// * schnorr_1: `r (32-bytes) || s (32-bytes)` - `64 bytes`  (schnorr signature implemented by Zilliqa where both `r` and `s` are scalars encoded as `32-bytes` values, most significant byte first.)
-// * schnorr_poseidon: `r (32-bytes) || s (32-bytes)` where s = Hash(1st pk || 2nd pk || r) - `64 bytes`  (schnorr signature w/ Poseidon hash function implemented by O(1) Labs where both `r` and `s` are scalars encoded as `32-bytes` values, least significant byte first. https://github.com/CodaProtocol/signer-reference/blob/master/schnorr.ml )
+// SignatureType is the type of a cryptographic signature. * ecdsa: `r
+// (32-bytes) || s (32-bytes)` - `64 bytes` * ecdsa_recovery: `r (32-bytes) || s
+// (32-bytes) || v (1-byte)` - `65 bytes` * ed25519: `R (32-byte) || s
+// (32-bytes)` - `64 bytes` * schnorr_1: `r (32-bytes) || s (32-bytes)` - `64
+// bytes` (schnorr signature implemented by Zilliqa where both `r` and `s` are
+// scalars encoded as `32-bytes` values, most significant byte first.) *
+// schnorr_poseidon: `r (32-bytes) || s (32-bytes)` where s = Hash(1st pk || 2nd
+// pk || r) - `64 bytes` (schnorr signature w/ Poseidon hash function
+// implemented by O(1) Labs where both `r` and `s` are scalars encoded as
+// `32-bytes` values, least significant byte first.
+// https://github.com/CodaProtocol/signer-reference/blob/master/schnorr.ml )
 type SignatureType string
 
 // Validate the SignatureType value.
@@ -3170,19 +10313,132 @@ func (v SignatureType) Validate() error {
 }
 
 // SigningPayload is signed by the client with the keypair associated with an
-// AccountIdentifier using the specified SignatureType.
-//
-// SignatureType can be optionally populated if there is a restriction on the
-// signature scheme that can be used to sign the payload.
+// AccountIdentifier using the specified SignatureType. SignatureType can be
+// optionally populated if there is a restriction on the signature scheme that
+// can be used to sign the payload.
 type SigningPayload struct {
 	AccountIdentifier OptionalAccountIdentifierType
-	// [DEPRECATED by `account_identifier` in `v1.4.4`] The network-specific
-	// address of the account that should sign the payload.
+	// [DEPRECATED by account_identifier in v1.4.4] Address in network-specific
+	// format.
 	Address       OptionalStringType
 	Bytes         []byte
 	SignatureType OptionalSignatureTypeType
 }
 
+// DecodeJSON decodes a SigningPayload value from JSON.
+func (v *SigningPayload) DecodeJSON(d *json.Decoder) error {
+	more, err := d.ObjectStart()
+	if err != nil {
+		return err
+	}
+	seen := uint64(0)
+	for more {
+		var key []byte
+		if key, err = d.Key(); err != nil {
+			return err
+		}
+		switch string(key) {
+		case "account_identifier":
+			if d.Null() {
+				v.AccountIdentifier.Set = false
+				err = d.Report(json.NullOptional)
+				break
+			}
+			v.AccountIdentifier.Set = true
+			err = v.AccountIdentifier.Value.DecodeJSON(d)
+		case "address":
+			if d.Null() {
+				v.Address.Set = false
+				err = d.Report(json.NullOptional)
+				break
+			}
+			v.Address.Set = true
+			var s []byte
+			s, err = d.String()
+			v.Address.Value = string(s)
+		case "hex_bytes":
+			v.Bytes, err = d.HexBytes(v.Bytes[:0])
+			seen |= 1 << 0
+		case "signature_type":
+			if d.Null() {
+				v.SignatureType.Set = false
+				err = d.Report(json.NullOptional)
+				break
+			}
+			v.SignatureType.Set = true
+			var s []byte
+			s, err = d.String()
+			v.SignatureType.Value = SignatureType(s)
+		default:
+			if err = d.Report(json.UnknownKey); err == nil {
+				err = d.Skip()
+			}
+		}
+		if err != nil {
+			return err
+		}
+		if more, err = d.ObjectNext(); err != nil {
+			return err
+		}
+	}
+	if seen&(1<<0) == 0 {
+		return d.Errorf("missing required field \"hex_bytes\" in SigningPayload")
+	}
+	return nil
+}
+
+// Diff returns the differences between two SigningPayload values.
+func (v SigningPayload) Diff(o SigningPayload) []Difference {
+	return v.appendDiffs(nil, "", o)
+}
+
+func (v SigningPayload) appendDiffs(d []Difference, path string, o SigningPayload) []Difference {
+	switch {
+	case v.AccountIdentifier.Set && o.AccountIdentifier.Set:
+		d = v.AccountIdentifier.Value.appendDiffs(d, diffPath(path, "account_identifier"), o.AccountIdentifier.Value)
+	case v.AccountIdentifier.Set:
+		d = append(d, Difference{A: string(v.AccountIdentifier.Value.EncodeJSON(nil)), Path: diffPath(path, "account_identifier")})
+	case o.AccountIdentifier.Set:
+		d = append(d, Difference{B: string(o.AccountIdentifier.Value.EncodeJSON(nil)), Path: diffPath(path, "account_identifier")})
+	}
+	switch {
+	case v.Address.Set && o.Address.Set:
+		if v.Address.Value != o.Address.Value {
+			d = append(d, Difference{
+				A:    string(json.AppendString(nil, v.Address.Value)),
+				B:    string(json.AppendString(nil, o.Address.Value)),
+				Path: diffPath(path, "address"),
+			})
+		}
+	case v.Address.Set:
+		d = append(d, Difference{A: string(json.AppendString(nil, v.Address.Value)), Path: diffPath(path, "address")})
+	case o.Address.Set:
+		d = append(d, Difference{B: string(json.AppendString(nil, o.Address.Value)), Path: diffPath(path, "address")})
+	}
+	if string(v.Bytes) != string(o.Bytes) {
+		d = append(d, Difference{
+			A:    string(json.AppendHexBytes(nil, v.Bytes)),
+			B:    string(json.AppendHexBytes(nil, o.Bytes)),
+			Path: diffPath(path, "hex_bytes"),
+		})
+	}
+	switch {
+	case v.SignatureType.Set && o.SignatureType.Set:
+		if v.SignatureType.Value != o.SignatureType.Value {
+			d = append(d, Difference{
+				A:    string(json.AppendString(nil, string(v.SignatureType.Value))),
+				B:    string(json.AppendString(nil, string(o.SignatureType.Value))),
+				Path: diffPath(path, "signature_type"),
+			})
+		}
+	case v.SignatureType.Set:
+		d = append(d, Difference{A: string(json.AppendString(nil, string(v.SignatureType.Value))), Path: diffPath(path, "signature_type")})
+	case o.SignatureType.Set:
+		d = append(d, Difference{B: string(json.AppendString(nil, string(o.SignatureType.Value))), Path: diffPath(path, "signature_type")})
+	}
+	return d
+}
+
 // EncodeJSON encodes SigningPayload into JSON.
 func (v SigningPayload) EncodeJSON(b []byte) []byte {
 	b = append(b, "{"...)
@@ -3210,13 +10466,13 @@ func (v SigningPayload) EncodeJSON(b []byte) []byte {
 
 // Equal returns whether two SigningPayload values are equal.
 func (v SigningPayload) Equal(o SigningPayload) bool {
-	return v.AccountIdentifier.Value.Equal(o.AccountIdentifier.Value) &&
-		v.AccountIdentifier.Set == o.AccountIdentifier.Set &&
-		v.Address.Value == o.Address.Value &&
+	return v.AccountIdentifier.Set == o.AccountIdentifier.Set &&
+		v.AccountIdentifier.Value.Equal(o.AccountIdentifier.Value) &&
 		v.Address.Set == o.Address.Set &&
+		v.Address.Value == o.Address.Value &&
 		string(v.Bytes) == string(o.Bytes) &&
-		v.SignatureType.Value == o.SignatureType.Value &&
-		v.SignatureType.Set == o.SignatureType.Set
+		v.SignatureType.Set == o.SignatureType.Set &&
+		v.SignatureType.Value == o.SignatureType.Value
 }
 
 // Reset resets SigningPayload so that it can be reused.
@@ -3240,13 +10496,78 @@ type SubAccountIdentifier struct {
 	// identifier (ex: bonded) that uniquely specifies a SubAccount.
 	Address string
 	// If the SubAccount address is not sufficient to uniquely specify a
-	// SubAccount, any other identifying information can be stored here.
-	//
-	// It is important to note that two SubAccounts with identical addresses but
+	// SubAccount, any other identifying information can be stored here. It is
+	// important to note that two SubAccounts with identical addresses but
 	// differing metadata will not be considered equal by clients.
 	Metadata MapObject
 }
 
+// DecodeJSON decodes a SubAccountIdentifier value from JSON.
+func (v *SubAccountIdentifier) DecodeJSON(d *json.Decoder) error {
+	more, err := d.ObjectStart()
+	if err != nil {
+		return err
+	}
+	seen := uint64(0)
+	for more {
+		var key []byte
+		if key, err = d.Key(); err != nil {
+			return err
+		}
+		switch string(key) {
+		case "address":
+			var s []byte
+			s, err = d.String()
+			v.Address = string(s)
+			seen |= 1 << 0
+		case "metadata":
+			if d.Null() {
+				v.Metadata = v.Metadata[:0]
+				err = d.Report(json.NullOptional)
+				break
+			}
+			v.Metadata, err = decodeMapObject(d, v.Metadata)
+		default:
+			if err = d.Report(json.UnknownKey); err == nil {
+				err = d.Skip()
+			}
+		}
+		if err != nil {
+			return err
+		}
+		if more, err = d.ObjectNext(); err != nil {
+			return err
+		}
+	}
+	if seen&(1<<0) == 0 {
+		return d.Errorf("missing required field \"address\" in SubAccountIdentifier")
+	}
+	return nil
+}
+
+// Diff returns the differences between two SubAccountIdentifier values.
+func (v SubAccountIdentifier) Diff(o SubAccountIdentifier) []Difference {
+	return v.appendDiffs(nil, "", o)
+}
+
+func (v SubAccountIdentifier) appendDiffs(d []Difference, path string, o SubAccountIdentifier) []Difference {
+	if v.Address != o.Address {
+		d = append(d, Difference{
+			A:    string(json.AppendString(nil, v.Address)),
+			B:    string(json.AppendString(nil, o.Address)),
+			Path: diffPath(path, "address"),
+		})
+	}
+	if string(v.Metadata) != string(o.Metadata) {
+		d = append(d, Difference{
+			A:    string(appendMapObject(nil, v.Metadata)),
+			B:    string(appendMapObject(nil, o.Metadata)),
+			Path: diffPath(path, "metadata"),
+		})
+	}
+	return d
+}
+
 // EncodeJSON encodes SubAccountIdentifier into JSON.
 func (v SubAccountIdentifier) EncodeJSON(b []byte) []byte {
 	b = append(b, `{"address":`...)
@@ -3283,6 +10604,72 @@ type SubNetworkIdentifier struct {
 	Network  string
 }
 
+// DecodeJSON decodes a SubNetworkIdentifier value from JSON.
+func (v *SubNetworkIdentifier) DecodeJSON(d *json.Decoder) error {
+	more, err := d.ObjectStart()
+	if err != nil {
+		return err
+	}
+	seen := uint64(0)
+	for more {
+		var key []byte
+		if key, err = d.Key(); err != nil {
+			return err
+		}
+		switch string(key) {
+		case "metadata":
+			if d.Null() {
+				v.Metadata = v.Metadata[:0]
+				err = d.Report(json.NullOptional)
+				break
+			}
+			v.Metadata, err = decodeMapObject(d, v.Metadata)
+		case "network":
+			var s []byte
+			s, err = d.String()
+			v.Network = string(s)
+			seen |= 1 << 0
+		default:
+			if err = d.Report(json.UnknownKey); err == nil {
+				err = d.Skip()
+			}
+		}
+		if err != nil {
+			return err
+		}
+		if more, err = d.ObjectNext(); err != nil {
+			return err
+		}
+	}
+	if seen&(1<<0) == 0 {
+		return d.Errorf("missing required field \"network\" in SubNetworkIdentifier")
+	}
+	return nil
+}
+
+// Diff returns the differences between two SubNetworkIdentifier values.
+func (v SubNetworkIdentifier) Diff(o SubNetworkIdentifier) []Difference {
+	return v.appendDiffs(nil, "", o)
+}
+
+func (v SubNetworkIdentifier) appendDiffs(d []Difference, path string, o SubNetworkIdentifier) []Difference {
+	if string(v.Metadata) != string(o.Metadata) {
+		d = append(d, Difference{
+			A:    string(appendMapObject(nil, v.Metadata)),
+			B:    string(appendMapObject(nil, o.Metadata)),
+			Path: diffPath(path, "metadata"),
+		})
+	}
+	if v.Network != o.Network {
+		d = append(d, Difference{
+			A:    string(json.AppendString(nil, v.Network)),
+			B:    string(json.AppendString(nil, o.Network)),
+			Path: diffPath(path, "network"),
+		})
+	}
+	return d
+}
+
 // EncodeJSON encodes SubNetworkIdentifier into JSON.
 func (v SubNetworkIdentifier) EncodeJSON(b []byte) []byte {
 	b = append(b, "{"...)
@@ -3309,15 +10696,12 @@ func (v *SubNetworkIdentifier) Reset() {
 }
 
 // SyncStatus is used to provide additional context about an implementation's
-// sync status.
-//
-// This object is often used by implementations to indicate healthiness when
-// block data cannot be queried until some sync phase completes or cannot be
-// determined by comparing the timestamp of the most recent block with the
-// current time.
+// sync status. This object is often used by implementations to indicate
+// healthiness when block data cannot be queried until some sync phase completes
+// or cannot be determined by comparing the timestamp of the most recent block
+// with the current time.
 type SyncStatus struct {
 	// CurrentIndex is the index of the last synced block in the current stage.
-	//
 	// This is a separate field from current_block_identifier in
 	// NetworkStatusResponse because blocks with indices up to and including the
 	// current_index may not yet be queryable by the caller. To reiterate, all
@@ -3330,18 +10714,142 @@ type SyncStatus struct {
 	// sycned is a boolean that indicates if an implementation has synced up to
 	// the most recent block. If this field is not populated, the caller should
 	// rely on a traditional tip timestamp comparison to determine if an
-	// implementation is synced.
-	//
-	// This field is particularly useful for quiescent blockchains (blocks only
-	// produced when there are pending transactions). In these blockchains, the
-	// most recent block could have a timestamp far behind the current time but
-	// the node could be healthy and at tip.
+	// implementation is synced. This field is particularly useful for quiescent
+	// blockchains (blocks only produced when there are pending transactions).
+	// In these blockchains, the most recent block could have a timestamp far
+	// behind the current time but the node could be healthy and at tip.
 	Synced OptionalBoolType
 	// TargetIndex is the index of the block that the implementation is
 	// attempting to sync to in the current stage.
 	TargetIndex OptionalInt64Type
 }
 
+// DecodeJSON decodes a SyncStatus value from JSON.
+func (v *SyncStatus) DecodeJSON(d *json.Decoder) error {
+	more, err := d.ObjectStart()
+	if err != nil {
+		return err
+	}
+	for more {
+		var key []byte
+		if key, err = d.Key(); err != nil {
+			return err
+		}
+		switch string(key) {
+		case "current_index":
+			if d.Null() {
+				v.CurrentIndex.Set = false
+				err = d.Report(json.NullOptional)
+				break
+			}
+			v.CurrentIndex.Set = true
+			v.CurrentIndex.Value, err = d.Int64()
+		case "stage":
+			if d.Null() {
+				v.Stage.Set = false
+				err = d.Report(json.NullOptional)
+				break
+			}
+			v.Stage.Set = true
+			var s []byte
+			s, err = d.String()
+			v.Stage.Value = string(s)
+		case "synced":
+			if d.Null() {
+				v.Synced.Set = false
+				err = d.Report(json.NullOptional)
+				break
+			}
+			v.Synced.Set = true
+			v.Synced.Value, err = d.Bool()
+		case "target_index":
+			if d.Null() {
+				v.TargetIndex.Set = false
+				err = d.Report(json.NullOptional)
+				break
+			}
+			v.TargetIndex.Set = true
+			v.TargetIndex.Value, err = d.Int64()
+		default:
+			if err = d.Report(json.UnknownKey); err == nil {
+				err = d.Skip()
+			}
+		}
+		if err != nil {
+			return err
+		}
+		if more, err = d.ObjectNext(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Diff returns the differences between two SyncStatus values.
+func (v SyncStatus) Diff(o SyncStatus) []Difference {
+	return v.appendDiffs(nil, "", o)
+}
+
+func (v SyncStatus) appendDiffs(d []Difference, path string, o SyncStatus) []Difference {
+	switch {
+	case v.CurrentIndex.Set && o.CurrentIndex.Set:
+		if v.CurrentIndex.Value != o.CurrentIndex.Value {
+			d = append(d, Difference{
+				A:    string(json.AppendInt(nil, v.CurrentIndex.Value)),
+				B:    string(json.AppendInt(nil, o.CurrentIndex.Value)),
+				Path: diffPath(path, "current_index"),
+			})
+		}
+	case v.CurrentIndex.Set:
+		d = append(d, Difference{A: string(json.AppendInt(nil, v.CurrentIndex.Value)), Path: diffPath(path, "current_index")})
+	case o.CurrentIndex.Set:
+		d = append(d, Difference{B: string(json.AppendInt(nil, o.CurrentIndex.Value)), Path: diffPath(path, "current_index")})
+	}
+	switch {
+	case v.Stage.Set && o.Stage.Set:
+		if v.Stage.Value != o.Stage.Value {
+			d = append(d, Difference{
+				A:    string(json.AppendString(nil, v.Stage.Value)),
+				B:    string(json.AppendString(nil, o.Stage.Value)),
+				Path: diffPath(path, "stage"),
+			})
+		}
+	case v.Stage.Set:
+		d = append(d, Difference{A: string(json.AppendString(nil, v.Stage.Value)), Path: diffPath(path, "stage")})
+	case o.Stage.Set:
+		d = append(d, Difference{B: string(json.AppendString(nil, o.Stage.Value)), Path: diffPath(path, "stage")})
+	}
+	switch {
+	case v.Synced.Set && o.Synced.Set:
+		if v.Synced.Value != o.Synced.Value {
+			d = append(d, Difference{
+				A:    string(json.AppendBool(nil, v.Synced.Value)),
+				B:    string(json.AppendBool(nil, o.Synced.Value)),
+				Path: diffPath(path, "synced"),
+			})
+		}
+	case v.Synced.Set:
+		d = append(d, Difference{A: string(json.AppendBool(nil, v.Synced.Value)), Path: diffPath(path, "synced")})
+	case o.Synced.Set:
+		d = append(d, Difference{B: string(json.AppendBool(nil, o.Synced.Value)), Path: diffPath(path, "synced")})
+	}
+	switch {
+	case v.TargetIndex.Set && o.TargetIndex.Set:
+		if v.TargetIndex.Value != o.TargetIndex.Value {
+			d = append(d, Difference{
+				A:    string(json.AppendInt(nil, v.TargetIndex.Value)),
+				B:    string(json.AppendInt(nil, o.TargetIndex.Value)),
+				Path: diffPath(path, "target_index"),
+			})
+		}
+	case v.TargetIndex.Set:
+		d = append(d, Difference{A: string(json.AppendInt(nil, v.TargetIndex.Value)), Path: diffPath(path, "target_index")})
+	case o.TargetIndex.Set:
+		d = append(d, Difference{B: string(json.AppendInt(nil, o.TargetIndex.Value)), Path: diffPath(path, "target_index")})
+	}
+	return d
+}
+
 // EncodeJSON encodes SyncStatus into JSON.
 func (v SyncStatus) EncodeJSON(b []byte) []byte {
 	b = append(b, "{"...)
@@ -3365,20 +10873,23 @@ func (v SyncStatus) EncodeJSON(b []byte) []byte {
 		b = json.AppendInt(b, v.TargetIndex.Value)
 		b = append(b, ","...)
 	}
+	if b[len(b)-1] == '{' {
+		return append(b, "}"...)
+	}
 	b[len(b)-1] = '}'
 	return b
 }
 
 // Equal returns whether two SyncStatus values are equal.
 func (v SyncStatus) Equal(o SyncStatus) bool {
-	return v.CurrentIndex.Value == o.CurrentIndex.Value &&
-		v.CurrentIndex.Set == o.CurrentIndex.Set &&
-		v.Stage.Value == o.Stage.Value &&
+	return v.CurrentIndex.Set == o.CurrentIndex.Set &&
+		v.CurrentIndex.Value == o.CurrentIndex.Value &&
 		v.Stage.Set == o.Stage.Set &&
-		v.Synced.Value == o.Synced.Value &&
+		v.Stage.Value == o.Stage.Value &&
 		v.Synced.Set == o.Synced.Set &&
-		v.TargetIndex.Value == o.TargetIndex.Value &&
-		v.TargetIndex.Set == o.TargetIndex.Set
+		v.Synced.Value == o.Synced.Value &&
+		v.TargetIndex.Set == o.TargetIndex.Set &&
+		v.TargetIndex.Value == o.TargetIndex.Value
 }
 
 // Reset resets SyncStatus so that it can be reused.
@@ -3395,9 +10906,7 @@ func (v *SyncStatus) Reset() {
 
 // Timestamp type.
 //
-// The timestamp of the block in milliseconds since the Unix Epoch. The
-// timestamp is stored in milliseconds because some blockchains produce blocks
-// more often than once a second.
+// The timestamp of the block in milliseconds since the Unix Epoch.
 type Timestamp int64
 
 // Validate the Timestamp value.
@@ -3422,6 +10931,143 @@ type Transaction struct {
 	TransactionIdentifier TransactionIdentifier
 }
 
+// DecodeJSON decodes a Transaction value from JSON.
+func (v *Transaction) DecodeJSON(d *json.Decoder) error {
+	more, err := d.ObjectStart()
+	if err != nil {
+		return err
+	}
+	seen := uint64(0)
+	for more {
+		var key []byte
+		if key, err = d.Key(); err != nil {
+			return err
+		}
+		switch string(key) {
+		case "metadata":
+			if d.Null() {
+				v.Metadata = v.Metadata[:0]
+				err = d.Report(json.NullOptional)
+				break
+			}
+			v.Metadata, err = decodeMapObject(d, v.Metadata)
+		case "operations":
+			v.Operations = v.Operations[:0]
+			if d.Null() {
+				break
+			}
+			var next bool
+			if next, err = d.ArrayStart(); err != nil {
+				break
+			}
+			for next {
+				n := len(v.Operations)
+				if n < cap(v.Operations) {
+					v.Operations = v.Operations[:n+1]
+					v.Operations[n].Reset()
+				} else {
+					v.Operations = append(v.Operations, Operation{})
+				}
+				if err = v.Operations[n].DecodeJSON(d); err != nil {
+					break
+				}
+				if next, err = d.ArrayNext(); err != nil {
+					break
+				}
+			}
+			seen |= 1 << 0
+		case "related_transactions":
+			if d.Null() {
+				v.RelatedTransactions = v.RelatedTransactions[:0]
+				err = d.Report(json.NullOptional)
+				break
+			}
+			v.RelatedTransactions = v.RelatedTransactions[:0]
+			if d.Null() {
+				break
+			}
+			var next bool
+			if next, err = d.ArrayStart(); err != nil {
+				break
+			}
+			for next {
+				n := len(v.RelatedTransactions)
+				if n < cap(v.RelatedTransactions) {
+					v.RelatedTransactions = v.RelatedTransactions[:n+1]
+					v.RelatedTransactions[n].Reset()
+				} else {
+					v.RelatedTransactions = append(v.RelatedTransactions, RelatedTransaction{})
+				}
+				if err = v.RelatedTransactions[n].DecodeJSON(d); err != nil {
+					break
+				}
+				if next, err = d.ArrayNext(); err != nil {
+					break
+				}
+			}
+		case "transaction_identifier":
+			err = v.TransactionIdentifier.DecodeJSON(d)
+			seen |= 1 << 1
+		default:
+			if err = d.Report(json.UnknownKey); err == nil {
+				err = d.Skip()
+			}
+		}
+		if err != nil {
+			return err
+		}
+		if more, err = d.ObjectNext(); err != nil {
+			return err
+		}
+	}
+	if seen&(1<<0) == 0 {
+		return d.Errorf("missing required field \"operations\" in Transaction")
+	}
+	if seen&(1<<1) == 0 {
+		return d.Errorf("missing required field \"transaction_identifier\" in Transaction")
+	}
+	return nil
+}
+
+// Diff returns the differences between two Transaction values.
+func (v Transaction) Diff(o Transaction) []Difference {
+	return v.appendDiffs(nil, "", o)
+}
+
+func (v Transaction) appendDiffs(d []Difference, path string, o Transaction) []Difference {
+	if string(v.Metadata) != string(o.Metadata) {
+		d = append(d, Difference{
+			A:    string(appendMapObject(nil, v.Metadata)),
+			B:    string(appendMapObject(nil, o.Metadata)),
+			Path: diffPath(path, "metadata"),
+		})
+	}
+	for i := 0; i < len(v.Operations) || i < len(o.Operations); i++ {
+		p := diffIndex(diffPath(path, "operations"), i)
+		switch {
+		case i >= len(o.Operations):
+			d = append(d, Difference{A: string(v.Operations[i].EncodeJSON(nil)), Path: p})
+		case i >= len(v.Operations):
+			d = append(d, Difference{B: string(o.Operations[i].EncodeJSON(nil)), Path: p})
+		default:
+			d = v.Operations[i].appendDiffs(d, p, o.Operations[i])
+		}
+	}
+	for i := 0; i < len(v.RelatedTransactions) || i < len(o.RelatedTransactions); i++ {
+		p := diffIndex(diffPath(path, "related_transactions"), i)
+		switch {
+		case i >= len(o.RelatedTransactions):
+			d = append(d, Difference{A: string(v.RelatedTransactions[i].EncodeJSON(nil)), Path: p})
+		case i >= len(v.RelatedTransactions):
+			d = append(d, Difference{B: string(o.RelatedTransactions[i].EncodeJSON(nil)), Path: p})
+		default:
+			d = v.RelatedTransactions[i].appendDiffs(d, p, o.RelatedTransactions[i])
+		}
+	}
+	d = v.TransactionIdentifier.appendDiffs(d, diffPath(path, "transaction_identifier"), o.TransactionIdentifier)
+	return d
+}
+
 // EncodeJSON encodes Transaction into JSON.
 func (v Transaction) EncodeJSON(b []byte) []byte {
 	b = append(b, "{"...)
@@ -3481,6 +11127,58 @@ type TransactionIdentifier struct {
 	Hash string
 }
 
+// DecodeJSON decodes a TransactionIdentifier value from JSON.
+func (v *TransactionIdentifier) DecodeJSON(d *json.Decoder) error {
+	more, err := d.ObjectStart()
+	if err != nil {
+		return err
+	}
+	seen := uint64(0)
+	for more {
+		var key []byte
+		if key, err = d.Key(); err != nil {
+			return err
+		}
+		switch string(key) {
+		case "hash":
+			var s []byte
+			s, err = d.String()
+			v.Hash = string(s)
+			seen |= 1 << 0
+		default:
+			if err = d.Report(json.UnknownKey); err == nil {
+				err = d.Skip()
+			}
+		}
+		if err != nil {
+			return err
+		}
+		if more, err = d.ObjectNext(); err != nil {
+			return err
+		}
+	}
+	if seen&(1<<0) == 0 {
+		return d.Errorf("missing required field \"hash\" in TransactionIdentifier")
+	}
+	return nil
+}
+
+// Diff returns the differences between two TransactionIdentifier values.
+func (v TransactionIdentifier) Diff(o TransactionIdentifier) []Difference {
+	return v.appendDiffs(nil, "", o)
+}
+
+func (v TransactionIdentifier) appendDiffs(d []Difference, path string, o TransactionIdentifier) []Difference {
+	if v.Hash != o.Hash {
+		d = append(d, Difference{
+			A:    string(json.AppendString(nil, v.Hash)),
+			B:    string(json.AppendString(nil, o.Hash)),
+			Path: diffPath(path, "hash"),
+		})
+	}
+	return d
+}
+
 // EncodeJSON encodes TransactionIdentifier into JSON.
 func (v TransactionIdentifier) EncodeJSON(b []byte) []byte {
 	b = append(b, `{"hash":`...)
@@ -3506,6 +11204,64 @@ type TransactionIdentifierResponse struct {
 	TransactionIdentifier TransactionIdentifier
 }
 
+// DecodeJSON decodes a TransactionIdentifierResponse value from JSON.
+func (v *TransactionIdentifierResponse) DecodeJSON(d *json.Decoder) error {
+	more, err := d.ObjectStart()
+	if err != nil {
+		return err
+	}
+	seen := uint64(0)
+	for more {
+		var key []byte
+		if key, err = d.Key(); err != nil {
+			return err
+		}
+		switch string(key) {
+		case "metadata":
+			if d.Null() {
+				v.Metadata = v.Metadata[:0]
+				err = d.Report(json.NullOptional)
+				break
+			}
+			v.Metadata, err = decodeMapObject(d, v.Metadata)
+		case "transaction_identifier":
+			err = v.TransactionIdentifier.DecodeJSON(d)
+			seen |= 1 << 0
+		default:
+			if err = d.Report(json.UnknownKey); err == nil {
+				err = d.Skip()
+			}
+		}
+		if err != nil {
+			return err
+		}
+		if more, err = d.ObjectNext(); err != nil {
+			return err
+		}
+	}
+	if seen&(1<<0) == 0 {
+		return d.Errorf("missing required field \"transaction_identifier\" in TransactionIdentifierResponse")
+	}
+	return nil
+}
+
+// Diff returns the differences between two TransactionIdentifierResponse values.
+func (v TransactionIdentifierResponse) Diff(o TransactionIdentifierResponse) []Difference {
+	return v.appendDiffs(nil, "", o)
+}
+
+func (v TransactionIdentifierResponse) appendDiffs(d []Difference, path string, o TransactionIdentifierResponse) []Difference {
+	if string(v.Metadata) != string(o.Metadata) {
+		d = append(d, Difference{
+			A:    string(appendMapObject(nil, v.Metadata)),
+			B:    string(appendMapObject(nil, o.Metadata)),
+			Path: diffPath(path, "metadata"),
+		})
+	}
+	d = v.TransactionIdentifier.appendDiffs(d, diffPath(path, "transaction_identifier"), o.TransactionIdentifier)
+	return d
+}
+
 // EncodeJSON encodes TransactionIdentifierResponse into JSON.
 func (v TransactionIdentifierResponse) EncodeJSON(b []byte) []byte {
 	b = append(b, "{"...)
@@ -3551,6 +11307,111 @@ type Version struct {
 	RosettaVersion string
 }
 
+// DecodeJSON decodes a Version value from JSON.
+func (v *Version) DecodeJSON(d *json.Decoder) error {
+	more, err := d.ObjectStart()
+	if err != nil {
+		return err
+	}
+	seen := uint64(0)
+	for more {
+		var key []byte
+		if key, err = d.Key(); err != nil {
+			return err
+		}
+		switch string(key) {
+		case "metadata":
+			if d.Null() {
+				v.Metadata = v.Metadata[:0]
+				err = d.Report(json.NullOptional)
+				break
+			}
+			v.Metadata, err = decodeMapObject(d, v.Metadata)
+		case "middleware_version":
+			if d.Null() {
+				v.MiddlewareVersion.Set = false
+				err = d.Report(json.NullOptional)
+				break
+			}
+			v.MiddlewareVersion.Set = true
+			var s []byte
+			s, err = d.String()
+			v.MiddlewareVersion.Value = string(s)
+		case "node_version":
+			var s []byte
+			s, err = d.String()
+			v.NodeVersion = string(s)
+			seen |= 1 << 0
+		case "rosetta_version":
+			var s []byte
+			s, err = d.String()
+			v.RosettaVersion = string(s)
+			seen |= 1 << 1
+		default:
+			if err = d.Report(json.UnknownKey); err == nil {
+				err = d.Skip()
+			}
+		}
+		if err != nil {
+			return err
+		}
+		if more, err = d.ObjectNext(); err != nil {
+			return err
+		}
+	}
+	if seen&(1<<0) == 0 {
+		return d.Errorf("missing required field \"node_version\" in Version")
+	}
+	if seen&(1<<1) == 0 {
+		return d.Errorf("missing required field \"rosetta_version\" in Version")
+	}
+	return nil
+}
+
+// Diff returns the differences between two Version values.
+func (v Version) Diff(o Version) []Difference {
+	return v.appendDiffs(nil, "", o)
+}
+
+func (v Version) appendDiffs(d []Difference, path string, o Version) []Difference {
+	if string(v.Metadata) != string(o.Metadata) {
+		d = append(d, Difference{
+			A:    string(appendMapObject(nil, v.Metadata)),
+			B:    string(appendMapObject(nil, o.Metadata)),
+			Path: diffPath(path, "metadata"),
+		})
+	}
+	switch {
+	case v.MiddlewareVersion.Set && o.MiddlewareVersion.Set:
+		if v.MiddlewareVersion.Value != o.MiddlewareVersion.Value {
+			d = append(d, Difference{
+				A:    string(json.AppendString(nil, v.MiddlewareVersion.Value)),
+				B:    string(json.AppendString(nil, o.MiddlewareVersion.Value)),
+				Path: diffPath(path, "middleware_version"),
+			})
+		}
+	case v.MiddlewareVersion.Set:
+		d = append(d, Difference{A: string(json.AppendString(nil, v.MiddlewareVersion.Value)), Path: diffPath(path, "middleware_version")})
+	case o.MiddlewareVersion.Set:
+		d = append(d, Difference{B: string(json.AppendString(nil, o.MiddlewareVersion.Value)), Path: diffPath(path, "middleware_version")})
+	}
+	if v.NodeVersion != o.NodeVersion {
+		d = append(d, Difference{
+			A:    string(json.AppendString(nil, v.NodeVersion)),
+			B:    string(json.AppendString(nil, o.NodeVersion)),
+			Path: diffPath(path, "node_version"),
+		})
+	}
+	if v.RosettaVersion != o.RosettaVersion {
+		d = append(d, Difference{
+			A:    string(json.AppendString(nil, v.RosettaVersion)),
+			B:    string(json.AppendString(nil, o.RosettaVersion)),
+			Path: diffPath(path, "rosetta_version"),
+		})
+	}
+	return d
+}
+
 // EncodeJSON encodes Version into JSON.
 func (v Version) EncodeJSON(b []byte) []byte {
 	b = append(b, "{"...)
@@ -3574,8 +11435,8 @@ func (v Version) EncodeJSON(b []byte) []byte {
 // Equal returns whether two Version values are equal.
 func (v Version) Equal(o Version) bool {
 	return string(v.Metadata) == string(o.Metadata) &&
-		v.MiddlewareVersion.Value == o.MiddlewareVersion.Value &&
 		v.MiddlewareVersion.Set == o.MiddlewareVersion.Set &&
+		v.MiddlewareVersion.Value == o.MiddlewareVersion.Value &&
 		v.NodeVersion == o.NodeVersion &&
 		v.RosettaVersion == o.RosettaVersion
 }
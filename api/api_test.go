@@ -0,0 +1,1485 @@
+// DO NOT EDIT.
+// Generated by running: go run cmd/genapi/genapi.go
+
+// Copyright 2021 Coinbase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"testing"
+
+	"github.com/coinbase/rosetta-sdk-go/types"
+	"github.com/tav/validate-rosetta/json"
+)
+
+// deprecatedKeys specifies the JSON keys of deprecated fields, which are
+// derived from their replacements when encoded by rosetta-sdk-go.
+var deprecatedKeys = map[string]bool{
+	"address": true,
+	"signers": true,
+}
+
+// AccountBalanceRequest returns a random AccountBalanceRequest value.
+func (r *testRand) AccountBalanceRequest() AccountBalanceRequest {
+	v := AccountBalanceRequest{}
+	v.AccountIdentifier = r.AccountIdentifier()
+	if r.optional() {
+		v.BlockIdentifier = OptionalPartialBlockIdentifier(r.PartialBlockIdentifier())
+	}
+	for i := r.length(); i > 0; i-- {
+		v.Currencies = append(v.Currencies, r.Currency())
+	}
+	return v
+}
+
+// AccountBalanceResponse returns a random AccountBalanceResponse value.
+func (r *testRand) AccountBalanceResponse() AccountBalanceResponse {
+	v := AccountBalanceResponse{}
+	for i := r.length(); i > 0; i-- {
+		v.Balances = append(v.Balances, r.Amount())
+	}
+	v.BlockIdentifier = r.BlockIdentifier()
+	v.Metadata = r.mapObject()
+	return v
+}
+
+// AccountCoinsRequest returns a random AccountCoinsRequest value.
+func (r *testRand) AccountCoinsRequest() AccountCoinsRequest {
+	v := AccountCoinsRequest{}
+	v.AccountIdentifier = r.AccountIdentifier()
+	for i := r.length(); i > 0; i-- {
+		v.Currencies = append(v.Currencies, r.Currency())
+	}
+	v.IncludeMempool = r.bool()
+	return v
+}
+
+// AccountCoinsResponse returns a random AccountCoinsResponse value.
+func (r *testRand) AccountCoinsResponse() AccountCoinsResponse {
+	v := AccountCoinsResponse{}
+	v.BlockIdentifier = r.BlockIdentifier()
+	for i := r.length(); i > 0; i-- {
+		v.Coins = append(v.Coins, r.Coin())
+	}
+	v.Metadata = r.mapObject()
+	return v
+}
+
+// AccountIdentifier returns a random AccountIdentifier value.
+func (r *testRand) AccountIdentifier() AccountIdentifier {
+	v := AccountIdentifier{}
+	v.Address = r.string()
+	v.Metadata = r.mapObject()
+	if r.optional() {
+		v.SubAccount = OptionalSubAccountIdentifier(r.SubAccountIdentifier())
+	}
+	return v
+}
+
+// Allow returns a random Allow value.
+func (r *testRand) Allow() Allow {
+	v := Allow{}
+	for i := r.length(); i > 0; i-- {
+		v.BalanceExemptions = append(v.BalanceExemptions, r.BalanceExemption())
+	}
+	v.CallMethods = r.strings()
+	for i := r.length(); i > 0; i-- {
+		v.Errors = append(v.Errors, r.Error())
+	}
+	v.HistoricalBalanceLookup = r.bool()
+	v.MempoolCoins = r.bool()
+	for i := r.length(); i > 0; i-- {
+		v.OperationStatuses = append(v.OperationStatuses, r.OperationStatus())
+	}
+	v.OperationTypes = r.strings()
+	if r.optional() {
+		v.TimestampStartIndex = OptionalInt64(r.int64(false))
+	}
+	return v
+}
+
+// Amount returns a random Amount value.
+func (r *testRand) Amount() Amount {
+	v := Amount{}
+	v.Currency = r.Currency()
+	v.Metadata = r.mapObject()
+	v.Value = r.string()
+	return v
+}
+
+// BalanceExemption returns a random BalanceExemption value.
+func (r *testRand) BalanceExemption() BalanceExemption {
+	v := BalanceExemption{}
+	if r.optional() {
+		v.Currency = OptionalCurrency(r.Currency())
+	}
+	if r.optional() {
+		v.ExemptionType = OptionalExemptionType(r.ExemptionType())
+	}
+	if r.optional() {
+		v.SubAccountAddress = OptionalString(r.string())
+	}
+	return v
+}
+
+// Block returns a random Block value.
+func (r *testRand) Block() Block {
+	v := Block{}
+	v.BlockIdentifier = r.BlockIdentifier()
+	v.Metadata = r.mapObject()
+	v.ParentBlockIdentifier = r.BlockIdentifier()
+	v.Timestamp = r.Timestamp()
+	for i := r.length(); i > 0; i-- {
+		v.Transactions = append(v.Transactions, r.Transaction())
+	}
+	return v
+}
+
+// BlockEvent returns a random BlockEvent value.
+func (r *testRand) BlockEvent() BlockEvent {
+	v := BlockEvent{}
+	v.BlockIdentifier = r.BlockIdentifier()
+	v.Sequence = r.int64(false)
+	v.Type = r.BlockEventType()
+	return v
+}
+
+// BlockEventType returns a random BlockEventType value.
+func (r *testRand) BlockEventType() BlockEventType {
+	return BlockEventType(r.oneOf("block_added", "block_removed"))
+}
+
+// BlockIdentifier returns a random BlockIdentifier value.
+func (r *testRand) BlockIdentifier() BlockIdentifier {
+	v := BlockIdentifier{}
+	v.Hash = r.string()
+	v.Index = r.int64(false)
+	return v
+}
+
+// BlockRequest returns a random BlockRequest value.
+func (r *testRand) BlockRequest() BlockRequest {
+	v := BlockRequest{}
+	v.BlockIdentifier = r.PartialBlockIdentifier()
+	return v
+}
+
+// BlockResponse returns a random BlockResponse value.
+func (r *testRand) BlockResponse() BlockResponse {
+	v := BlockResponse{}
+	if r.optional() {
+		v.Block = OptionalBlock(r.Block())
+	}
+	for i := r.length(); i > 0; i-- {
+		v.OtherTransactions = append(v.OtherTransactions, r.TransactionIdentifier())
+	}
+	return v
+}
+
+// BlockTransaction returns a random BlockTransaction value.
+func (r *testRand) BlockTransaction() BlockTransaction {
+	v := BlockTransaction{}
+	v.BlockIdentifier = r.BlockIdentifier()
+	v.Transaction = r.Transaction()
+	return v
+}
+
+// BlockTransactionRequest returns a random BlockTransactionRequest value.
+func (r *testRand) BlockTransactionRequest() BlockTransactionRequest {
+	v := BlockTransactionRequest{}
+	v.BlockIdentifier = r.BlockIdentifier()
+	v.TransactionIdentifier = r.TransactionIdentifier()
+	return v
+}
+
+// BlockTransactionResponse returns a random BlockTransactionResponse value.
+func (r *testRand) BlockTransactionResponse() BlockTransactionResponse {
+	v := BlockTransactionResponse{}
+	v.Transaction = r.Transaction()
+	return v
+}
+
+// CallRequest returns a random CallRequest value.
+func (r *testRand) CallRequest() CallRequest {
+	v := CallRequest{}
+	v.Method = r.string()
+	v.Parameters = r.mapObject()
+	return v
+}
+
+// CallResponse returns a random CallResponse value.
+func (r *testRand) CallResponse() CallResponse {
+	v := CallResponse{}
+	v.Idempotent = r.bool()
+	v.Result = r.mapObject()
+	return v
+}
+
+// Coin returns a random Coin value.
+func (r *testRand) Coin() Coin {
+	v := Coin{}
+	v.Amount = r.Amount()
+	v.CoinIdentifier = r.CoinIdentifier()
+	return v
+}
+
+// CoinAction returns a random CoinAction value.
+func (r *testRand) CoinAction() CoinAction {
+	return CoinAction(r.oneOf("coin_created", "coin_spent"))
+}
+
+// CoinChange returns a random CoinChange value.
+func (r *testRand) CoinChange() CoinChange {
+	v := CoinChange{}
+	v.CoinAction = r.CoinAction()
+	v.CoinIdentifier = r.CoinIdentifier()
+	return v
+}
+
+// CoinIdentifier returns a random CoinIdentifier value.
+func (r *testRand) CoinIdentifier() CoinIdentifier {
+	v := CoinIdentifier{}
+	v.Identifier = r.string()
+	return v
+}
+
+// ConstructionCombineRequest returns a random ConstructionCombineRequest value.
+func (r *testRand) ConstructionCombineRequest() ConstructionCombineRequest {
+	v := ConstructionCombineRequest{}
+	for i := r.length(); i > 0; i-- {
+		v.Signatures = append(v.Signatures, r.Signature())
+	}
+	v.UnsignedTransaction = r.string()
+	return v
+}
+
+// ConstructionCombineResponse returns a random ConstructionCombineResponse value.
+func (r *testRand) ConstructionCombineResponse() ConstructionCombineResponse {
+	v := ConstructionCombineResponse{}
+	v.SignedTransaction = r.string()
+	return v
+}
+
+// ConstructionDeriveRequest returns a random ConstructionDeriveRequest value.
+func (r *testRand) ConstructionDeriveRequest() ConstructionDeriveRequest {
+	v := ConstructionDeriveRequest{}
+	v.Metadata = r.mapObject()
+	v.PublicKey = r.PublicKey()
+	return v
+}
+
+// ConstructionDeriveResponse returns a random ConstructionDeriveResponse value.
+func (r *testRand) ConstructionDeriveResponse() ConstructionDeriveResponse {
+	v := ConstructionDeriveResponse{}
+	if r.optional() {
+		v.AccountIdentifier = OptionalAccountIdentifier(r.AccountIdentifier())
+	}
+	v.Metadata = r.mapObject()
+	return v
+}
+
+// ConstructionHashRequest returns a random ConstructionHashRequest value.
+func (r *testRand) ConstructionHashRequest() ConstructionHashRequest {
+	v := ConstructionHashRequest{}
+	v.SignedTransaction = r.string()
+	return v
+}
+
+// ConstructionMetadataRequest returns a random ConstructionMetadataRequest value.
+func (r *testRand) ConstructionMetadataRequest() ConstructionMetadataRequest {
+	v := ConstructionMetadataRequest{}
+	v.Options = r.mapObject()
+	for i := r.length(); i > 0; i-- {
+		v.PublicKeys = append(v.PublicKeys, r.PublicKey())
+	}
+	return v
+}
+
+// ConstructionMetadataResponse returns a random ConstructionMetadataResponse value.
+func (r *testRand) ConstructionMetadataResponse() ConstructionMetadataResponse {
+	v := ConstructionMetadataResponse{}
+	v.Metadata = r.mapObject()
+	for i := r.length(); i > 0; i-- {
+		v.SuggestedFee = append(v.SuggestedFee, r.Amount())
+	}
+	return v
+}
+
+// ConstructionParseRequest returns a random ConstructionParseRequest value.
+func (r *testRand) ConstructionParseRequest() ConstructionParseRequest {
+	v := ConstructionParseRequest{}
+	v.Signed = r.bool()
+	v.Transaction = r.string()
+	return v
+}
+
+// ConstructionParseResponse returns a random ConstructionParseResponse value.
+func (r *testRand) ConstructionParseResponse() ConstructionParseResponse {
+	v := ConstructionParseResponse{}
+	for i := r.length(); i > 0; i-- {
+		v.AccountIdentifierSigners = append(v.AccountIdentifierSigners, r.AccountIdentifier())
+	}
+	v.Metadata = r.mapObject()
+	for i := r.length(); i > 0; i-- {
+		v.Operations = append(v.Operations, r.Operation())
+	}
+	return v
+}
+
+// ConstructionPayloadsRequest returns a random ConstructionPayloadsRequest value.
+func (r *testRand) ConstructionPayloadsRequest() ConstructionPayloadsRequest {
+	v := ConstructionPayloadsRequest{}
+	v.Metadata = r.mapObject()
+	for i := r.length(); i > 0; i-- {
+		v.Operations = append(v.Operations, r.Operation())
+	}
+	for i := r.length(); i > 0; i-- {
+		v.PublicKeys = append(v.PublicKeys, r.PublicKey())
+	}
+	return v
+}
+
+// ConstructionPayloadsResponse returns a random ConstructionPayloadsResponse value.
+func (r *testRand) ConstructionPayloadsResponse() ConstructionPayloadsResponse {
+	v := ConstructionPayloadsResponse{}
+	for i := r.length(); i > 0; i-- {
+		v.Payloads = append(v.Payloads, r.SigningPayload())
+	}
+	v.UnsignedTransaction = r.string()
+	return v
+}
+
+// ConstructionPreprocessRequest returns a random ConstructionPreprocessRequest value.
+func (r *testRand) ConstructionPreprocessRequest() ConstructionPreprocessRequest {
+	v := ConstructionPreprocessRequest{}
+	for i := r.length(); i > 0; i-- {
+		v.MaxFee = append(v.MaxFee, r.Amount())
+	}
+	v.Metadata = r.mapObject()
+	for i := r.length(); i > 0; i-- {
+		v.Operations = append(v.Operations, r.Operation())
+	}
+	if r.optional() {
+		v.SuggestedFeeMultiplier = OptionalFloat64(r.float64(false))
+	}
+	return v
+}
+
+// ConstructionPreprocessResponse returns a random ConstructionPreprocessResponse value.
+func (r *testRand) ConstructionPreprocessResponse() ConstructionPreprocessResponse {
+	v := ConstructionPreprocessResponse{}
+	v.Options = r.mapObject()
+	for i := r.length(); i > 0; i-- {
+		v.RequiredPublicKeys = append(v.RequiredPublicKeys, r.AccountIdentifier())
+	}
+	return v
+}
+
+// ConstructionSubmitRequest returns a random ConstructionSubmitRequest value.
+func (r *testRand) ConstructionSubmitRequest() ConstructionSubmitRequest {
+	v := ConstructionSubmitRequest{}
+	v.SignedTransaction = r.string()
+	return v
+}
+
+// Currency returns a random Currency value.
+func (r *testRand) Currency() Currency {
+	v := Currency{}
+	v.Decimals = r.int32(false)
+	v.Metadata = r.mapObject()
+	v.Symbol = r.string()
+	return v
+}
+
+// CurveType returns a random CurveType value.
+func (r *testRand) CurveType() CurveType {
+	return CurveType(r.oneOf("edwards25519", "secp256k1", "secp256r1", "tweedle"))
+}
+
+// Direction returns a random Direction value.
+func (r *testRand) Direction() Direction {
+	return Direction(r.oneOf("backward", "forward"))
+}
+
+// Error returns a random Error value.
+func (r *testRand) Error() Error {
+	v := Error{}
+	v.Code = r.int32(false)
+	if r.optional() {
+		v.Description = OptionalString(r.string())
+	}
+	v.Details = r.mapObject()
+	v.Message = r.string()
+	v.Retriable = r.bool()
+	return v
+}
+
+// EventsBlocksRequest returns a random EventsBlocksRequest value.
+func (r *testRand) EventsBlocksRequest() EventsBlocksRequest {
+	v := EventsBlocksRequest{}
+	if r.optional() {
+		v.Limit = OptionalInt64(r.int64(false))
+	}
+	if r.optional() {
+		v.Offset = OptionalInt64(r.int64(false))
+	}
+	return v
+}
+
+// EventsBlocksResponse returns a random EventsBlocksResponse value.
+func (r *testRand) EventsBlocksResponse() EventsBlocksResponse {
+	v := EventsBlocksResponse{}
+	for i := r.length(); i > 0; i-- {
+		v.Events = append(v.Events, r.BlockEvent())
+	}
+	v.MaxSequence = r.int64(false)
+	return v
+}
+
+// ExemptionType returns a random ExemptionType value.
+func (r *testRand) ExemptionType() ExemptionType {
+	return ExemptionType(r.oneOf("dynamic", "greater_or_equal", "less_or_equal"))
+}
+
+// MempoolResponse returns a random MempoolResponse value.
+func (r *testRand) MempoolResponse() MempoolResponse {
+	v := MempoolResponse{}
+	for i := r.length(); i > 0; i-- {
+		v.TransactionIdentifiers = append(v.TransactionIdentifiers, r.TransactionIdentifier())
+	}
+	return v
+}
+
+// MempoolTransactionRequest returns a random MempoolTransactionRequest value.
+func (r *testRand) MempoolTransactionRequest() MempoolTransactionRequest {
+	v := MempoolTransactionRequest{}
+	v.TransactionIdentifier = r.TransactionIdentifier()
+	return v
+}
+
+// MempoolTransactionResponse returns a random MempoolTransactionResponse value.
+func (r *testRand) MempoolTransactionResponse() MempoolTransactionResponse {
+	v := MempoolTransactionResponse{}
+	v.Metadata = r.mapObject()
+	v.Transaction = r.Transaction()
+	return v
+}
+
+// MetadataRequest returns a random MetadataRequest value.
+func (r *testRand) MetadataRequest() MetadataRequest {
+	v := MetadataRequest{}
+	v.Metadata = r.mapObject()
+	return v
+}
+
+// NetworkIdentifier returns a random NetworkIdentifier value.
+func (r *testRand) NetworkIdentifier() NetworkIdentifier {
+	v := NetworkIdentifier{}
+	v.Blockchain = r.string()
+	v.Network = r.string()
+	if r.optional() {
+		v.SubNetworkIdentifier = OptionalSubNetworkIdentifier(r.SubNetworkIdentifier())
+	}
+	return v
+}
+
+// NetworkListResponse returns a random NetworkListResponse value.
+func (r *testRand) NetworkListResponse() NetworkListResponse {
+	v := NetworkListResponse{}
+	for i := r.length(); i > 0; i-- {
+		v.NetworkIdentifiers = append(v.NetworkIdentifiers, r.NetworkIdentifier())
+	}
+	return v
+}
+
+// NetworkOptionsResponse returns a random NetworkOptionsResponse value.
+func (r *testRand) NetworkOptionsResponse() NetworkOptionsResponse {
+	v := NetworkOptionsResponse{}
+	v.Allow = r.Allow()
+	v.Version = r.Version()
+	return v
+}
+
+// NetworkRequest returns a random NetworkRequest value.
+func (r *testRand) NetworkRequest() NetworkRequest {
+	v := NetworkRequest{}
+	v.Metadata = r.mapObject()
+	return v
+}
+
+// NetworkStatusResponse returns a random NetworkStatusResponse value.
+func (r *testRand) NetworkStatusResponse() NetworkStatusResponse {
+	v := NetworkStatusResponse{}
+	v.CurrentBlockIdentifier = r.BlockIdentifier()
+	v.CurrentBlockTimestamp = r.Timestamp()
+	v.GenesisBlockIdentifier = r.BlockIdentifier()
+	if r.optional() {
+		v.OldestBlockIdentifier = OptionalBlockIdentifier(r.BlockIdentifier())
+	}
+	for i := r.length(); i > 0; i-- {
+		v.Peers = append(v.Peers, r.Peer())
+	}
+	if r.optional() {
+		v.SyncStatus = OptionalSyncStatus(r.SyncStatus())
+	}
+	return v
+}
+
+// Operation returns a random Operation value.
+func (r *testRand) Operation() Operation {
+	v := Operation{}
+	if r.optional() {
+		v.Account = OptionalAccountIdentifier(r.AccountIdentifier())
+	}
+	if r.optional() {
+		v.Amount = OptionalAmount(r.Amount())
+	}
+	if r.optional() {
+		v.CoinChange = OptionalCoinChange(r.CoinChange())
+	}
+	v.Metadata = r.mapObject()
+	v.OperationIdentifier = r.OperationIdentifier()
+	for i := r.length(); i > 0; i-- {
+		v.RelatedOperations = append(v.RelatedOperations, r.OperationIdentifier())
+	}
+	if r.optional() {
+		v.Status = OptionalString(r.string())
+	}
+	v.Type = r.string()
+	return v
+}
+
+// OperationIdentifier returns a random OperationIdentifier value.
+func (r *testRand) OperationIdentifier() OperationIdentifier {
+	v := OperationIdentifier{}
+	v.Index = r.int64(false)
+	if r.optional() {
+		v.NetworkIndex = OptionalInt64(r.int64(false))
+	}
+	return v
+}
+
+// OperationStatus returns a random OperationStatus value.
+func (r *testRand) OperationStatus() OperationStatus {
+	v := OperationStatus{}
+	v.Status = r.string()
+	v.Successful = r.bool()
+	return v
+}
+
+// Operator returns a random Operator value.
+func (r *testRand) Operator() Operator {
+	return Operator(r.oneOf("and", "or"))
+}
+
+// PartialBlockIdentifier returns a random PartialBlockIdentifier value.
+func (r *testRand) PartialBlockIdentifier() PartialBlockIdentifier {
+	v := PartialBlockIdentifier{}
+	if r.optional() {
+		v.Hash = OptionalString(r.string())
+	}
+	if r.optional() {
+		v.Index = OptionalInt64(r.int64(false))
+	}
+	return v
+}
+
+// Peer returns a random Peer value.
+func (r *testRand) Peer() Peer {
+	v := Peer{}
+	v.Metadata = r.mapObject()
+	v.PeerID = r.string()
+	return v
+}
+
+// PublicKey returns a random PublicKey value.
+func (r *testRand) PublicKey() PublicKey {
+	v := PublicKey{}
+	v.Bytes = r.bytes()
+	v.CurveType = r.CurveType()
+	return v
+}
+
+// RelatedTransaction returns a random RelatedTransaction value.
+func (r *testRand) RelatedTransaction() RelatedTransaction {
+	v := RelatedTransaction{}
+	v.Direction = r.Direction()
+	if r.optional() {
+		v.NetworkIdentifier = OptionalNetworkIdentifier(r.NetworkIdentifier())
+	}
+	v.TransactionIdentifier = r.TransactionIdentifier()
+	return v
+}
+
+// SearchTransactionsRequest returns a random SearchTransactionsRequest value.
+func (r *testRand) SearchTransactionsRequest() SearchTransactionsRequest {
+	v := SearchTransactionsRequest{}
+	if r.optional() {
+		v.AccountIdentifier = OptionalAccountIdentifier(r.AccountIdentifier())
+	}
+	if r.optional() {
+		v.Address = OptionalString(r.string())
+	}
+	if r.optional() {
+		v.CoinIdentifier = OptionalCoinIdentifier(r.CoinIdentifier())
+	}
+	if r.optional() {
+		v.Currency = OptionalCurrency(r.Currency())
+	}
+	if r.optional() {
+		v.Limit = OptionalInt64(r.int64(false))
+	}
+	if r.optional() {
+		v.MaxBlock = OptionalInt64(r.int64(false))
+	}
+	if r.optional() {
+		v.Offset = OptionalInt64(r.int64(false))
+	}
+	if r.optional() {
+		v.Operator = OptionalOperator(r.Operator())
+	}
+	if r.optional() {
+		v.Status = OptionalString(r.string())
+	}
+	if r.optional() {
+		v.Success = OptionalBool(r.bool())
+	}
+	if r.optional() {
+		v.TransactionIdentifier = OptionalTransactionIdentifier(r.TransactionIdentifier())
+	}
+	if r.optional() {
+		v.Type = OptionalString(r.string())
+	}
+	return v
+}
+
+// SearchTransactionsResponse returns a random SearchTransactionsResponse value.
+func (r *testRand) SearchTransactionsResponse() SearchTransactionsResponse {
+	v := SearchTransactionsResponse{}
+	if r.optional() {
+		v.NextOffset = OptionalInt64(r.int64(false))
+	}
+	v.TotalCount = r.int64(false)
+	for i := r.length(); i > 0; i-- {
+		v.Transactions = append(v.Transactions, r.BlockTransaction())
+	}
+	return v
+}
+
+// Signature returns a random Signature value.
+func (r *testRand) Signature() Signature {
+	v := Signature{}
+	v.Bytes = r.bytes()
+	v.PublicKey = r.PublicKey()
+	v.SignatureType = r.SignatureType()
+	v.SigningPayload = r.SigningPayload()
+	return v
+}
+
+// SignatureType returns a random SignatureType value.
+func (r *testRand) SignatureType() SignatureType {
+	return SignatureType(r.oneOf("ecdsa", "ecdsa_recovery", "ed25519", "schnorr_1", "schnorr_poseidon"))
+}
+
+// SigningPayload returns a random SigningPayload value.
+func (r *testRand) SigningPayload() SigningPayload {
+	v := SigningPayload{}
+	if r.optional() {
+		v.AccountIdentifier = OptionalAccountIdentifier(r.AccountIdentifier())
+	}
+	v.Bytes = r.bytes()
+	if r.optional() {
+		v.SignatureType = OptionalSignatureType(r.SignatureType())
+	}
+	return v
+}
+
+// SubAccountIdentifier returns a random SubAccountIdentifier value.
+func (r *testRand) SubAccountIdentifier() SubAccountIdentifier {
+	v := SubAccountIdentifier{}
+	v.Address = r.string()
+	v.Metadata = r.mapObject()
+	return v
+}
+
+// SubNetworkIdentifier returns a random SubNetworkIdentifier value.
+func (r *testRand) SubNetworkIdentifier() SubNetworkIdentifier {
+	v := SubNetworkIdentifier{}
+	v.Metadata = r.mapObject()
+	v.Network = r.string()
+	return v
+}
+
+// SyncStatus returns a random SyncStatus value.
+func (r *testRand) SyncStatus() SyncStatus {
+	v := SyncStatus{}
+	if r.optional() {
+		v.CurrentIndex = OptionalInt64(r.int64(false))
+	}
+	if r.optional() {
+		v.Stage = OptionalString(r.string())
+	}
+	if r.optional() {
+		v.Synced = OptionalBool(r.bool())
+	}
+	if r.optional() {
+		v.TargetIndex = OptionalInt64(r.int64(false))
+	}
+	return v
+}
+
+// Timestamp returns a random Timestamp value.
+func (r *testRand) Timestamp() Timestamp {
+	return Timestamp(r.int64(true))
+}
+
+// Transaction returns a random Transaction value.
+func (r *testRand) Transaction() Transaction {
+	v := Transaction{}
+	v.Metadata = r.mapObject()
+	for i := r.length(); i > 0; i-- {
+		v.Operations = append(v.Operations, r.Operation())
+	}
+	for i := r.length(); i > 0; i-- {
+		v.RelatedTransactions = append(v.RelatedTransactions, r.RelatedTransaction())
+	}
+	v.TransactionIdentifier = r.TransactionIdentifier()
+	return v
+}
+
+// TransactionIdentifier returns a random TransactionIdentifier value.
+func (r *testRand) TransactionIdentifier() TransactionIdentifier {
+	v := TransactionIdentifier{}
+	v.Hash = r.string()
+	return v
+}
+
+// TransactionIdentifierResponse returns a random TransactionIdentifierResponse value.
+func (r *testRand) TransactionIdentifierResponse() TransactionIdentifierResponse {
+	v := TransactionIdentifierResponse{}
+	v.Metadata = r.mapObject()
+	v.TransactionIdentifier = r.TransactionIdentifier()
+	return v
+}
+
+// Version returns a random Version value.
+func (r *testRand) Version() Version {
+	v := Version{}
+	v.Metadata = r.mapObject()
+	if r.optional() {
+		v.MiddlewareVersion = OptionalString(r.string())
+	}
+	v.NodeVersion = r.string()
+	v.RosettaVersion = r.string()
+	return v
+}
+
+func TestRoundTrip(t *testing.T) {
+	r := newTestRand()
+	t.Run("AccountBalanceRequest", func(t *testing.T) {
+		for i := 0; i < roundTrips; i++ {
+			n := r.NetworkIdentifier()
+			v := r.AccountBalanceRequest()
+			m := NetworkIdentifier{}
+			w := AccountBalanceRequest{}
+			checkRoundTrip(t, v.EncodeJSON(nil, EncodeNetworkForJSON(n)), func(d *json.Decoder) ([]Difference, error) {
+				err := w.DecodeJSON(d, &m)
+				return n.appendDiffs(v.Diff(w), "network_identifier", m), err
+			}, &types.AccountBalanceRequest{})
+		}
+	})
+	t.Run("AccountBalanceResponse", func(t *testing.T) {
+		for i := 0; i < roundTrips; i++ {
+			v := r.AccountBalanceResponse()
+			w := AccountBalanceResponse{}
+			checkRoundTrip(t, v.EncodeJSON(nil), func(d *json.Decoder) ([]Difference, error) {
+				err := w.DecodeJSON(d)
+				return v.Diff(w), err
+			}, &types.AccountBalanceResponse{})
+		}
+	})
+	t.Run("AccountCoinsRequest", func(t *testing.T) {
+		for i := 0; i < roundTrips; i++ {
+			n := r.NetworkIdentifier()
+			v := r.AccountCoinsRequest()
+			m := NetworkIdentifier{}
+			w := AccountCoinsRequest{}
+			checkRoundTrip(t, v.EncodeJSON(nil, EncodeNetworkForJSON(n)), func(d *json.Decoder) ([]Difference, error) {
+				err := w.DecodeJSON(d, &m)
+				return n.appendDiffs(v.Diff(w), "network_identifier", m), err
+			}, &types.AccountCoinsRequest{})
+		}
+	})
+	t.Run("AccountCoinsResponse", func(t *testing.T) {
+		for i := 0; i < roundTrips; i++ {
+			v := r.AccountCoinsResponse()
+			w := AccountCoinsResponse{}
+			checkRoundTrip(t, v.EncodeJSON(nil), func(d *json.Decoder) ([]Difference, error) {
+				err := w.DecodeJSON(d)
+				return v.Diff(w), err
+			}, &types.AccountCoinsResponse{})
+		}
+	})
+	t.Run("AccountIdentifier", func(t *testing.T) {
+		for i := 0; i < roundTrips; i++ {
+			v := r.AccountIdentifier()
+			w := AccountIdentifier{}
+			checkRoundTrip(t, v.EncodeJSON(nil), func(d *json.Decoder) ([]Difference, error) {
+				err := w.DecodeJSON(d)
+				return v.Diff(w), err
+			}, &types.AccountIdentifier{})
+		}
+	})
+	t.Run("Allow", func(t *testing.T) {
+		for i := 0; i < roundTrips; i++ {
+			v := r.Allow()
+			w := Allow{}
+			checkRoundTrip(t, v.EncodeJSON(nil), func(d *json.Decoder) ([]Difference, error) {
+				err := w.DecodeJSON(d)
+				return v.Diff(w), err
+			}, &types.Allow{})
+		}
+	})
+	t.Run("Amount", func(t *testing.T) {
+		for i := 0; i < roundTrips; i++ {
+			v := r.Amount()
+			w := Amount{}
+			checkRoundTrip(t, v.EncodeJSON(nil), func(d *json.Decoder) ([]Difference, error) {
+				err := w.DecodeJSON(d)
+				return v.Diff(w), err
+			}, &types.Amount{})
+		}
+	})
+	t.Run("BalanceExemption", func(t *testing.T) {
+		for i := 0; i < roundTrips; i++ {
+			v := r.BalanceExemption()
+			w := BalanceExemption{}
+			checkRoundTrip(t, v.EncodeJSON(nil), func(d *json.Decoder) ([]Difference, error) {
+				err := w.DecodeJSON(d)
+				return v.Diff(w), err
+			}, &types.BalanceExemption{})
+		}
+	})
+	t.Run("Block", func(t *testing.T) {
+		for i := 0; i < roundTrips; i++ {
+			v := r.Block()
+			w := Block{}
+			checkRoundTrip(t, v.EncodeJSON(nil), func(d *json.Decoder) ([]Difference, error) {
+				err := w.DecodeJSON(d)
+				return v.Diff(w), err
+			}, &types.Block{})
+		}
+	})
+	t.Run("BlockEvent", func(t *testing.T) {
+		for i := 0; i < roundTrips; i++ {
+			v := r.BlockEvent()
+			w := BlockEvent{}
+			checkRoundTrip(t, v.EncodeJSON(nil), func(d *json.Decoder) ([]Difference, error) {
+				err := w.DecodeJSON(d)
+				return v.Diff(w), err
+			}, &types.BlockEvent{})
+		}
+	})
+	t.Run("BlockIdentifier", func(t *testing.T) {
+		for i := 0; i < roundTrips; i++ {
+			v := r.BlockIdentifier()
+			w := BlockIdentifier{}
+			checkRoundTrip(t, v.EncodeJSON(nil), func(d *json.Decoder) ([]Difference, error) {
+				err := w.DecodeJSON(d)
+				return v.Diff(w), err
+			}, &types.BlockIdentifier{})
+		}
+	})
+	t.Run("BlockRequest", func(t *testing.T) {
+		for i := 0; i < roundTrips; i++ {
+			n := r.NetworkIdentifier()
+			v := r.BlockRequest()
+			m := NetworkIdentifier{}
+			w := BlockRequest{}
+			checkRoundTrip(t, v.EncodeJSON(nil, EncodeNetworkForJSON(n)), func(d *json.Decoder) ([]Difference, error) {
+				err := w.DecodeJSON(d, &m)
+				return n.appendDiffs(v.Diff(w), "network_identifier", m), err
+			}, &types.BlockRequest{})
+		}
+	})
+	t.Run("BlockResponse", func(t *testing.T) {
+		for i := 0; i < roundTrips; i++ {
+			v := r.BlockResponse()
+			w := BlockResponse{}
+			checkRoundTrip(t, v.EncodeJSON(nil), func(d *json.Decoder) ([]Difference, error) {
+				err := w.DecodeJSON(d)
+				return v.Diff(w), err
+			}, &types.BlockResponse{})
+		}
+	})
+	t.Run("BlockTransaction", func(t *testing.T) {
+		for i := 0; i < roundTrips; i++ {
+			v := r.BlockTransaction()
+			w := BlockTransaction{}
+			checkRoundTrip(t, v.EncodeJSON(nil), func(d *json.Decoder) ([]Difference, error) {
+				err := w.DecodeJSON(d)
+				return v.Diff(w), err
+			}, &types.BlockTransaction{})
+		}
+	})
+	t.Run("BlockTransactionRequest", func(t *testing.T) {
+		for i := 0; i < roundTrips; i++ {
+			n := r.NetworkIdentifier()
+			v := r.BlockTransactionRequest()
+			m := NetworkIdentifier{}
+			w := BlockTransactionRequest{}
+			checkRoundTrip(t, v.EncodeJSON(nil, EncodeNetworkForJSON(n)), func(d *json.Decoder) ([]Difference, error) {
+				err := w.DecodeJSON(d, &m)
+				return n.appendDiffs(v.Diff(w), "network_identifier", m), err
+			}, &types.BlockTransactionRequest{})
+		}
+	})
+	t.Run("BlockTransactionResponse", func(t *testing.T) {
+		for i := 0; i < roundTrips; i++ {
+			v := r.BlockTransactionResponse()
+			w := BlockTransactionResponse{}
+			checkRoundTrip(t, v.EncodeJSON(nil), func(d *json.Decoder) ([]Difference, error) {
+				err := w.DecodeJSON(d)
+				return v.Diff(w), err
+			}, &types.BlockTransactionResponse{})
+		}
+	})
+	t.Run("CallRequest", func(t *testing.T) {
+		for i := 0; i < roundTrips; i++ {
+			n := r.NetworkIdentifier()
+			v := r.CallRequest()
+			m := NetworkIdentifier{}
+			w := CallRequest{}
+			checkRoundTrip(t, v.EncodeJSON(nil, EncodeNetworkForJSON(n)), func(d *json.Decoder) ([]Difference, error) {
+				err := w.DecodeJSON(d, &m)
+				return n.appendDiffs(v.Diff(w), "network_identifier", m), err
+			}, &types.CallRequest{})
+		}
+	})
+	t.Run("CallResponse", func(t *testing.T) {
+		for i := 0; i < roundTrips; i++ {
+			v := r.CallResponse()
+			w := CallResponse{}
+			checkRoundTrip(t, v.EncodeJSON(nil), func(d *json.Decoder) ([]Difference, error) {
+				err := w.DecodeJSON(d)
+				return v.Diff(w), err
+			}, &types.CallResponse{})
+		}
+	})
+	t.Run("Coin", func(t *testing.T) {
+		for i := 0; i < roundTrips; i++ {
+			v := r.Coin()
+			w := Coin{}
+			checkRoundTrip(t, v.EncodeJSON(nil), func(d *json.Decoder) ([]Difference, error) {
+				err := w.DecodeJSON(d)
+				return v.Diff(w), err
+			}, &types.Coin{})
+		}
+	})
+	t.Run("CoinChange", func(t *testing.T) {
+		for i := 0; i < roundTrips; i++ {
+			v := r.CoinChange()
+			w := CoinChange{}
+			checkRoundTrip(t, v.EncodeJSON(nil), func(d *json.Decoder) ([]Difference, error) {
+				err := w.DecodeJSON(d)
+				return v.Diff(w), err
+			}, &types.CoinChange{})
+		}
+	})
+	t.Run("CoinIdentifier", func(t *testing.T) {
+		for i := 0; i < roundTrips; i++ {
+			v := r.CoinIdentifier()
+			w := CoinIdentifier{}
+			checkRoundTrip(t, v.EncodeJSON(nil), func(d *json.Decoder) ([]Difference, error) {
+				err := w.DecodeJSON(d)
+				return v.Diff(w), err
+			}, &types.CoinIdentifier{})
+		}
+	})
+	t.Run("ConstructionCombineRequest", func(t *testing.T) {
+		for i := 0; i < roundTrips; i++ {
+			n := r.NetworkIdentifier()
+			v := r.ConstructionCombineRequest()
+			m := NetworkIdentifier{}
+			w := ConstructionCombineRequest{}
+			checkRoundTrip(t, v.EncodeJSON(nil, EncodeNetworkForJSON(n)), func(d *json.Decoder) ([]Difference, error) {
+				err := w.DecodeJSON(d, &m)
+				return n.appendDiffs(v.Diff(w), "network_identifier", m), err
+			}, &types.ConstructionCombineRequest{})
+		}
+	})
+	t.Run("ConstructionCombineResponse", func(t *testing.T) {
+		for i := 0; i < roundTrips; i++ {
+			v := r.ConstructionCombineResponse()
+			w := ConstructionCombineResponse{}
+			checkRoundTrip(t, v.EncodeJSON(nil), func(d *json.Decoder) ([]Difference, error) {
+				err := w.DecodeJSON(d)
+				return v.Diff(w), err
+			}, &types.ConstructionCombineResponse{})
+		}
+	})
+	t.Run("ConstructionDeriveRequest", func(t *testing.T) {
+		for i := 0; i < roundTrips; i++ {
+			n := r.NetworkIdentifier()
+			v := r.ConstructionDeriveRequest()
+			m := NetworkIdentifier{}
+			w := ConstructionDeriveRequest{}
+			checkRoundTrip(t, v.EncodeJSON(nil, EncodeNetworkForJSON(n)), func(d *json.Decoder) ([]Difference, error) {
+				err := w.DecodeJSON(d, &m)
+				return n.appendDiffs(v.Diff(w), "network_identifier", m), err
+			}, &types.ConstructionDeriveRequest{})
+		}
+	})
+	t.Run("ConstructionDeriveResponse", func(t *testing.T) {
+		for i := 0; i < roundTrips; i++ {
+			v := r.ConstructionDeriveResponse()
+			w := ConstructionDeriveResponse{}
+			checkRoundTrip(t, v.EncodeJSON(nil), func(d *json.Decoder) ([]Difference, error) {
+				err := w.DecodeJSON(d)
+				return v.Diff(w), err
+			}, &types.ConstructionDeriveResponse{})
+		}
+	})
+	t.Run("ConstructionHashRequest", func(t *testing.T) {
+		for i := 0; i < roundTrips; i++ {
+			n := r.NetworkIdentifier()
+			v := r.ConstructionHashRequest()
+			m := NetworkIdentifier{}
+			w := ConstructionHashRequest{}
+			checkRoundTrip(t, v.EncodeJSON(nil, EncodeNetworkForJSON(n)), func(d *json.Decoder) ([]Difference, error) {
+				err := w.DecodeJSON(d, &m)
+				return n.appendDiffs(v.Diff(w), "network_identifier", m), err
+			}, &types.ConstructionHashRequest{})
+		}
+	})
+	t.Run("ConstructionMetadataRequest", func(t *testing.T) {
+		for i := 0; i < roundTrips; i++ {
+			n := r.NetworkIdentifier()
+			v := r.ConstructionMetadataRequest()
+			m := NetworkIdentifier{}
+			w := ConstructionMetadataRequest{}
+			checkRoundTrip(t, v.EncodeJSON(nil, EncodeNetworkForJSON(n)), func(d *json.Decoder) ([]Difference, error) {
+				err := w.DecodeJSON(d, &m)
+				return n.appendDiffs(v.Diff(w), "network_identifier", m), err
+			}, &types.ConstructionMetadataRequest{})
+		}
+	})
+	t.Run("ConstructionMetadataResponse", func(t *testing.T) {
+		for i := 0; i < roundTrips; i++ {
+			v := r.ConstructionMetadataResponse()
+			w := ConstructionMetadataResponse{}
+			checkRoundTrip(t, v.EncodeJSON(nil), func(d *json.Decoder) ([]Difference, error) {
+				err := w.DecodeJSON(d)
+				return v.Diff(w), err
+			}, &types.ConstructionMetadataResponse{})
+		}
+	})
+	t.Run("ConstructionParseRequest", func(t *testing.T) {
+		for i := 0; i < roundTrips; i++ {
+			n := r.NetworkIdentifier()
+			v := r.ConstructionParseRequest()
+			m := NetworkIdentifier{}
+			w := ConstructionParseRequest{}
+			checkRoundTrip(t, v.EncodeJSON(nil, EncodeNetworkForJSON(n)), func(d *json.Decoder) ([]Difference, error) {
+				err := w.DecodeJSON(d, &m)
+				return n.appendDiffs(v.Diff(w), "network_identifier", m), err
+			}, &types.ConstructionParseRequest{})
+		}
+	})
+	t.Run("ConstructionParseResponse", func(t *testing.T) {
+		for i := 0; i < roundTrips; i++ {
+			v := r.ConstructionParseResponse()
+			w := ConstructionParseResponse{}
+			checkRoundTrip(t, v.EncodeJSON(nil), func(d *json.Decoder) ([]Difference, error) {
+				err := w.DecodeJSON(d)
+				return v.Diff(w), err
+			}, &types.ConstructionParseResponse{})
+		}
+	})
+	t.Run("ConstructionPayloadsRequest", func(t *testing.T) {
+		for i := 0; i < roundTrips; i++ {
+			n := r.NetworkIdentifier()
+			v := r.ConstructionPayloadsRequest()
+			m := NetworkIdentifier{}
+			w := ConstructionPayloadsRequest{}
+			checkRoundTrip(t, v.EncodeJSON(nil, EncodeNetworkForJSON(n)), func(d *json.Decoder) ([]Difference, error) {
+				err := w.DecodeJSON(d, &m)
+				return n.appendDiffs(v.Diff(w), "network_identifier", m), err
+			}, &types.ConstructionPayloadsRequest{})
+		}
+	})
+	t.Run("ConstructionPayloadsResponse", func(t *testing.T) {
+		for i := 0; i < roundTrips; i++ {
+			v := r.ConstructionPayloadsResponse()
+			w := ConstructionPayloadsResponse{}
+			checkRoundTrip(t, v.EncodeJSON(nil), func(d *json.Decoder) ([]Difference, error) {
+				err := w.DecodeJSON(d)
+				return v.Diff(w), err
+			}, &types.ConstructionPayloadsResponse{})
+		}
+	})
+	t.Run("ConstructionPreprocessRequest", func(t *testing.T) {
+		for i := 0; i < roundTrips; i++ {
+			n := r.NetworkIdentifier()
+			v := r.ConstructionPreprocessRequest()
+			m := NetworkIdentifier{}
+			w := ConstructionPreprocessRequest{}
+			checkRoundTrip(t, v.EncodeJSON(nil, EncodeNetworkForJSON(n)), func(d *json.Decoder) ([]Difference, error) {
+				err := w.DecodeJSON(d, &m)
+				return n.appendDiffs(v.Diff(w), "network_identifier", m), err
+			}, &types.ConstructionPreprocessRequest{})
+		}
+	})
+	t.Run("ConstructionPreprocessResponse", func(t *testing.T) {
+		for i := 0; i < roundTrips; i++ {
+			v := r.ConstructionPreprocessResponse()
+			w := ConstructionPreprocessResponse{}
+			checkRoundTrip(t, v.EncodeJSON(nil), func(d *json.Decoder) ([]Difference, error) {
+				err := w.DecodeJSON(d)
+				return v.Diff(w), err
+			}, &types.ConstructionPreprocessResponse{})
+		}
+	})
+	t.Run("ConstructionSubmitRequest", func(t *testing.T) {
+		for i := 0; i < roundTrips; i++ {
+			n := r.NetworkIdentifier()
+			v := r.ConstructionSubmitRequest()
+			m := NetworkIdentifier{}
+			w := ConstructionSubmitRequest{}
+			checkRoundTrip(t, v.EncodeJSON(nil, EncodeNetworkForJSON(n)), func(d *json.Decoder) ([]Difference, error) {
+				err := w.DecodeJSON(d, &m)
+				return n.appendDiffs(v.Diff(w), "network_identifier", m), err
+			}, &types.ConstructionSubmitRequest{})
+		}
+	})
+	t.Run("Currency", func(t *testing.T) {
+		for i := 0; i < roundTrips; i++ {
+			v := r.Currency()
+			w := Currency{}
+			checkRoundTrip(t, v.EncodeJSON(nil), func(d *json.Decoder) ([]Difference, error) {
+				err := w.DecodeJSON(d)
+				return v.Diff(w), err
+			}, &types.Currency{})
+		}
+	})
+	t.Run("Error", func(t *testing.T) {
+		for i := 0; i < roundTrips; i++ {
+			v := r.Error()
+			w := Error{}
+			checkRoundTrip(t, v.EncodeJSON(nil), func(d *json.Decoder) ([]Difference, error) {
+				err := w.DecodeJSON(d)
+				return v.Diff(w), err
+			}, &types.Error{})
+		}
+	})
+	t.Run("EventsBlocksRequest", func(t *testing.T) {
+		for i := 0; i < roundTrips; i++ {
+			n := r.NetworkIdentifier()
+			v := r.EventsBlocksRequest()
+			m := NetworkIdentifier{}
+			w := EventsBlocksRequest{}
+			checkRoundTrip(t, v.EncodeJSON(nil, EncodeNetworkForJSON(n)), func(d *json.Decoder) ([]Difference, error) {
+				err := w.DecodeJSON(d, &m)
+				return n.appendDiffs(v.Diff(w), "network_identifier", m), err
+			}, &types.EventsBlocksRequest{})
+		}
+	})
+	t.Run("EventsBlocksResponse", func(t *testing.T) {
+		for i := 0; i < roundTrips; i++ {
+			v := r.EventsBlocksResponse()
+			w := EventsBlocksResponse{}
+			checkRoundTrip(t, v.EncodeJSON(nil), func(d *json.Decoder) ([]Difference, error) {
+				err := w.DecodeJSON(d)
+				return v.Diff(w), err
+			}, &types.EventsBlocksResponse{})
+		}
+	})
+	t.Run("MempoolResponse", func(t *testing.T) {
+		for i := 0; i < roundTrips; i++ {
+			v := r.MempoolResponse()
+			w := MempoolResponse{}
+			checkRoundTrip(t, v.EncodeJSON(nil), func(d *json.Decoder) ([]Difference, error) {
+				err := w.DecodeJSON(d)
+				return v.Diff(w), err
+			}, &types.MempoolResponse{})
+		}
+	})
+	t.Run("MempoolTransactionRequest", func(t *testing.T) {
+		for i := 0; i < roundTrips; i++ {
+			n := r.NetworkIdentifier()
+			v := r.MempoolTransactionRequest()
+			m := NetworkIdentifier{}
+			w := MempoolTransactionRequest{}
+			checkRoundTrip(t, v.EncodeJSON(nil, EncodeNetworkForJSON(n)), func(d *json.Decoder) ([]Difference, error) {
+				err := w.DecodeJSON(d, &m)
+				return n.appendDiffs(v.Diff(w), "network_identifier", m), err
+			}, &types.MempoolTransactionRequest{})
+		}
+	})
+	t.Run("MempoolTransactionResponse", func(t *testing.T) {
+		for i := 0; i < roundTrips; i++ {
+			v := r.MempoolTransactionResponse()
+			w := MempoolTransactionResponse{}
+			checkRoundTrip(t, v.EncodeJSON(nil), func(d *json.Decoder) ([]Difference, error) {
+				err := w.DecodeJSON(d)
+				return v.Diff(w), err
+			}, &types.MempoolTransactionResponse{})
+		}
+	})
+	t.Run("MetadataRequest", func(t *testing.T) {
+		for i := 0; i < roundTrips; i++ {
+			v := r.MetadataRequest()
+			w := MetadataRequest{}
+			checkRoundTrip(t, v.EncodeJSON(nil), func(d *json.Decoder) ([]Difference, error) {
+				err := w.DecodeJSON(d)
+				return v.Diff(w), err
+			}, &types.MetadataRequest{})
+		}
+	})
+	t.Run("NetworkIdentifier", func(t *testing.T) {
+		for i := 0; i < roundTrips; i++ {
+			v := r.NetworkIdentifier()
+			w := NetworkIdentifier{}
+			checkRoundTrip(t, v.EncodeJSON(nil), func(d *json.Decoder) ([]Difference, error) {
+				err := w.DecodeJSON(d)
+				return v.Diff(w), err
+			}, &types.NetworkIdentifier{})
+		}
+	})
+	t.Run("NetworkListResponse", func(t *testing.T) {
+		for i := 0; i < roundTrips; i++ {
+			v := r.NetworkListResponse()
+			w := NetworkListResponse{}
+			checkRoundTrip(t, v.EncodeJSON(nil), func(d *json.Decoder) ([]Difference, error) {
+				err := w.DecodeJSON(d)
+				return v.Diff(w), err
+			}, &types.NetworkListResponse{})
+		}
+	})
+	t.Run("NetworkOptionsResponse", func(t *testing.T) {
+		for i := 0; i < roundTrips; i++ {
+			v := r.NetworkOptionsResponse()
+			w := NetworkOptionsResponse{}
+			checkRoundTrip(t, v.EncodeJSON(nil), func(d *json.Decoder) ([]Difference, error) {
+				err := w.DecodeJSON(d)
+				return v.Diff(w), err
+			}, &types.NetworkOptionsResponse{})
+		}
+	})
+	t.Run("NetworkRequest", func(t *testing.T) {
+		for i := 0; i < roundTrips; i++ {
+			n := r.NetworkIdentifier()
+			v := r.NetworkRequest()
+			m := NetworkIdentifier{}
+			w := NetworkRequest{}
+			checkRoundTrip(t, v.EncodeJSON(nil, EncodeNetworkForJSON(n)), func(d *json.Decoder) ([]Difference, error) {
+				err := w.DecodeJSON(d, &m)
+				return n.appendDiffs(v.Diff(w), "network_identifier", m), err
+			}, &types.NetworkRequest{})
+		}
+	})
+	t.Run("NetworkStatusResponse", func(t *testing.T) {
+		for i := 0; i < roundTrips; i++ {
+			v := r.NetworkStatusResponse()
+			w := NetworkStatusResponse{}
+			checkRoundTrip(t, v.EncodeJSON(nil), func(d *json.Decoder) ([]Difference, error) {
+				err := w.DecodeJSON(d)
+				return v.Diff(w), err
+			}, &types.NetworkStatusResponse{})
+		}
+	})
+	t.Run("Operation", func(t *testing.T) {
+		for i := 0; i < roundTrips; i++ {
+			v := r.Operation()
+			w := Operation{}
+			checkRoundTrip(t, v.EncodeJSON(nil), func(d *json.Decoder) ([]Difference, error) {
+				err := w.DecodeJSON(d)
+				return v.Diff(w), err
+			}, &types.Operation{})
+		}
+	})
+	t.Run("OperationIdentifier", func(t *testing.T) {
+		for i := 0; i < roundTrips; i++ {
+			v := r.OperationIdentifier()
+			w := OperationIdentifier{}
+			checkRoundTrip(t, v.EncodeJSON(nil), func(d *json.Decoder) ([]Difference, error) {
+				err := w.DecodeJSON(d)
+				return v.Diff(w), err
+			}, &types.OperationIdentifier{})
+		}
+	})
+	t.Run("OperationStatus", func(t *testing.T) {
+		for i := 0; i < roundTrips; i++ {
+			v := r.OperationStatus()
+			w := OperationStatus{}
+			checkRoundTrip(t, v.EncodeJSON(nil), func(d *json.Decoder) ([]Difference, error) {
+				err := w.DecodeJSON(d)
+				return v.Diff(w), err
+			}, &types.OperationStatus{})
+		}
+	})
+	t.Run("PartialBlockIdentifier", func(t *testing.T) {
+		for i := 0; i < roundTrips; i++ {
+			v := r.PartialBlockIdentifier()
+			w := PartialBlockIdentifier{}
+			checkRoundTrip(t, v.EncodeJSON(nil), func(d *json.Decoder) ([]Difference, error) {
+				err := w.DecodeJSON(d)
+				return v.Diff(w), err
+			}, &types.PartialBlockIdentifier{})
+		}
+	})
+	t.Run("Peer", func(t *testing.T) {
+		for i := 0; i < roundTrips; i++ {
+			v := r.Peer()
+			w := Peer{}
+			checkRoundTrip(t, v.EncodeJSON(nil), func(d *json.Decoder) ([]Difference, error) {
+				err := w.DecodeJSON(d)
+				return v.Diff(w), err
+			}, &types.Peer{})
+		}
+	})
+	t.Run("PublicKey", func(t *testing.T) {
+		for i := 0; i < roundTrips; i++ {
+			v := r.PublicKey()
+			w := PublicKey{}
+			checkRoundTrip(t, v.EncodeJSON(nil), func(d *json.Decoder) ([]Difference, error) {
+				err := w.DecodeJSON(d)
+				return v.Diff(w), err
+			}, &types.PublicKey{})
+		}
+	})
+	t.Run("RelatedTransaction", func(t *testing.T) {
+		for i := 0; i < roundTrips; i++ {
+			v := r.RelatedTransaction()
+			w := RelatedTransaction{}
+			checkRoundTrip(t, v.EncodeJSON(nil), func(d *json.Decoder) ([]Difference, error) {
+				err := w.DecodeJSON(d)
+				return v.Diff(w), err
+			}, &types.RelatedTransaction{})
+		}
+	})
+	t.Run("SearchTransactionsRequest", func(t *testing.T) {
+		for i := 0; i < roundTrips; i++ {
+			n := r.NetworkIdentifier()
+			v := r.SearchTransactionsRequest()
+			m := NetworkIdentifier{}
+			w := SearchTransactionsRequest{}
+			checkRoundTrip(t, v.EncodeJSON(nil, EncodeNetworkForJSON(n)), func(d *json.Decoder) ([]Difference, error) {
+				err := w.DecodeJSON(d, &m)
+				return n.appendDiffs(v.Diff(w), "network_identifier", m), err
+			}, &types.SearchTransactionsRequest{})
+		}
+	})
+	t.Run("SearchTransactionsResponse", func(t *testing.T) {
+		for i := 0; i < roundTrips; i++ {
+			v := r.SearchTransactionsResponse()
+			w := SearchTransactionsResponse{}
+			checkRoundTrip(t, v.EncodeJSON(nil), func(d *json.Decoder) ([]Difference, error) {
+				err := w.DecodeJSON(d)
+				return v.Diff(w), err
+			}, &types.SearchTransactionsResponse{})
+		}
+	})
+	t.Run("Signature", func(t *testing.T) {
+		for i := 0; i < roundTrips; i++ {
+			v := r.Signature()
+			w := Signature{}
+			checkRoundTrip(t, v.EncodeJSON(nil), func(d *json.Decoder) ([]Difference, error) {
+				err := w.DecodeJSON(d)
+				return v.Diff(w), err
+			}, &types.Signature{})
+		}
+	})
+	t.Run("SigningPayload", func(t *testing.T) {
+		for i := 0; i < roundTrips; i++ {
+			v := r.SigningPayload()
+			w := SigningPayload{}
+			checkRoundTrip(t, v.EncodeJSON(nil), func(d *json.Decoder) ([]Difference, error) {
+				err := w.DecodeJSON(d)
+				return v.Diff(w), err
+			}, &types.SigningPayload{})
+		}
+	})
+	t.Run("SubAccountIdentifier", func(t *testing.T) {
+		for i := 0; i < roundTrips; i++ {
+			v := r.SubAccountIdentifier()
+			w := SubAccountIdentifier{}
+			checkRoundTrip(t, v.EncodeJSON(nil), func(d *json.Decoder) ([]Difference, error) {
+				err := w.DecodeJSON(d)
+				return v.Diff(w), err
+			}, &types.SubAccountIdentifier{})
+		}
+	})
+	t.Run("SubNetworkIdentifier", func(t *testing.T) {
+		for i := 0; i < roundTrips; i++ {
+			v := r.SubNetworkIdentifier()
+			w := SubNetworkIdentifier{}
+			checkRoundTrip(t, v.EncodeJSON(nil), func(d *json.Decoder) ([]Difference, error) {
+				err := w.DecodeJSON(d)
+				return v.Diff(w), err
+			}, &types.SubNetworkIdentifier{})
+		}
+	})
+	t.Run("SyncStatus", func(t *testing.T) {
+		for i := 0; i < roundTrips; i++ {
+			v := r.SyncStatus()
+			w := SyncStatus{}
+			checkRoundTrip(t, v.EncodeJSON(nil), func(d *json.Decoder) ([]Difference, error) {
+				err := w.DecodeJSON(d)
+				return v.Diff(w), err
+			}, &types.SyncStatus{})
+		}
+	})
+	t.Run("Transaction", func(t *testing.T) {
+		for i := 0; i < roundTrips; i++ {
+			v := r.Transaction()
+			w := Transaction{}
+			checkRoundTrip(t, v.EncodeJSON(nil), func(d *json.Decoder) ([]Difference, error) {
+				err := w.DecodeJSON(d)
+				return v.Diff(w), err
+			}, &types.Transaction{})
+		}
+	})
+	t.Run("TransactionIdentifier", func(t *testing.T) {
+		for i := 0; i < roundTrips; i++ {
+			v := r.TransactionIdentifier()
+			w := TransactionIdentifier{}
+			checkRoundTrip(t, v.EncodeJSON(nil), func(d *json.Decoder) ([]Difference, error) {
+				err := w.DecodeJSON(d)
+				return v.Diff(w), err
+			}, &types.TransactionIdentifier{})
+		}
+	})
+	t.Run("TransactionIdentifierResponse", func(t *testing.T) {
+		for i := 0; i < roundTrips; i++ {
+			v := r.TransactionIdentifierResponse()
+			w := TransactionIdentifierResponse{}
+			checkRoundTrip(t, v.EncodeJSON(nil), func(d *json.Decoder) ([]Difference, error) {
+				err := w.DecodeJSON(d)
+				return v.Diff(w), err
+			}, &types.TransactionIdentifierResponse{})
+		}
+	})
+	t.Run("Version", func(t *testing.T) {
+		for i := 0; i < roundTrips; i++ {
+			v := r.Version()
+			w := Version{}
+			checkRoundTrip(t, v.EncodeJSON(nil), func(d *json.Decoder) ([]Difference, error) {
+				err := w.DecodeJSON(d)
+				return v.Diff(w), err
+			}, &types.Version{})
+		}
+	})
+}
@@ -0,0 +1,228 @@
+// Copyright 2021 Coinbase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/tav/validate-rosetta/json"
+	"github.com/tav/validate-rosetta/retry"
+)
+
+// Defaults for the circuit breaker and concurrency limiter shared by all
+// Clients for the same base URL.
+const (
+	breakerCooldown  = 5 * time.Second
+	breakerThreshold = 5
+	limiterInitial   = 32
+	limiterMax       = 512
+	limiterMin       = 1
+)
+
+var (
+	backends   = map[string]*backend{}
+	backendsMu sync.Mutex // protects backends
+)
+
+// BackendStatus represents the status of the circuit breaker and concurrency
+// limiter for a base URL.
+type BackendStatus struct {
+	BaseURL  string
+	Breaker  retry.BreakerStatus
+	InFlight int
+	Limit    int
+}
+
+// BreakerError is returned for attempts that weren't made as the circuit
+// breaker for the base URL was open.
+type BreakerError struct {
+	BaseURL    string
+	RetryAfter time.Duration
+}
+
+func (e *BreakerError) Error() string {
+	return fmt.Sprintf("api: circuit breaker for %s is open", e.BaseURL)
+}
+
+// outcome specifies what the outcome of an attempt says about the health of
+// the backend.
+type outcome int
+
+const (
+	outcomeSuccess outcome = iota
+	outcomeFailure
+	// outcomeCancelled is for attempts that were cancelled after being sent,
+	// e.g. hedged requests that lost, which say nothing about the backend.
+	outcomeCancelled
+)
+
+type backend struct {
+	baseURL   string
+	breaker   *retry.Breaker
+	latencies map[string]*latencies
+	limiter   *retry.Limiter
+	mu        sync.Mutex // protects latencies, rate, rates, transport
+	rate      *retry.RateLimiter
+	rates     map[string]*retry.RateLimiter
+	transport *httpTransport
+}
+
+// acquire waits until an attempt can be made to the given endpoint of the
+// backend.
+//
+// The circuit breaker is checked first, so that attempts rejected by it don't
+// use up the rate limits shared with other callers.
+func (b *backend) acquire(ctx context.Context, endpoint string) error {
+	wait, ok := b.breaker.Allow()
+	if !ok {
+		return &BreakerError{
+			BaseURL:    b.baseURL,
+			RetryAfter: wait,
+		}
+	}
+	b.mu.Lock()
+	rate, erate := b.rate, b.rates[endpoint]
+	b.mu.Unlock()
+	// NOTE(tav): As the attempt is never made if we fail to acquire it, we
+	// don't count it as either a success or a failure.
+	if rate != nil {
+		if err := rate.Wait(ctx); err != nil {
+			b.breaker.Cancel()
+			return err
+		}
+	}
+	if erate != nil {
+		if err := erate.Wait(ctx); err != nil {
+			b.breaker.Cancel()
+			return err
+		}
+	}
+	if err := b.limiter.Acquire(ctx); err != nil {
+		b.breaker.Cancel()
+		return err
+	}
+	return nil
+}
+
+// release records the outcome of an attempt acquired with acquire.
+func (b *backend) release(err error) {
+	switch backendOutcome(err) {
+	case outcomeCancelled:
+		b.breaker.Cancel()
+		b.limiter.Cancel()
+	case outcomeFailure:
+		b.breaker.Record(true)
+		b.limiter.Release(true)
+	default:
+		b.breaker.Record(false)
+		b.limiter.Release(false)
+	}
+}
+
+func (b *backend) status() BackendStatus {
+	return BackendStatus{
+		BaseURL:  b.baseURL,
+		Breaker:  b.breaker.Status(),
+		InFlight: b.limiter.InFlight(),
+		Limit:    b.limiter.Limit(),
+	}
+}
+
+// BackendStatuses returns the status of the circuit breakers and concurrency
+// limiters for all base URLs used by Clients, ordered by base URL.
+func BackendStatuses() []BackendStatus {
+	backendsMu.Lock()
+	defer backendsMu.Unlock()
+	statuses := make([]BackendStatus, 0, len(backends))
+	for _, b := range backends {
+		statuses = append(statuses, b.status())
+	}
+	sort.Slice(statuses, func(i, j int) bool {
+		return statuses[i].BaseURL < statuses[j].BaseURL
+	})
+	return statuses
+}
+
+// SetRateLimit limits the rate of requests made by all Clients to the given
+// base URL, to the given number of requests per second on average, in bursts
+// of up to the given size. If endpoint is not empty, e.g. "/block", the limit
+// only applies to requests to that endpoint, in addition to any limit for the
+// base URL as a whole. A non-positive rate removes the limit.
+func SetRateLimit(baseURL string, endpoint string, perSecond float64, burst int) {
+	b := getBackend(baseURL)
+	var rate *retry.RateLimiter
+	if perSecond > 0 {
+		rate = retry.NewRateLimiter(perSecond, burst)
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if endpoint == "" {
+		b.rate = rate
+		return
+	}
+	if rate == nil {
+		delete(b.rates, endpoint)
+		return
+	}
+	if b.rates == nil {
+		b.rates = map[string]*retry.RateLimiter{}
+	}
+	b.rates[endpoint] = rate
+}
+
+// getBackend returns the backend for the given base URL, creating it if
+// necessary.
+func getBackend(baseURL string) *backend {
+	backendsMu.Lock()
+	defer backendsMu.Unlock()
+	b, ok := backends[baseURL]
+	if !ok {
+		b = &backend{
+			baseURL: baseURL,
+			breaker: retry.NewBreaker(breakerThreshold, breakerCooldown),
+			limiter: retry.NewLimiter(limiterInitial, limiterMin, limiterMax),
+		}
+		backends[baseURL] = b
+	}
+	return b
+}
+
+// backendOutcome returns the outcome of an attempt that resulted in the given
+// error. Errors that indicate that the backend is failing or overloaded are
+// failures, while errors from responses that the backend was able to produce,
+// e.g. Rosetta errors, are not.
+func backendOutcome(err error) outcome {
+	if err == nil {
+		return outcomeSuccess
+	}
+	if errors.Is(err, context.Canceled) {
+		return outcomeCancelled
+	}
+	switch err := err.(type) {
+	case *ClientError, *json.DecodeError, *json.LimitError:
+		return outcomeSuccess
+	case *StatusError:
+		if err.Retriable() {
+			return outcomeFailure
+		}
+		return outcomeSuccess
+	}
+	return outcomeFailure
+}
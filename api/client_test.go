@@ -0,0 +1,429 @@
+// Copyright 2021 Coinbase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/tav/validate-rosetta/retry"
+)
+
+func TestBackendAcquire(t *testing.T) {
+	b := &backend{
+		baseURL: "test",
+		breaker: retry.NewBreaker(1, time.Millisecond),
+		limiter: retry.NewLimiter(1, 1, 4),
+	}
+	b.breaker.Allow()
+	b.breaker.Record(true)
+	time.Sleep(2 * time.Millisecond)
+	if err := b.limiter.Acquire(context.Background()); err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if err := b.acquire(ctx, "/block"); err != context.Canceled {
+		t.Fatalf("Expected the cancelled context error, got: %v", err)
+	}
+	// NOTE(tav): The probe was never sent, so the breaker must stay half-open
+	// and allow another probe.
+	if state := b.breaker.Status().State; state != retry.HalfOpen {
+		t.Fatalf("Unexpected breaker state: got %s, want %s", state, retry.HalfOpen)
+	}
+	if _, ok := b.breaker.Allow(); !ok {
+		t.Fatalf("Expected the breaker to allow a probe")
+	}
+	// NOTE(tav): A probe that was sent and then cancelled neither closes the
+	// breaker nor grows the concurrency limit.
+	b.release(fmt.Errorf("api: request failed: %w", context.Canceled))
+	if state := b.breaker.Status().State; state != retry.HalfOpen {
+		t.Fatalf("Unexpected breaker state after cancel: got %s, want %s", state, retry.HalfOpen)
+	}
+	if limit, n := b.limiter.Limit(), b.limiter.InFlight(); limit != 1 || n != 0 {
+		t.Fatalf("Unexpected limiter after cancel: got limit %d with %d in flight", limit, n)
+	}
+	// NOTE(tav): Attempts rejected by an open breaker must not use up the
+	// rate limit.
+	b = &backend{
+		baseURL: "test",
+		breaker: retry.NewBreaker(1, time.Minute),
+		limiter: retry.NewLimiter(1, 1, 1),
+		rate:    retry.NewRateLimiter(1, 1),
+	}
+	b.breaker.Allow()
+	b.breaker.Record(true)
+	var berr *BreakerError
+	if err := b.acquire(context.Background(), "/block"); !errors.As(err, &berr) {
+		t.Fatalf("Expected a BreakerError, got: %v", err)
+	}
+	ctx, cancel = context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	if err := b.rate.Wait(ctx); err != nil {
+		t.Fatalf("Expected the rate limit token to be unused, got: %v", err)
+	}
+}
+
+func TestClientBreaker(t *testing.T) {
+	var hits int32
+	failing := int32(1)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&hits, 1)
+		if atomic.LoadInt32(&failing) == 1 {
+			w.WriteHeader(503)
+			return
+		}
+		w.Write(BlockResponse{}.EncodeJSON(nil))
+	}))
+	defer srv.Close()
+	client := NewClient(srv.URL)
+	client.SetNetwork(NetworkIdentifier{Blockchain: "test", Network: "test"})
+	client.backend.breaker = retry.NewBreaker(breakerThreshold, 20*time.Millisecond)
+	once := retry.MustBuild(retry.Policy{MaxIterations: 1})
+	state := func(want retry.BreakerState) {
+		t.Helper()
+		for _, status := range BackendStatuses() {
+			if status.BaseURL != srv.URL {
+				continue
+			}
+			if status.Breaker.State != want {
+				t.Fatalf("Unexpected breaker state: got %s, want %s", status.Breaker.State, want)
+			}
+			return
+		}
+		t.Fatalf("Missing backend status for %s", srv.URL)
+	}
+	err := client.Block(context.Background(), &BlockRequest{}, &BlockResponse{}, retry.Default)
+	if _, ok := err.CallError.(*StatusError); !ok {
+		t.Fatalf("Expected HTTP status error, got: %v", err)
+	}
+	state(retry.Open)
+	err = client.Block(context.Background(), &BlockRequest{}, &BlockResponse{}, once)
+	if berr, ok := err.CallError.(*BreakerError); !ok || berr.RetryAfter <= 0 {
+		t.Fatalf("Expected circuit breaker error, got: %v", err)
+	}
+	if n := atomic.LoadInt32(&hits); n != breakerThreshold {
+		t.Fatalf("Got %d requests to the server, want %d", n, breakerThreshold)
+	}
+	// NOTE(tav): The retry Handler waits for the breaker cooldown, so that the
+	// call succeeds once the server recovers.
+	atomic.StoreInt32(&failing, 0)
+	if err := client.Block(context.Background(), &BlockRequest{}, &BlockResponse{}, retry.Default); err != nil {
+		t.Fatalf("Unexpected error after recovery: %s", err)
+	}
+	state(retry.Closed)
+}
+
+func TestClientHedging(t *testing.T) {
+	var hits int32
+	cancelled := make(chan struct{})
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&hits, 1) == hedgeMinSamples+1 {
+			// NOTE(tav): The server only notices the cancellation once the
+			// request body has been read.
+			io.Copy(io.Discard, r.Body)
+			<-r.Context().Done()
+			close(cancelled)
+			return
+		}
+		w.Write(BlockResponse{}.EncodeJSON(nil))
+	}))
+	defer srv.Close()
+	client := NewClient(srv.URL)
+	client.SetNetwork(NetworkIdentifier{Blockchain: "test", Network: "test"})
+	var hedges []bool
+	client.SetHedging(90, func(endpoint string, won bool) {
+		hedges = append(hedges, won)
+	})
+	for i := 0; i <= hedgeMinSamples; i++ {
+		if err := client.Block(context.Background(), &BlockRequest{}, &BlockResponse{}, retry.Default); err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+	}
+	if len(hedges) != 1 || !hedges[0] {
+		t.Fatalf("Expected a single winning hedged request, got: %v", hedges)
+	}
+	select {
+	case <-cancelled:
+	case <-time.After(time.Second):
+		t.Fatalf("The losing request was not cancelled")
+	}
+}
+
+func TestClientHedgingFallback(t *testing.T) {
+	var hits int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch atomic.AddInt32(&hits, 1) {
+		case hedgeMinSamples + 1:
+			time.Sleep(30 * time.Millisecond)
+			w.WriteHeader(503)
+		case hedgeMinSamples + 2:
+			time.Sleep(60 * time.Millisecond)
+			w.Write(BlockResponse{}.EncodeJSON(nil))
+		default:
+			w.Write(BlockResponse{}.EncodeJSON(nil))
+		}
+	}))
+	defer srv.Close()
+	rt := &trackingTransport{}
+	SetTransport(srv.URL, Transport{RoundTripper: rt})
+	client := NewClient(srv.URL)
+	client.SetNetwork(NetworkIdentifier{Blockchain: "test", Network: "test"})
+	var hedges []bool
+	client.SetHedging(90, func(endpoint string, won bool) {
+		hedges = append(hedges, won)
+	})
+	for i := 0; i <= hedgeMinSamples; i++ {
+		if err := client.Block(context.Background(), &BlockRequest{}, &BlockResponse{}, retry.Default); err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+	}
+	if len(hedges) != 1 || !hedges[0] {
+		t.Fatalf("Expected a single winning hedged request, got: %v", hedges)
+	}
+	// NOTE(tav): The failed response of the first request must be closed, even
+	// though it was kept as a fallback when the hedged request won.
+	if n := atomic.LoadInt32(&rt.open); n != 0 {
+		t.Fatalf("Got %d unclosed response bodies, want 0", n)
+	}
+	if n := client.backend.limiter.InFlight(); n != 0 {
+		t.Fatalf("Got %d in-flight requests, want 0", n)
+	}
+}
+
+func TestClientHedgingLoser(t *testing.T) {
+	var hits int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch atomic.AddInt32(&hits, 1) {
+		case 2*hedgeMinSamples + 1:
+			time.Sleep(20 * time.Millisecond)
+		case 2*hedgeMinSamples + 2:
+			io.Copy(io.Discard, r.Body)
+			<-r.Context().Done()
+			return
+		}
+		w.Write(BlockResponse{}.EncodeJSON(nil))
+	}))
+	defer srv.Close()
+	client := NewClient(srv.URL)
+	client.SetNetwork(NetworkIdentifier{Blockchain: "test", Network: "test"})
+	for i := 0; i < hedgeMinSamples; i++ {
+		if err := client.Block(context.Background(), &BlockRequest{}, &BlockResponse{}, retry.Default); err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+	}
+	// NOTE(tav): Latencies are only observed while hedging is enabled.
+	if d := client.backend.latency("/block", 90); d != 0 {
+		t.Fatalf("Got a latency of %s with hedging disabled, want 0", d)
+	}
+	var hedges []bool
+	client.SetHedging(90, func(endpoint string, won bool) {
+		hedges = append(hedges, won)
+	})
+	for i := 0; i < hedgeMinSamples; i++ {
+		if err := client.Block(context.Background(), &BlockRequest{}, &BlockResponse{}, retry.Default); err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+	}
+	// NOTE(tav): The limit grows from 1 to ~3.55 after 5 successes, so that a
+	// single further success keeps it below 4, while two take it above.
+	client.backend.limiter = retry.NewLimiter(1, 1, 8)
+	for i := 0; i < 5; i++ {
+		client.backend.limiter.Acquire(context.Background())
+		client.backend.limiter.Release(false)
+	}
+	if err := client.Block(context.Background(), &BlockRequest{}, &BlockResponse{}, retry.Default); err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	if len(hedges) != 1 || hedges[0] {
+		t.Fatalf("Expected a single losing hedged request, got: %v", hedges)
+	}
+	deadline := time.Now().Add(time.Second)
+	for client.backend.limiter.InFlight() > 0 && time.Now().Before(deadline) {
+		time.Sleep(time.Millisecond)
+	}
+	if n := client.backend.limiter.InFlight(); n != 0 {
+		t.Fatalf("Got %d in-flight requests, want 0", n)
+	}
+	// NOTE(tav): The cancelled hedged request must not grow the limit.
+	if limit := client.backend.limiter.Limit(); limit != 3 {
+		t.Fatalf("Got a limit of %d after the hedged request lost, want 3", limit)
+	}
+}
+
+func TestClientRateLimit(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write(BlockResponse{}.EncodeJSON(nil))
+	}))
+	defer srv.Close()
+	SetRateLimit(srv.URL, "/block", 50, 1)
+	defer SetRateLimit(srv.URL, "/block", 0, 0)
+	start := time.Now()
+	for i := 0; i < 3; i++ {
+		// NOTE(tav): Separate Clients for the same base URL share the rate
+		// limit.
+		client := NewClient(srv.URL)
+		client.SetNetwork(NetworkIdentifier{Blockchain: "test", Network: "test"})
+		if err := client.Block(context.Background(), &BlockRequest{}, &BlockResponse{}, retry.Default); err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+	}
+	if elapsed := time.Since(start); elapsed < 35*time.Millisecond {
+		t.Fatalf("Rate limit was not enforced: 3 calls took %s", elapsed)
+	}
+	SetRateLimit(srv.URL, "/block", 1, 1)
+	client := NewClient(srv.URL)
+	client.SetNetwork(NetworkIdentifier{Blockchain: "test", Network: "test"})
+	if err := client.Block(context.Background(), &BlockRequest{}, &BlockResponse{}, retry.Default); err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Millisecond)
+	defer cancel()
+	err := client.Block(ctx, &BlockRequest{}, &BlockResponse{}, retry.Default)
+	if err == nil || err.CallError != context.DeadlineExceeded {
+		t.Fatalf("Expected the context deadline error, got: %v", err)
+	}
+}
+
+func TestClientRetry(t *testing.T) {
+	var responses []func(w http.ResponseWriter)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		respond := responses[0]
+		responses = responses[1:]
+		respond(w)
+	}))
+	defer srv.Close()
+	status := func(code int) func(w http.ResponseWriter) {
+		return func(w http.ResponseWriter) {
+			w.WriteHeader(code)
+		}
+	}
+	rosettaError := func(retriable bool) func(w http.ResponseWriter) {
+		return func(w http.ResponseWriter) {
+			w.WriteHeader(500)
+			w.Write(Error{Code: 12, Message: "failed", Retriable: retriable}.EncodeJSON(nil))
+		}
+	}
+	ok := func(w http.ResponseWriter) {
+		w.Write(BlockResponse{}.EncodeJSON(nil))
+	}
+	client := NewClient(srv.URL)
+	client.SetNetwork(NetworkIdentifier{Blockchain: "test", Network: "test"})
+	var attempts []retry.Attempt
+	client.SetAttemptHandler(func(endpoint string, a retry.Attempt) {
+		if endpoint != "/block" {
+			t.Errorf("Got attempt for unexpected endpoint %q", endpoint)
+		}
+		attempts = append(attempts, a)
+	})
+	for _, test := range []struct {
+		responses []func(w http.ResponseWriter)
+		attempts  int
+		code      int32
+		status    int
+	}{
+		{[]func(w http.ResponseWriter){status(503), rosettaError(true), ok}, 3, 0, 0},
+		{[]func(w http.ResponseWriter){rosettaError(false), ok}, 1, 12, 0},
+		{[]func(w http.ResponseWriter){status(404), ok}, 1, 0, 404},
+		{[]func(w http.ResponseWriter){status(429), status(502), status(500), status(503), status(504)}, 5, 0, 504},
+	} {
+		responses = test.responses
+		attempts = attempts[:0]
+		err := client.Block(context.Background(), &BlockRequest{}, &BlockResponse{}, retry.Default)
+		if len(attempts) != test.attempts {
+			t.Errorf("Got %d attempts, want %d", len(attempts), test.attempts)
+		}
+		switch {
+		case test.code != 0:
+			if err == nil || err.CallError != nil || err.RosettaError.Code != test.code {
+				t.Errorf("Expected Rosetta error %d, got: %v", test.code, err)
+			}
+		case test.status != 0:
+			serr, ok := err.CallError.(*StatusError)
+			if !ok || serr.StatusCode != test.status || serr.Endpoint != "/block" {
+				t.Errorf("Expected HTTP status %d error, got: %v", test.status, err)
+			}
+		case err != nil:
+			t.Errorf("Unexpected error: %s", err)
+		}
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	err := client.Block(ctx, &BlockRequest{}, &BlockResponse{}, retry.Default)
+	if err == nil || err.CallError != context.Canceled {
+		t.Errorf("Expected the cancelled context error, got: %v", err)
+	}
+}
+
+// trackingBody decrements the count of open bodies of its trackingTransport on
+// Close.
+type trackingBody struct {
+	io.ReadCloser
+	closed int32
+	t      *trackingTransport
+}
+
+func (b *trackingBody) Close() error {
+	if atomic.CompareAndSwapInt32(&b.closed, 0, 1) {
+		atomic.AddInt32(&b.t.open, -1)
+	}
+	return b.ReadCloser.Close()
+}
+
+// trackingTransport keeps count of the response bodies that are still open.
+type trackingTransport struct {
+	open int32
+}
+
+func (t *trackingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	hresp, err := http.DefaultTransport.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+	atomic.AddInt32(&t.open, 1)
+	hresp.Body = &trackingBody{ReadCloser: hresp.Body, t: t}
+	return hresp, nil
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	now := time.Date(2021, 7, 1, 12, 0, 0, 0, time.UTC)
+	for _, test := range []struct {
+		value string
+		want  time.Duration
+	}{
+		{"", 0},
+		{"5", 5 * time.Second},
+		{"-5", 0},
+		{"+5", 0},
+		{"soon", 0},
+		{"86400", maxRetryAfter},
+		{"9223372036854775807", maxRetryAfter},
+		{"99999999999999999999", 0},
+		{"Thu, 01 Jul 2021 12:00:30 GMT", 30 * time.Second},
+		{"Thu, 01 Jul 2021 11:59:00 GMT", 0},
+		{"Fri, 01 Jul 2022 12:00:00 GMT", maxRetryAfter},
+	} {
+		if got := parseRetryAfter(test.value, now); got != test.want {
+			t.Errorf("parseRetryAfter(%q) = %s, want %s", test.value, got, test.want)
+		}
+	}
+}
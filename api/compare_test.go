@@ -19,9 +19,11 @@ import (
 	"crypto/sha512"
 	"encoding/hex"
 	"encoding/json"
+	"strings"
 	"testing"
 
 	"github.com/coinbase/rosetta-sdk-go/types"
+	jsonpkg "github.com/tav/validate-rosetta/json"
 )
 
 var (
@@ -61,6 +63,40 @@ func BenchmarkEncodeSmallNew(b *testing.B) {
 	resultSlice = buf
 }
 
+func BenchmarkDecodeLargeOld(b *testing.B) {
+	enc, err := json.Marshal(createOldBlock())
+	if err != nil {
+		b.Fatalf("Failed to encode value to JSON: %s", err)
+	}
+	var val *types.Block
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		val = &types.Block{}
+		if err := json.Unmarshal(enc, val); err != nil {
+			b.Fatalf("Failed to decode value from JSON: %s", err)
+		}
+	}
+	resultBool = val.BlockIdentifier != nil
+}
+
+func BenchmarkDecodeLargeNew(b *testing.B) {
+	enc, err := json.Marshal(createOldBlock())
+	if err != nil {
+		b.Fatalf("Failed to encode value to JSON: %s", err)
+	}
+	dec := jsonpkg.NewDecoder()
+	val := &Block{}
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		dec.ResetFromBytes(enc)
+		val.Reset()
+		if err := val.DecodeJSON(dec); err != nil {
+			b.Fatalf("Failed to decode value from JSON: %s", err)
+		}
+	}
+	resultBool = val.BlockIdentifier.Index != 0
+}
+
 func BenchmarkEncodeLargeOld(b *testing.B) {
 	val := createOldBlock()
 	var buf *bytes.Buffer
@@ -181,6 +217,193 @@ func TestEncodeJSON(t *testing.T) {
 	}
 }
 
+func TestDecodeJSON(t *testing.T) {
+	enc, err := json.Marshal(createOldBlock())
+	if err != nil {
+		t.Fatalf("Failed to encode old value: %s", err)
+	}
+	dec := jsonpkg.NewDecoder()
+	dec.ResetFromBytes(enc)
+	val := &Block{}
+	if err := val.DecodeJSON(dec); err != nil {
+		t.Fatalf("Failed to decode value: %s", err)
+	}
+	if err := dec.End(); err != nil {
+		t.Fatalf("Failed to decode value: %s", err)
+	}
+	if !val.Equal(createNewBlock()) {
+		t.Errorf("Mismatching decoded value: %s", val.EncodeJSON(nil))
+	}
+	// Decoding into a used value must give the same result.
+	dec.ResetFromBytes(enc)
+	val.Reset()
+	if err := val.DecodeJSON(dec); err != nil {
+		t.Fatalf("Failed to decode value on reuse: %s", err)
+	}
+	if !val.Equal(createNewBlock()) {
+		t.Errorf("Mismatching decoded value on reuse: %s", val.EncodeJSON(nil))
+	}
+	for _, src := range []string{
+		`{"block_identifier":{"index":1,"hash":"a"}}`,
+		`{"block_identifier":{"index":1.5,"hash":"a"}}`,
+		`{"block_identifier":{"index":1,"hash":"a"},}`,
+		`[]`,
+	} {
+		dec.ResetFromBytes([]byte(src))
+		val.Reset()
+		if err := val.DecodeJSON(dec); err == nil {
+			t.Errorf("Expected error when decoding %s", src)
+		}
+	}
+}
+
+func TestDecodeJSONStrict(t *testing.T) {
+	src := []byte(`{"index": 1, "hash": "a", "extra": true}`)
+	dec := jsonpkg.NewDecoder()
+	dec.SetStrict(jsonpkg.StrictWarn)
+	dec.ResetFromBytes(src)
+	val := &BlockIdentifier{}
+	if err := val.DecodeJSON(dec); err != nil {
+		t.Fatalf("Failed to decode value: %s", err)
+	}
+	findings := dec.Findings()
+	if len(findings) != 1 || findings[0].Kind != jsonpkg.UnknownKey || findings[0].Err.Path != "extra" {
+		t.Errorf("Got unexpected findings: %v", findings)
+	}
+	src = []byte(`{"index": 1, "hash": null}`)
+	dec.ResetFromBytes(src)
+	pval := &PartialBlockIdentifier{}
+	if err := pval.DecodeJSON(dec); err != nil {
+		t.Fatalf("Failed to decode value: %s", err)
+	}
+	findings = dec.Findings()
+	if len(findings) != 1 || findings[0].Kind != jsonpkg.NullOptional {
+		t.Errorf("Got unexpected findings: %v", findings)
+	}
+	if pval.Hash.Set || !pval.Index.Set || pval.Index.Value != 1 {
+		t.Errorf("Mismatching decoded value: %s", pval.EncodeJSON(nil))
+	}
+	dec.SetStrict(jsonpkg.StrictError)
+	dec.ResetFromBytes(src)
+	if err := pval.DecodeJSON(dec); err == nil {
+		t.Errorf("Expected error when decoding %s strictly", src)
+	}
+}
+
+func TestDecodeMapObject(t *testing.T) {
+	want, err := MapObjectFrom(map[string]interface{}{
+		"fee":   json.Number("12345678901234567890"),
+		"memo":  "<tag>",
+		"ratio": 1.5,
+	})
+	if err != nil {
+		t.Fatalf("Failed to create MapObject: %s", err)
+	}
+	if string(want) != `{"fee":12345678901234567890,"memo":"<tag>","ratio":1.5}` {
+		t.Errorf("Got non-canonical MapObject: %s", want)
+	}
+	dec := jsonpkg.NewDecoder()
+	val := &AccountIdentifier{}
+	for _, md := range []string{
+		`{"fee":12345678901234567890,"memo":"<tag>","ratio":1.5}`,
+		`{"ratio": 15e-1, "memo": "\u003ctag\u003e", "fee": 1234567890123456789.0e1}`,
+	} {
+		dec.ResetFromBytes([]byte(`{"address": "a", "metadata": ` + md + `}`))
+		val.Reset()
+		if err := val.DecodeJSON(dec); err != nil {
+			t.Fatalf("Failed to decode value: %s", err)
+		}
+		if !val.Metadata.Equal(want) {
+			t.Errorf("Mismatching MapObject for %s: %s", md, val.Metadata)
+		}
+	}
+	dec.ResetFromBytes([]byte(`{"address": "a", "metadata": { }}`))
+	val.Reset()
+	if err := val.DecodeJSON(dec); err != nil {
+		t.Fatalf("Failed to decode value: %s", err)
+	}
+	if len(val.Metadata) != 0 {
+		t.Errorf("Expected empty MapObject, got: %s", val.Metadata)
+	}
+}
+
+func TestDiff(t *testing.T) {
+	a := createNewBlock()
+	if diffs := a.Diff(createNewBlock()); len(diffs) != 0 {
+		t.Errorf("Got differences for equal blocks:\n%s", DescribeDiffs(diffs, 0))
+	}
+	b := createNewBlock()
+	b.Timestamp++
+	b.Transactions[0].Operations[1].Amount.Value.Value = "2"
+	b.Transactions[0].Operations[2].Status = OptionalString("FAILURE")
+	b.Transactions[0].Operations[3].Account.Set = false
+	b.Transactions = append(b.Transactions, Transaction{
+		TransactionIdentifier: TransactionIdentifier{Hash: "ab"},
+	})
+	want := []Difference{
+		{A: "1624897505000", B: "1624897505001", Path: "timestamp"},
+		{A: `"1"`, B: `"2"`, Path: "transactions[0].operations[1].amount.value"},
+		{A: `"SUCCESS"`, B: `"FAILURE"`, Path: "transactions[0].operations[2].status"},
+		{A: `{"address":"AFmseVrdL9f9oyCzZefL9tG6UbviEH9ugK"}`, Path: "transactions[0].operations[3].account"},
+		{B: `{"operations":[],"transaction_identifier":{"hash":"ab"}}`, Path: "transactions[1]"},
+	}
+	diffs := a.Diff(b)
+	if len(diffs) != len(want) {
+		t.Fatalf("Got %d differences, want %d:\n%s", len(diffs), len(want), DescribeDiffs(diffs, 0))
+	}
+	for i, diff := range diffs {
+		if diff != want[i] {
+			t.Errorf("Mismatching difference: got %s, want %s", diff, want[i])
+		}
+	}
+	desc := DescribeDiffs(diffs, 2)
+	if !strings.HasSuffix(desc, "\n... and 3 more") || strings.Count(desc, "\n") != 2 {
+		t.Errorf("Unexpected description of differences:\n%s", desc)
+	}
+}
+
+func TestKeys(t *testing.T) {
+	dec := jsonpkg.NewDecoder()
+	decode := func(src string) AccountIdentifier {
+		dec.ResetFromBytes([]byte(src))
+		v := AccountIdentifier{}
+		if err := v.DecodeJSON(dec); err != nil {
+			t.Fatalf("Failed to decode %s: %s", src, err)
+		}
+		return v
+	}
+	a := decode(`{"address":"a","metadata":{"x":1,"y":"z"}}`)
+	b := decode(`{"metadata":{"y":"z","x":1.0},"address":"a"}`)
+	c := decode(`{"address":"a","metadata":{"x":1,"y":"z"},"sub_account":{"address":""}}`)
+	d := decode(`{"address":"a\u0001"}`)
+	if a.Key() != b.Key() {
+		t.Errorf("Mismatching keys for equal accounts: %q != %q", a.Key(), b.Key())
+	}
+	if a.Key() == c.Key() {
+		t.Errorf("Got the same key for accounts with and without a sub-account")
+	}
+	if a.Key() == d.Key() {
+		t.Errorf("Got the same key for different accounts")
+	}
+	btc := Currency{Decimals: 8, Symbol: "BTC"}
+	if btc.Key() == (Currency{Decimals: 7, Symbol: "BTC"}).Key() {
+		t.Errorf("Got the same key for currencies with different decimals")
+	}
+	balances := map[BalanceKey]int{
+		NewBalanceKey(a, btc): 1,
+	}
+	buf := make([]byte, 0, 64)
+	allocs := testing.AllocsPerRun(100, func() {
+		buf = AppendBalanceKey(buf[:0], b, btc)
+		if balances[BalanceKey(buf)] != 1 {
+			t.Fatalf("Failed to look up balance by key")
+		}
+	})
+	if allocs != 0 {
+		t.Errorf("Got %v allocations when looking up a balance by key", allocs)
+	}
+}
+
 func createNewAccountBalanceRequest() AccountBalanceRequest {
 	md, _ := MapObjectFrom(map[string]interface{}{
 		"contract": "0200000000000000000000000000000000000000",
@@ -0,0 +1,1645 @@
+// DO NOT EDIT.
+// Generated by running: go run cmd/genapi/genapi.go
+
+// Copyright 2021 Coinbase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build go1.18
+// +build go1.18
+
+package api
+
+import (
+	"testing"
+
+	"github.com/tav/validate-rosetta/json"
+)
+
+func FuzzAccountBalanceRequest(f *testing.F) {
+	r := newTestRand()
+	for i := 0; i < fuzzSeeds; i++ {
+		f.Add(r.AccountBalanceRequest().EncodeJSON(nil, EncodeNetworkForJSON(r.NetworkIdentifier())))
+	}
+	f.Fuzz(func(t *testing.T, data []byte) {
+		n := NetworkIdentifier{}
+		v := AccountBalanceRequest{}
+		enc, ok := fuzzDecode(data, func(d *json.Decoder) ([]byte, error) {
+			if err := v.DecodeJSON(d, &n); err != nil {
+				return nil, err
+			}
+			return v.EncodeJSON(nil, EncodeNetworkForJSON(n)), nil
+		})
+		if !ok {
+			return
+		}
+		m := NetworkIdentifier{}
+		w := AccountBalanceRequest{}
+		checkRoundTrip(t, enc, func(d *json.Decoder) ([]Difference, error) {
+			err := w.DecodeJSON(d, &m)
+			return n.appendDiffs(v.Diff(w), "network_identifier", m), err
+		}, nil)
+	})
+}
+
+func FuzzAccountBalanceResponse(f *testing.F) {
+	r := newTestRand()
+	for i := 0; i < fuzzSeeds; i++ {
+		f.Add(r.AccountBalanceResponse().EncodeJSON(nil))
+	}
+	f.Fuzz(func(t *testing.T, data []byte) {
+		v := AccountBalanceResponse{}
+		enc, ok := fuzzDecode(data, func(d *json.Decoder) ([]byte, error) {
+			if err := v.DecodeJSON(d); err != nil {
+				return nil, err
+			}
+			return v.EncodeJSON(nil), nil
+		})
+		if !ok {
+			return
+		}
+		w := AccountBalanceResponse{}
+		checkRoundTrip(t, enc, func(d *json.Decoder) ([]Difference, error) {
+			err := w.DecodeJSON(d)
+			return v.Diff(w), err
+		}, nil)
+	})
+}
+
+func FuzzAccountCoinsRequest(f *testing.F) {
+	r := newTestRand()
+	for i := 0; i < fuzzSeeds; i++ {
+		f.Add(r.AccountCoinsRequest().EncodeJSON(nil, EncodeNetworkForJSON(r.NetworkIdentifier())))
+	}
+	f.Fuzz(func(t *testing.T, data []byte) {
+		n := NetworkIdentifier{}
+		v := AccountCoinsRequest{}
+		enc, ok := fuzzDecode(data, func(d *json.Decoder) ([]byte, error) {
+			if err := v.DecodeJSON(d, &n); err != nil {
+				return nil, err
+			}
+			return v.EncodeJSON(nil, EncodeNetworkForJSON(n)), nil
+		})
+		if !ok {
+			return
+		}
+		m := NetworkIdentifier{}
+		w := AccountCoinsRequest{}
+		checkRoundTrip(t, enc, func(d *json.Decoder) ([]Difference, error) {
+			err := w.DecodeJSON(d, &m)
+			return n.appendDiffs(v.Diff(w), "network_identifier", m), err
+		}, nil)
+	})
+}
+
+func FuzzAccountCoinsResponse(f *testing.F) {
+	r := newTestRand()
+	for i := 0; i < fuzzSeeds; i++ {
+		f.Add(r.AccountCoinsResponse().EncodeJSON(nil))
+	}
+	f.Fuzz(func(t *testing.T, data []byte) {
+		v := AccountCoinsResponse{}
+		enc, ok := fuzzDecode(data, func(d *json.Decoder) ([]byte, error) {
+			if err := v.DecodeJSON(d); err != nil {
+				return nil, err
+			}
+			return v.EncodeJSON(nil), nil
+		})
+		if !ok {
+			return
+		}
+		w := AccountCoinsResponse{}
+		checkRoundTrip(t, enc, func(d *json.Decoder) ([]Difference, error) {
+			err := w.DecodeJSON(d)
+			return v.Diff(w), err
+		}, nil)
+	})
+}
+
+func FuzzAccountIdentifier(f *testing.F) {
+	r := newTestRand()
+	for i := 0; i < fuzzSeeds; i++ {
+		f.Add(r.AccountIdentifier().EncodeJSON(nil))
+	}
+	f.Fuzz(func(t *testing.T, data []byte) {
+		v := AccountIdentifier{}
+		enc, ok := fuzzDecode(data, func(d *json.Decoder) ([]byte, error) {
+			if err := v.DecodeJSON(d); err != nil {
+				return nil, err
+			}
+			return v.EncodeJSON(nil), nil
+		})
+		if !ok {
+			return
+		}
+		w := AccountIdentifier{}
+		checkRoundTrip(t, enc, func(d *json.Decoder) ([]Difference, error) {
+			err := w.DecodeJSON(d)
+			return v.Diff(w), err
+		}, nil)
+	})
+}
+
+func FuzzAllow(f *testing.F) {
+	r := newTestRand()
+	for i := 0; i < fuzzSeeds; i++ {
+		f.Add(r.Allow().EncodeJSON(nil))
+	}
+	f.Fuzz(func(t *testing.T, data []byte) {
+		v := Allow{}
+		enc, ok := fuzzDecode(data, func(d *json.Decoder) ([]byte, error) {
+			if err := v.DecodeJSON(d); err != nil {
+				return nil, err
+			}
+			return v.EncodeJSON(nil), nil
+		})
+		if !ok {
+			return
+		}
+		w := Allow{}
+		checkRoundTrip(t, enc, func(d *json.Decoder) ([]Difference, error) {
+			err := w.DecodeJSON(d)
+			return v.Diff(w), err
+		}, nil)
+	})
+}
+
+func FuzzAmount(f *testing.F) {
+	r := newTestRand()
+	for i := 0; i < fuzzSeeds; i++ {
+		f.Add(r.Amount().EncodeJSON(nil))
+	}
+	f.Fuzz(func(t *testing.T, data []byte) {
+		v := Amount{}
+		enc, ok := fuzzDecode(data, func(d *json.Decoder) ([]byte, error) {
+			if err := v.DecodeJSON(d); err != nil {
+				return nil, err
+			}
+			return v.EncodeJSON(nil), nil
+		})
+		if !ok {
+			return
+		}
+		w := Amount{}
+		checkRoundTrip(t, enc, func(d *json.Decoder) ([]Difference, error) {
+			err := w.DecodeJSON(d)
+			return v.Diff(w), err
+		}, nil)
+	})
+}
+
+func FuzzBalanceExemption(f *testing.F) {
+	r := newTestRand()
+	for i := 0; i < fuzzSeeds; i++ {
+		f.Add(r.BalanceExemption().EncodeJSON(nil))
+	}
+	f.Fuzz(func(t *testing.T, data []byte) {
+		v := BalanceExemption{}
+		enc, ok := fuzzDecode(data, func(d *json.Decoder) ([]byte, error) {
+			if err := v.DecodeJSON(d); err != nil {
+				return nil, err
+			}
+			return v.EncodeJSON(nil), nil
+		})
+		if !ok {
+			return
+		}
+		w := BalanceExemption{}
+		checkRoundTrip(t, enc, func(d *json.Decoder) ([]Difference, error) {
+			err := w.DecodeJSON(d)
+			return v.Diff(w), err
+		}, nil)
+	})
+}
+
+func FuzzBlock(f *testing.F) {
+	r := newTestRand()
+	for i := 0; i < fuzzSeeds; i++ {
+		f.Add(r.Block().EncodeJSON(nil))
+	}
+	f.Fuzz(func(t *testing.T, data []byte) {
+		v := Block{}
+		enc, ok := fuzzDecode(data, func(d *json.Decoder) ([]byte, error) {
+			if err := v.DecodeJSON(d); err != nil {
+				return nil, err
+			}
+			return v.EncodeJSON(nil), nil
+		})
+		if !ok {
+			return
+		}
+		w := Block{}
+		checkRoundTrip(t, enc, func(d *json.Decoder) ([]Difference, error) {
+			err := w.DecodeJSON(d)
+			return v.Diff(w), err
+		}, nil)
+	})
+}
+
+func FuzzBlockEvent(f *testing.F) {
+	r := newTestRand()
+	for i := 0; i < fuzzSeeds; i++ {
+		f.Add(r.BlockEvent().EncodeJSON(nil))
+	}
+	f.Fuzz(func(t *testing.T, data []byte) {
+		v := BlockEvent{}
+		enc, ok := fuzzDecode(data, func(d *json.Decoder) ([]byte, error) {
+			if err := v.DecodeJSON(d); err != nil {
+				return nil, err
+			}
+			return v.EncodeJSON(nil), nil
+		})
+		if !ok {
+			return
+		}
+		w := BlockEvent{}
+		checkRoundTrip(t, enc, func(d *json.Decoder) ([]Difference, error) {
+			err := w.DecodeJSON(d)
+			return v.Diff(w), err
+		}, nil)
+	})
+}
+
+func FuzzBlockIdentifier(f *testing.F) {
+	r := newTestRand()
+	for i := 0; i < fuzzSeeds; i++ {
+		f.Add(r.BlockIdentifier().EncodeJSON(nil))
+	}
+	f.Fuzz(func(t *testing.T, data []byte) {
+		v := BlockIdentifier{}
+		enc, ok := fuzzDecode(data, func(d *json.Decoder) ([]byte, error) {
+			if err := v.DecodeJSON(d); err != nil {
+				return nil, err
+			}
+			return v.EncodeJSON(nil), nil
+		})
+		if !ok {
+			return
+		}
+		w := BlockIdentifier{}
+		checkRoundTrip(t, enc, func(d *json.Decoder) ([]Difference, error) {
+			err := w.DecodeJSON(d)
+			return v.Diff(w), err
+		}, nil)
+	})
+}
+
+func FuzzBlockRequest(f *testing.F) {
+	r := newTestRand()
+	for i := 0; i < fuzzSeeds; i++ {
+		f.Add(r.BlockRequest().EncodeJSON(nil, EncodeNetworkForJSON(r.NetworkIdentifier())))
+	}
+	f.Fuzz(func(t *testing.T, data []byte) {
+		n := NetworkIdentifier{}
+		v := BlockRequest{}
+		enc, ok := fuzzDecode(data, func(d *json.Decoder) ([]byte, error) {
+			if err := v.DecodeJSON(d, &n); err != nil {
+				return nil, err
+			}
+			return v.EncodeJSON(nil, EncodeNetworkForJSON(n)), nil
+		})
+		if !ok {
+			return
+		}
+		m := NetworkIdentifier{}
+		w := BlockRequest{}
+		checkRoundTrip(t, enc, func(d *json.Decoder) ([]Difference, error) {
+			err := w.DecodeJSON(d, &m)
+			return n.appendDiffs(v.Diff(w), "network_identifier", m), err
+		}, nil)
+	})
+}
+
+func FuzzBlockResponse(f *testing.F) {
+	r := newTestRand()
+	for i := 0; i < fuzzSeeds; i++ {
+		f.Add(r.BlockResponse().EncodeJSON(nil))
+	}
+	f.Fuzz(func(t *testing.T, data []byte) {
+		v := BlockResponse{}
+		enc, ok := fuzzDecode(data, func(d *json.Decoder) ([]byte, error) {
+			if err := v.DecodeJSON(d); err != nil {
+				return nil, err
+			}
+			return v.EncodeJSON(nil), nil
+		})
+		if !ok {
+			return
+		}
+		w := BlockResponse{}
+		checkRoundTrip(t, enc, func(d *json.Decoder) ([]Difference, error) {
+			err := w.DecodeJSON(d)
+			return v.Diff(w), err
+		}, nil)
+	})
+}
+
+func FuzzBlockTransaction(f *testing.F) {
+	r := newTestRand()
+	for i := 0; i < fuzzSeeds; i++ {
+		f.Add(r.BlockTransaction().EncodeJSON(nil))
+	}
+	f.Fuzz(func(t *testing.T, data []byte) {
+		v := BlockTransaction{}
+		enc, ok := fuzzDecode(data, func(d *json.Decoder) ([]byte, error) {
+			if err := v.DecodeJSON(d); err != nil {
+				return nil, err
+			}
+			return v.EncodeJSON(nil), nil
+		})
+		if !ok {
+			return
+		}
+		w := BlockTransaction{}
+		checkRoundTrip(t, enc, func(d *json.Decoder) ([]Difference, error) {
+			err := w.DecodeJSON(d)
+			return v.Diff(w), err
+		}, nil)
+	})
+}
+
+func FuzzBlockTransactionRequest(f *testing.F) {
+	r := newTestRand()
+	for i := 0; i < fuzzSeeds; i++ {
+		f.Add(r.BlockTransactionRequest().EncodeJSON(nil, EncodeNetworkForJSON(r.NetworkIdentifier())))
+	}
+	f.Fuzz(func(t *testing.T, data []byte) {
+		n := NetworkIdentifier{}
+		v := BlockTransactionRequest{}
+		enc, ok := fuzzDecode(data, func(d *json.Decoder) ([]byte, error) {
+			if err := v.DecodeJSON(d, &n); err != nil {
+				return nil, err
+			}
+			return v.EncodeJSON(nil, EncodeNetworkForJSON(n)), nil
+		})
+		if !ok {
+			return
+		}
+		m := NetworkIdentifier{}
+		w := BlockTransactionRequest{}
+		checkRoundTrip(t, enc, func(d *json.Decoder) ([]Difference, error) {
+			err := w.DecodeJSON(d, &m)
+			return n.appendDiffs(v.Diff(w), "network_identifier", m), err
+		}, nil)
+	})
+}
+
+func FuzzBlockTransactionResponse(f *testing.F) {
+	r := newTestRand()
+	for i := 0; i < fuzzSeeds; i++ {
+		f.Add(r.BlockTransactionResponse().EncodeJSON(nil))
+	}
+	f.Fuzz(func(t *testing.T, data []byte) {
+		v := BlockTransactionResponse{}
+		enc, ok := fuzzDecode(data, func(d *json.Decoder) ([]byte, error) {
+			if err := v.DecodeJSON(d); err != nil {
+				return nil, err
+			}
+			return v.EncodeJSON(nil), nil
+		})
+		if !ok {
+			return
+		}
+		w := BlockTransactionResponse{}
+		checkRoundTrip(t, enc, func(d *json.Decoder) ([]Difference, error) {
+			err := w.DecodeJSON(d)
+			return v.Diff(w), err
+		}, nil)
+	})
+}
+
+func FuzzCallRequest(f *testing.F) {
+	r := newTestRand()
+	for i := 0; i < fuzzSeeds; i++ {
+		f.Add(r.CallRequest().EncodeJSON(nil, EncodeNetworkForJSON(r.NetworkIdentifier())))
+	}
+	f.Fuzz(func(t *testing.T, data []byte) {
+		n := NetworkIdentifier{}
+		v := CallRequest{}
+		enc, ok := fuzzDecode(data, func(d *json.Decoder) ([]byte, error) {
+			if err := v.DecodeJSON(d, &n); err != nil {
+				return nil, err
+			}
+			return v.EncodeJSON(nil, EncodeNetworkForJSON(n)), nil
+		})
+		if !ok {
+			return
+		}
+		m := NetworkIdentifier{}
+		w := CallRequest{}
+		checkRoundTrip(t, enc, func(d *json.Decoder) ([]Difference, error) {
+			err := w.DecodeJSON(d, &m)
+			return n.appendDiffs(v.Diff(w), "network_identifier", m), err
+		}, nil)
+	})
+}
+
+func FuzzCallResponse(f *testing.F) {
+	r := newTestRand()
+	for i := 0; i < fuzzSeeds; i++ {
+		f.Add(r.CallResponse().EncodeJSON(nil))
+	}
+	f.Fuzz(func(t *testing.T, data []byte) {
+		v := CallResponse{}
+		enc, ok := fuzzDecode(data, func(d *json.Decoder) ([]byte, error) {
+			if err := v.DecodeJSON(d); err != nil {
+				return nil, err
+			}
+			return v.EncodeJSON(nil), nil
+		})
+		if !ok {
+			return
+		}
+		w := CallResponse{}
+		checkRoundTrip(t, enc, func(d *json.Decoder) ([]Difference, error) {
+			err := w.DecodeJSON(d)
+			return v.Diff(w), err
+		}, nil)
+	})
+}
+
+func FuzzCoin(f *testing.F) {
+	r := newTestRand()
+	for i := 0; i < fuzzSeeds; i++ {
+		f.Add(r.Coin().EncodeJSON(nil))
+	}
+	f.Fuzz(func(t *testing.T, data []byte) {
+		v := Coin{}
+		enc, ok := fuzzDecode(data, func(d *json.Decoder) ([]byte, error) {
+			if err := v.DecodeJSON(d); err != nil {
+				return nil, err
+			}
+			return v.EncodeJSON(nil), nil
+		})
+		if !ok {
+			return
+		}
+		w := Coin{}
+		checkRoundTrip(t, enc, func(d *json.Decoder) ([]Difference, error) {
+			err := w.DecodeJSON(d)
+			return v.Diff(w), err
+		}, nil)
+	})
+}
+
+func FuzzCoinChange(f *testing.F) {
+	r := newTestRand()
+	for i := 0; i < fuzzSeeds; i++ {
+		f.Add(r.CoinChange().EncodeJSON(nil))
+	}
+	f.Fuzz(func(t *testing.T, data []byte) {
+		v := CoinChange{}
+		enc, ok := fuzzDecode(data, func(d *json.Decoder) ([]byte, error) {
+			if err := v.DecodeJSON(d); err != nil {
+				return nil, err
+			}
+			return v.EncodeJSON(nil), nil
+		})
+		if !ok {
+			return
+		}
+		w := CoinChange{}
+		checkRoundTrip(t, enc, func(d *json.Decoder) ([]Difference, error) {
+			err := w.DecodeJSON(d)
+			return v.Diff(w), err
+		}, nil)
+	})
+}
+
+func FuzzCoinIdentifier(f *testing.F) {
+	r := newTestRand()
+	for i := 0; i < fuzzSeeds; i++ {
+		f.Add(r.CoinIdentifier().EncodeJSON(nil))
+	}
+	f.Fuzz(func(t *testing.T, data []byte) {
+		v := CoinIdentifier{}
+		enc, ok := fuzzDecode(data, func(d *json.Decoder) ([]byte, error) {
+			if err := v.DecodeJSON(d); err != nil {
+				return nil, err
+			}
+			return v.EncodeJSON(nil), nil
+		})
+		if !ok {
+			return
+		}
+		w := CoinIdentifier{}
+		checkRoundTrip(t, enc, func(d *json.Decoder) ([]Difference, error) {
+			err := w.DecodeJSON(d)
+			return v.Diff(w), err
+		}, nil)
+	})
+}
+
+func FuzzConstructionCombineRequest(f *testing.F) {
+	r := newTestRand()
+	for i := 0; i < fuzzSeeds; i++ {
+		f.Add(r.ConstructionCombineRequest().EncodeJSON(nil, EncodeNetworkForJSON(r.NetworkIdentifier())))
+	}
+	f.Fuzz(func(t *testing.T, data []byte) {
+		n := NetworkIdentifier{}
+		v := ConstructionCombineRequest{}
+		enc, ok := fuzzDecode(data, func(d *json.Decoder) ([]byte, error) {
+			if err := v.DecodeJSON(d, &n); err != nil {
+				return nil, err
+			}
+			return v.EncodeJSON(nil, EncodeNetworkForJSON(n)), nil
+		})
+		if !ok {
+			return
+		}
+		m := NetworkIdentifier{}
+		w := ConstructionCombineRequest{}
+		checkRoundTrip(t, enc, func(d *json.Decoder) ([]Difference, error) {
+			err := w.DecodeJSON(d, &m)
+			return n.appendDiffs(v.Diff(w), "network_identifier", m), err
+		}, nil)
+	})
+}
+
+func FuzzConstructionCombineResponse(f *testing.F) {
+	r := newTestRand()
+	for i := 0; i < fuzzSeeds; i++ {
+		f.Add(r.ConstructionCombineResponse().EncodeJSON(nil))
+	}
+	f.Fuzz(func(t *testing.T, data []byte) {
+		v := ConstructionCombineResponse{}
+		enc, ok := fuzzDecode(data, func(d *json.Decoder) ([]byte, error) {
+			if err := v.DecodeJSON(d); err != nil {
+				return nil, err
+			}
+			return v.EncodeJSON(nil), nil
+		})
+		if !ok {
+			return
+		}
+		w := ConstructionCombineResponse{}
+		checkRoundTrip(t, enc, func(d *json.Decoder) ([]Difference, error) {
+			err := w.DecodeJSON(d)
+			return v.Diff(w), err
+		}, nil)
+	})
+}
+
+func FuzzConstructionDeriveRequest(f *testing.F) {
+	r := newTestRand()
+	for i := 0; i < fuzzSeeds; i++ {
+		f.Add(r.ConstructionDeriveRequest().EncodeJSON(nil, EncodeNetworkForJSON(r.NetworkIdentifier())))
+	}
+	f.Fuzz(func(t *testing.T, data []byte) {
+		n := NetworkIdentifier{}
+		v := ConstructionDeriveRequest{}
+		enc, ok := fuzzDecode(data, func(d *json.Decoder) ([]byte, error) {
+			if err := v.DecodeJSON(d, &n); err != nil {
+				return nil, err
+			}
+			return v.EncodeJSON(nil, EncodeNetworkForJSON(n)), nil
+		})
+		if !ok {
+			return
+		}
+		m := NetworkIdentifier{}
+		w := ConstructionDeriveRequest{}
+		checkRoundTrip(t, enc, func(d *json.Decoder) ([]Difference, error) {
+			err := w.DecodeJSON(d, &m)
+			return n.appendDiffs(v.Diff(w), "network_identifier", m), err
+		}, nil)
+	})
+}
+
+func FuzzConstructionDeriveResponse(f *testing.F) {
+	r := newTestRand()
+	for i := 0; i < fuzzSeeds; i++ {
+		f.Add(r.ConstructionDeriveResponse().EncodeJSON(nil))
+	}
+	f.Fuzz(func(t *testing.T, data []byte) {
+		v := ConstructionDeriveResponse{}
+		enc, ok := fuzzDecode(data, func(d *json.Decoder) ([]byte, error) {
+			if err := v.DecodeJSON(d); err != nil {
+				return nil, err
+			}
+			return v.EncodeJSON(nil), nil
+		})
+		if !ok {
+			return
+		}
+		w := ConstructionDeriveResponse{}
+		checkRoundTrip(t, enc, func(d *json.Decoder) ([]Difference, error) {
+			err := w.DecodeJSON(d)
+			return v.Diff(w), err
+		}, nil)
+	})
+}
+
+func FuzzConstructionHashRequest(f *testing.F) {
+	r := newTestRand()
+	for i := 0; i < fuzzSeeds; i++ {
+		f.Add(r.ConstructionHashRequest().EncodeJSON(nil, EncodeNetworkForJSON(r.NetworkIdentifier())))
+	}
+	f.Fuzz(func(t *testing.T, data []byte) {
+		n := NetworkIdentifier{}
+		v := ConstructionHashRequest{}
+		enc, ok := fuzzDecode(data, func(d *json.Decoder) ([]byte, error) {
+			if err := v.DecodeJSON(d, &n); err != nil {
+				return nil, err
+			}
+			return v.EncodeJSON(nil, EncodeNetworkForJSON(n)), nil
+		})
+		if !ok {
+			return
+		}
+		m := NetworkIdentifier{}
+		w := ConstructionHashRequest{}
+		checkRoundTrip(t, enc, func(d *json.Decoder) ([]Difference, error) {
+			err := w.DecodeJSON(d, &m)
+			return n.appendDiffs(v.Diff(w), "network_identifier", m), err
+		}, nil)
+	})
+}
+
+func FuzzConstructionMetadataRequest(f *testing.F) {
+	r := newTestRand()
+	for i := 0; i < fuzzSeeds; i++ {
+		f.Add(r.ConstructionMetadataRequest().EncodeJSON(nil, EncodeNetworkForJSON(r.NetworkIdentifier())))
+	}
+	f.Fuzz(func(t *testing.T, data []byte) {
+		n := NetworkIdentifier{}
+		v := ConstructionMetadataRequest{}
+		enc, ok := fuzzDecode(data, func(d *json.Decoder) ([]byte, error) {
+			if err := v.DecodeJSON(d, &n); err != nil {
+				return nil, err
+			}
+			return v.EncodeJSON(nil, EncodeNetworkForJSON(n)), nil
+		})
+		if !ok {
+			return
+		}
+		m := NetworkIdentifier{}
+		w := ConstructionMetadataRequest{}
+		checkRoundTrip(t, enc, func(d *json.Decoder) ([]Difference, error) {
+			err := w.DecodeJSON(d, &m)
+			return n.appendDiffs(v.Diff(w), "network_identifier", m), err
+		}, nil)
+	})
+}
+
+func FuzzConstructionMetadataResponse(f *testing.F) {
+	r := newTestRand()
+	for i := 0; i < fuzzSeeds; i++ {
+		f.Add(r.ConstructionMetadataResponse().EncodeJSON(nil))
+	}
+	f.Fuzz(func(t *testing.T, data []byte) {
+		v := ConstructionMetadataResponse{}
+		enc, ok := fuzzDecode(data, func(d *json.Decoder) ([]byte, error) {
+			if err := v.DecodeJSON(d); err != nil {
+				return nil, err
+			}
+			return v.EncodeJSON(nil), nil
+		})
+		if !ok {
+			return
+		}
+		w := ConstructionMetadataResponse{}
+		checkRoundTrip(t, enc, func(d *json.Decoder) ([]Difference, error) {
+			err := w.DecodeJSON(d)
+			return v.Diff(w), err
+		}, nil)
+	})
+}
+
+func FuzzConstructionParseRequest(f *testing.F) {
+	r := newTestRand()
+	for i := 0; i < fuzzSeeds; i++ {
+		f.Add(r.ConstructionParseRequest().EncodeJSON(nil, EncodeNetworkForJSON(r.NetworkIdentifier())))
+	}
+	f.Fuzz(func(t *testing.T, data []byte) {
+		n := NetworkIdentifier{}
+		v := ConstructionParseRequest{}
+		enc, ok := fuzzDecode(data, func(d *json.Decoder) ([]byte, error) {
+			if err := v.DecodeJSON(d, &n); err != nil {
+				return nil, err
+			}
+			return v.EncodeJSON(nil, EncodeNetworkForJSON(n)), nil
+		})
+		if !ok {
+			return
+		}
+		m := NetworkIdentifier{}
+		w := ConstructionParseRequest{}
+		checkRoundTrip(t, enc, func(d *json.Decoder) ([]Difference, error) {
+			err := w.DecodeJSON(d, &m)
+			return n.appendDiffs(v.Diff(w), "network_identifier", m), err
+		}, nil)
+	})
+}
+
+func FuzzConstructionParseResponse(f *testing.F) {
+	r := newTestRand()
+	for i := 0; i < fuzzSeeds; i++ {
+		f.Add(r.ConstructionParseResponse().EncodeJSON(nil))
+	}
+	f.Fuzz(func(t *testing.T, data []byte) {
+		v := ConstructionParseResponse{}
+		enc, ok := fuzzDecode(data, func(d *json.Decoder) ([]byte, error) {
+			if err := v.DecodeJSON(d); err != nil {
+				return nil, err
+			}
+			return v.EncodeJSON(nil), nil
+		})
+		if !ok {
+			return
+		}
+		w := ConstructionParseResponse{}
+		checkRoundTrip(t, enc, func(d *json.Decoder) ([]Difference, error) {
+			err := w.DecodeJSON(d)
+			return v.Diff(w), err
+		}, nil)
+	})
+}
+
+func FuzzConstructionPayloadsRequest(f *testing.F) {
+	r := newTestRand()
+	for i := 0; i < fuzzSeeds; i++ {
+		f.Add(r.ConstructionPayloadsRequest().EncodeJSON(nil, EncodeNetworkForJSON(r.NetworkIdentifier())))
+	}
+	f.Fuzz(func(t *testing.T, data []byte) {
+		n := NetworkIdentifier{}
+		v := ConstructionPayloadsRequest{}
+		enc, ok := fuzzDecode(data, func(d *json.Decoder) ([]byte, error) {
+			if err := v.DecodeJSON(d, &n); err != nil {
+				return nil, err
+			}
+			return v.EncodeJSON(nil, EncodeNetworkForJSON(n)), nil
+		})
+		if !ok {
+			return
+		}
+		m := NetworkIdentifier{}
+		w := ConstructionPayloadsRequest{}
+		checkRoundTrip(t, enc, func(d *json.Decoder) ([]Difference, error) {
+			err := w.DecodeJSON(d, &m)
+			return n.appendDiffs(v.Diff(w), "network_identifier", m), err
+		}, nil)
+	})
+}
+
+func FuzzConstructionPayloadsResponse(f *testing.F) {
+	r := newTestRand()
+	for i := 0; i < fuzzSeeds; i++ {
+		f.Add(r.ConstructionPayloadsResponse().EncodeJSON(nil))
+	}
+	f.Fuzz(func(t *testing.T, data []byte) {
+		v := ConstructionPayloadsResponse{}
+		enc, ok := fuzzDecode(data, func(d *json.Decoder) ([]byte, error) {
+			if err := v.DecodeJSON(d); err != nil {
+				return nil, err
+			}
+			return v.EncodeJSON(nil), nil
+		})
+		if !ok {
+			return
+		}
+		w := ConstructionPayloadsResponse{}
+		checkRoundTrip(t, enc, func(d *json.Decoder) ([]Difference, error) {
+			err := w.DecodeJSON(d)
+			return v.Diff(w), err
+		}, nil)
+	})
+}
+
+func FuzzConstructionPreprocessRequest(f *testing.F) {
+	r := newTestRand()
+	for i := 0; i < fuzzSeeds; i++ {
+		f.Add(r.ConstructionPreprocessRequest().EncodeJSON(nil, EncodeNetworkForJSON(r.NetworkIdentifier())))
+	}
+	f.Fuzz(func(t *testing.T, data []byte) {
+		n := NetworkIdentifier{}
+		v := ConstructionPreprocessRequest{}
+		enc, ok := fuzzDecode(data, func(d *json.Decoder) ([]byte, error) {
+			if err := v.DecodeJSON(d, &n); err != nil {
+				return nil, err
+			}
+			return v.EncodeJSON(nil, EncodeNetworkForJSON(n)), nil
+		})
+		if !ok {
+			return
+		}
+		m := NetworkIdentifier{}
+		w := ConstructionPreprocessRequest{}
+		checkRoundTrip(t, enc, func(d *json.Decoder) ([]Difference, error) {
+			err := w.DecodeJSON(d, &m)
+			return n.appendDiffs(v.Diff(w), "network_identifier", m), err
+		}, nil)
+	})
+}
+
+func FuzzConstructionPreprocessResponse(f *testing.F) {
+	r := newTestRand()
+	for i := 0; i < fuzzSeeds; i++ {
+		f.Add(r.ConstructionPreprocessResponse().EncodeJSON(nil))
+	}
+	f.Fuzz(func(t *testing.T, data []byte) {
+		v := ConstructionPreprocessResponse{}
+		enc, ok := fuzzDecode(data, func(d *json.Decoder) ([]byte, error) {
+			if err := v.DecodeJSON(d); err != nil {
+				return nil, err
+			}
+			return v.EncodeJSON(nil), nil
+		})
+		if !ok {
+			return
+		}
+		w := ConstructionPreprocessResponse{}
+		checkRoundTrip(t, enc, func(d *json.Decoder) ([]Difference, error) {
+			err := w.DecodeJSON(d)
+			return v.Diff(w), err
+		}, nil)
+	})
+}
+
+func FuzzConstructionSubmitRequest(f *testing.F) {
+	r := newTestRand()
+	for i := 0; i < fuzzSeeds; i++ {
+		f.Add(r.ConstructionSubmitRequest().EncodeJSON(nil, EncodeNetworkForJSON(r.NetworkIdentifier())))
+	}
+	f.Fuzz(func(t *testing.T, data []byte) {
+		n := NetworkIdentifier{}
+		v := ConstructionSubmitRequest{}
+		enc, ok := fuzzDecode(data, func(d *json.Decoder) ([]byte, error) {
+			if err := v.DecodeJSON(d, &n); err != nil {
+				return nil, err
+			}
+			return v.EncodeJSON(nil, EncodeNetworkForJSON(n)), nil
+		})
+		if !ok {
+			return
+		}
+		m := NetworkIdentifier{}
+		w := ConstructionSubmitRequest{}
+		checkRoundTrip(t, enc, func(d *json.Decoder) ([]Difference, error) {
+			err := w.DecodeJSON(d, &m)
+			return n.appendDiffs(v.Diff(w), "network_identifier", m), err
+		}, nil)
+	})
+}
+
+func FuzzCurrency(f *testing.F) {
+	r := newTestRand()
+	for i := 0; i < fuzzSeeds; i++ {
+		f.Add(r.Currency().EncodeJSON(nil))
+	}
+	f.Fuzz(func(t *testing.T, data []byte) {
+		v := Currency{}
+		enc, ok := fuzzDecode(data, func(d *json.Decoder) ([]byte, error) {
+			if err := v.DecodeJSON(d); err != nil {
+				return nil, err
+			}
+			return v.EncodeJSON(nil), nil
+		})
+		if !ok {
+			return
+		}
+		w := Currency{}
+		checkRoundTrip(t, enc, func(d *json.Decoder) ([]Difference, error) {
+			err := w.DecodeJSON(d)
+			return v.Diff(w), err
+		}, nil)
+	})
+}
+
+func FuzzError(f *testing.F) {
+	r := newTestRand()
+	for i := 0; i < fuzzSeeds; i++ {
+		f.Add(r.Error().EncodeJSON(nil))
+	}
+	f.Fuzz(func(t *testing.T, data []byte) {
+		v := Error{}
+		enc, ok := fuzzDecode(data, func(d *json.Decoder) ([]byte, error) {
+			if err := v.DecodeJSON(d); err != nil {
+				return nil, err
+			}
+			return v.EncodeJSON(nil), nil
+		})
+		if !ok {
+			return
+		}
+		w := Error{}
+		checkRoundTrip(t, enc, func(d *json.Decoder) ([]Difference, error) {
+			err := w.DecodeJSON(d)
+			return v.Diff(w), err
+		}, nil)
+	})
+}
+
+func FuzzEventsBlocksRequest(f *testing.F) {
+	r := newTestRand()
+	for i := 0; i < fuzzSeeds; i++ {
+		f.Add(r.EventsBlocksRequest().EncodeJSON(nil, EncodeNetworkForJSON(r.NetworkIdentifier())))
+	}
+	f.Fuzz(func(t *testing.T, data []byte) {
+		n := NetworkIdentifier{}
+		v := EventsBlocksRequest{}
+		enc, ok := fuzzDecode(data, func(d *json.Decoder) ([]byte, error) {
+			if err := v.DecodeJSON(d, &n); err != nil {
+				return nil, err
+			}
+			return v.EncodeJSON(nil, EncodeNetworkForJSON(n)), nil
+		})
+		if !ok {
+			return
+		}
+		m := NetworkIdentifier{}
+		w := EventsBlocksRequest{}
+		checkRoundTrip(t, enc, func(d *json.Decoder) ([]Difference, error) {
+			err := w.DecodeJSON(d, &m)
+			return n.appendDiffs(v.Diff(w), "network_identifier", m), err
+		}, nil)
+	})
+}
+
+func FuzzEventsBlocksResponse(f *testing.F) {
+	r := newTestRand()
+	for i := 0; i < fuzzSeeds; i++ {
+		f.Add(r.EventsBlocksResponse().EncodeJSON(nil))
+	}
+	f.Fuzz(func(t *testing.T, data []byte) {
+		v := EventsBlocksResponse{}
+		enc, ok := fuzzDecode(data, func(d *json.Decoder) ([]byte, error) {
+			if err := v.DecodeJSON(d); err != nil {
+				return nil, err
+			}
+			return v.EncodeJSON(nil), nil
+		})
+		if !ok {
+			return
+		}
+		w := EventsBlocksResponse{}
+		checkRoundTrip(t, enc, func(d *json.Decoder) ([]Difference, error) {
+			err := w.DecodeJSON(d)
+			return v.Diff(w), err
+		}, nil)
+	})
+}
+
+func FuzzMempoolResponse(f *testing.F) {
+	r := newTestRand()
+	for i := 0; i < fuzzSeeds; i++ {
+		f.Add(r.MempoolResponse().EncodeJSON(nil))
+	}
+	f.Fuzz(func(t *testing.T, data []byte) {
+		v := MempoolResponse{}
+		enc, ok := fuzzDecode(data, func(d *json.Decoder) ([]byte, error) {
+			if err := v.DecodeJSON(d); err != nil {
+				return nil, err
+			}
+			return v.EncodeJSON(nil), nil
+		})
+		if !ok {
+			return
+		}
+		w := MempoolResponse{}
+		checkRoundTrip(t, enc, func(d *json.Decoder) ([]Difference, error) {
+			err := w.DecodeJSON(d)
+			return v.Diff(w), err
+		}, nil)
+	})
+}
+
+func FuzzMempoolTransactionRequest(f *testing.F) {
+	r := newTestRand()
+	for i := 0; i < fuzzSeeds; i++ {
+		f.Add(r.MempoolTransactionRequest().EncodeJSON(nil, EncodeNetworkForJSON(r.NetworkIdentifier())))
+	}
+	f.Fuzz(func(t *testing.T, data []byte) {
+		n := NetworkIdentifier{}
+		v := MempoolTransactionRequest{}
+		enc, ok := fuzzDecode(data, func(d *json.Decoder) ([]byte, error) {
+			if err := v.DecodeJSON(d, &n); err != nil {
+				return nil, err
+			}
+			return v.EncodeJSON(nil, EncodeNetworkForJSON(n)), nil
+		})
+		if !ok {
+			return
+		}
+		m := NetworkIdentifier{}
+		w := MempoolTransactionRequest{}
+		checkRoundTrip(t, enc, func(d *json.Decoder) ([]Difference, error) {
+			err := w.DecodeJSON(d, &m)
+			return n.appendDiffs(v.Diff(w), "network_identifier", m), err
+		}, nil)
+	})
+}
+
+func FuzzMempoolTransactionResponse(f *testing.F) {
+	r := newTestRand()
+	for i := 0; i < fuzzSeeds; i++ {
+		f.Add(r.MempoolTransactionResponse().EncodeJSON(nil))
+	}
+	f.Fuzz(func(t *testing.T, data []byte) {
+		v := MempoolTransactionResponse{}
+		enc, ok := fuzzDecode(data, func(d *json.Decoder) ([]byte, error) {
+			if err := v.DecodeJSON(d); err != nil {
+				return nil, err
+			}
+			return v.EncodeJSON(nil), nil
+		})
+		if !ok {
+			return
+		}
+		w := MempoolTransactionResponse{}
+		checkRoundTrip(t, enc, func(d *json.Decoder) ([]Difference, error) {
+			err := w.DecodeJSON(d)
+			return v.Diff(w), err
+		}, nil)
+	})
+}
+
+func FuzzMetadataRequest(f *testing.F) {
+	r := newTestRand()
+	for i := 0; i < fuzzSeeds; i++ {
+		f.Add(r.MetadataRequest().EncodeJSON(nil))
+	}
+	f.Fuzz(func(t *testing.T, data []byte) {
+		v := MetadataRequest{}
+		enc, ok := fuzzDecode(data, func(d *json.Decoder) ([]byte, error) {
+			if err := v.DecodeJSON(d); err != nil {
+				return nil, err
+			}
+			return v.EncodeJSON(nil), nil
+		})
+		if !ok {
+			return
+		}
+		w := MetadataRequest{}
+		checkRoundTrip(t, enc, func(d *json.Decoder) ([]Difference, error) {
+			err := w.DecodeJSON(d)
+			return v.Diff(w), err
+		}, nil)
+	})
+}
+
+func FuzzNetworkIdentifier(f *testing.F) {
+	r := newTestRand()
+	for i := 0; i < fuzzSeeds; i++ {
+		f.Add(r.NetworkIdentifier().EncodeJSON(nil))
+	}
+	f.Fuzz(func(t *testing.T, data []byte) {
+		v := NetworkIdentifier{}
+		enc, ok := fuzzDecode(data, func(d *json.Decoder) ([]byte, error) {
+			if err := v.DecodeJSON(d); err != nil {
+				return nil, err
+			}
+			return v.EncodeJSON(nil), nil
+		})
+		if !ok {
+			return
+		}
+		w := NetworkIdentifier{}
+		checkRoundTrip(t, enc, func(d *json.Decoder) ([]Difference, error) {
+			err := w.DecodeJSON(d)
+			return v.Diff(w), err
+		}, nil)
+	})
+}
+
+func FuzzNetworkListResponse(f *testing.F) {
+	r := newTestRand()
+	for i := 0; i < fuzzSeeds; i++ {
+		f.Add(r.NetworkListResponse().EncodeJSON(nil))
+	}
+	f.Fuzz(func(t *testing.T, data []byte) {
+		v := NetworkListResponse{}
+		enc, ok := fuzzDecode(data, func(d *json.Decoder) ([]byte, error) {
+			if err := v.DecodeJSON(d); err != nil {
+				return nil, err
+			}
+			return v.EncodeJSON(nil), nil
+		})
+		if !ok {
+			return
+		}
+		w := NetworkListResponse{}
+		checkRoundTrip(t, enc, func(d *json.Decoder) ([]Difference, error) {
+			err := w.DecodeJSON(d)
+			return v.Diff(w), err
+		}, nil)
+	})
+}
+
+func FuzzNetworkOptionsResponse(f *testing.F) {
+	r := newTestRand()
+	for i := 0; i < fuzzSeeds; i++ {
+		f.Add(r.NetworkOptionsResponse().EncodeJSON(nil))
+	}
+	f.Fuzz(func(t *testing.T, data []byte) {
+		v := NetworkOptionsResponse{}
+		enc, ok := fuzzDecode(data, func(d *json.Decoder) ([]byte, error) {
+			if err := v.DecodeJSON(d); err != nil {
+				return nil, err
+			}
+			return v.EncodeJSON(nil), nil
+		})
+		if !ok {
+			return
+		}
+		w := NetworkOptionsResponse{}
+		checkRoundTrip(t, enc, func(d *json.Decoder) ([]Difference, error) {
+			err := w.DecodeJSON(d)
+			return v.Diff(w), err
+		}, nil)
+	})
+}
+
+func FuzzNetworkRequest(f *testing.F) {
+	r := newTestRand()
+	for i := 0; i < fuzzSeeds; i++ {
+		f.Add(r.NetworkRequest().EncodeJSON(nil, EncodeNetworkForJSON(r.NetworkIdentifier())))
+	}
+	f.Fuzz(func(t *testing.T, data []byte) {
+		n := NetworkIdentifier{}
+		v := NetworkRequest{}
+		enc, ok := fuzzDecode(data, func(d *json.Decoder) ([]byte, error) {
+			if err := v.DecodeJSON(d, &n); err != nil {
+				return nil, err
+			}
+			return v.EncodeJSON(nil, EncodeNetworkForJSON(n)), nil
+		})
+		if !ok {
+			return
+		}
+		m := NetworkIdentifier{}
+		w := NetworkRequest{}
+		checkRoundTrip(t, enc, func(d *json.Decoder) ([]Difference, error) {
+			err := w.DecodeJSON(d, &m)
+			return n.appendDiffs(v.Diff(w), "network_identifier", m), err
+		}, nil)
+	})
+}
+
+func FuzzNetworkStatusResponse(f *testing.F) {
+	r := newTestRand()
+	for i := 0; i < fuzzSeeds; i++ {
+		f.Add(r.NetworkStatusResponse().EncodeJSON(nil))
+	}
+	f.Fuzz(func(t *testing.T, data []byte) {
+		v := NetworkStatusResponse{}
+		enc, ok := fuzzDecode(data, func(d *json.Decoder) ([]byte, error) {
+			if err := v.DecodeJSON(d); err != nil {
+				return nil, err
+			}
+			return v.EncodeJSON(nil), nil
+		})
+		if !ok {
+			return
+		}
+		w := NetworkStatusResponse{}
+		checkRoundTrip(t, enc, func(d *json.Decoder) ([]Difference, error) {
+			err := w.DecodeJSON(d)
+			return v.Diff(w), err
+		}, nil)
+	})
+}
+
+func FuzzOperation(f *testing.F) {
+	r := newTestRand()
+	for i := 0; i < fuzzSeeds; i++ {
+		f.Add(r.Operation().EncodeJSON(nil))
+	}
+	f.Fuzz(func(t *testing.T, data []byte) {
+		v := Operation{}
+		enc, ok := fuzzDecode(data, func(d *json.Decoder) ([]byte, error) {
+			if err := v.DecodeJSON(d); err != nil {
+				return nil, err
+			}
+			return v.EncodeJSON(nil), nil
+		})
+		if !ok {
+			return
+		}
+		w := Operation{}
+		checkRoundTrip(t, enc, func(d *json.Decoder) ([]Difference, error) {
+			err := w.DecodeJSON(d)
+			return v.Diff(w), err
+		}, nil)
+	})
+}
+
+func FuzzOperationIdentifier(f *testing.F) {
+	r := newTestRand()
+	for i := 0; i < fuzzSeeds; i++ {
+		f.Add(r.OperationIdentifier().EncodeJSON(nil))
+	}
+	f.Fuzz(func(t *testing.T, data []byte) {
+		v := OperationIdentifier{}
+		enc, ok := fuzzDecode(data, func(d *json.Decoder) ([]byte, error) {
+			if err := v.DecodeJSON(d); err != nil {
+				return nil, err
+			}
+			return v.EncodeJSON(nil), nil
+		})
+		if !ok {
+			return
+		}
+		w := OperationIdentifier{}
+		checkRoundTrip(t, enc, func(d *json.Decoder) ([]Difference, error) {
+			err := w.DecodeJSON(d)
+			return v.Diff(w), err
+		}, nil)
+	})
+}
+
+func FuzzOperationStatus(f *testing.F) {
+	r := newTestRand()
+	for i := 0; i < fuzzSeeds; i++ {
+		f.Add(r.OperationStatus().EncodeJSON(nil))
+	}
+	f.Fuzz(func(t *testing.T, data []byte) {
+		v := OperationStatus{}
+		enc, ok := fuzzDecode(data, func(d *json.Decoder) ([]byte, error) {
+			if err := v.DecodeJSON(d); err != nil {
+				return nil, err
+			}
+			return v.EncodeJSON(nil), nil
+		})
+		if !ok {
+			return
+		}
+		w := OperationStatus{}
+		checkRoundTrip(t, enc, func(d *json.Decoder) ([]Difference, error) {
+			err := w.DecodeJSON(d)
+			return v.Diff(w), err
+		}, nil)
+	})
+}
+
+func FuzzPartialBlockIdentifier(f *testing.F) {
+	r := newTestRand()
+	for i := 0; i < fuzzSeeds; i++ {
+		f.Add(r.PartialBlockIdentifier().EncodeJSON(nil))
+	}
+	f.Fuzz(func(t *testing.T, data []byte) {
+		v := PartialBlockIdentifier{}
+		enc, ok := fuzzDecode(data, func(d *json.Decoder) ([]byte, error) {
+			if err := v.DecodeJSON(d); err != nil {
+				return nil, err
+			}
+			return v.EncodeJSON(nil), nil
+		})
+		if !ok {
+			return
+		}
+		w := PartialBlockIdentifier{}
+		checkRoundTrip(t, enc, func(d *json.Decoder) ([]Difference, error) {
+			err := w.DecodeJSON(d)
+			return v.Diff(w), err
+		}, nil)
+	})
+}
+
+func FuzzPeer(f *testing.F) {
+	r := newTestRand()
+	for i := 0; i < fuzzSeeds; i++ {
+		f.Add(r.Peer().EncodeJSON(nil))
+	}
+	f.Fuzz(func(t *testing.T, data []byte) {
+		v := Peer{}
+		enc, ok := fuzzDecode(data, func(d *json.Decoder) ([]byte, error) {
+			if err := v.DecodeJSON(d); err != nil {
+				return nil, err
+			}
+			return v.EncodeJSON(nil), nil
+		})
+		if !ok {
+			return
+		}
+		w := Peer{}
+		checkRoundTrip(t, enc, func(d *json.Decoder) ([]Difference, error) {
+			err := w.DecodeJSON(d)
+			return v.Diff(w), err
+		}, nil)
+	})
+}
+
+func FuzzPublicKey(f *testing.F) {
+	r := newTestRand()
+	for i := 0; i < fuzzSeeds; i++ {
+		f.Add(r.PublicKey().EncodeJSON(nil))
+	}
+	f.Fuzz(func(t *testing.T, data []byte) {
+		v := PublicKey{}
+		enc, ok := fuzzDecode(data, func(d *json.Decoder) ([]byte, error) {
+			if err := v.DecodeJSON(d); err != nil {
+				return nil, err
+			}
+			return v.EncodeJSON(nil), nil
+		})
+		if !ok {
+			return
+		}
+		w := PublicKey{}
+		checkRoundTrip(t, enc, func(d *json.Decoder) ([]Difference, error) {
+			err := w.DecodeJSON(d)
+			return v.Diff(w), err
+		}, nil)
+	})
+}
+
+func FuzzRelatedTransaction(f *testing.F) {
+	r := newTestRand()
+	for i := 0; i < fuzzSeeds; i++ {
+		f.Add(r.RelatedTransaction().EncodeJSON(nil))
+	}
+	f.Fuzz(func(t *testing.T, data []byte) {
+		v := RelatedTransaction{}
+		enc, ok := fuzzDecode(data, func(d *json.Decoder) ([]byte, error) {
+			if err := v.DecodeJSON(d); err != nil {
+				return nil, err
+			}
+			return v.EncodeJSON(nil), nil
+		})
+		if !ok {
+			return
+		}
+		w := RelatedTransaction{}
+		checkRoundTrip(t, enc, func(d *json.Decoder) ([]Difference, error) {
+			err := w.DecodeJSON(d)
+			return v.Diff(w), err
+		}, nil)
+	})
+}
+
+func FuzzSearchTransactionsRequest(f *testing.F) {
+	r := newTestRand()
+	for i := 0; i < fuzzSeeds; i++ {
+		f.Add(r.SearchTransactionsRequest().EncodeJSON(nil, EncodeNetworkForJSON(r.NetworkIdentifier())))
+	}
+	f.Fuzz(func(t *testing.T, data []byte) {
+		n := NetworkIdentifier{}
+		v := SearchTransactionsRequest{}
+		enc, ok := fuzzDecode(data, func(d *json.Decoder) ([]byte, error) {
+			if err := v.DecodeJSON(d, &n); err != nil {
+				return nil, err
+			}
+			return v.EncodeJSON(nil, EncodeNetworkForJSON(n)), nil
+		})
+		if !ok {
+			return
+		}
+		m := NetworkIdentifier{}
+		w := SearchTransactionsRequest{}
+		checkRoundTrip(t, enc, func(d *json.Decoder) ([]Difference, error) {
+			err := w.DecodeJSON(d, &m)
+			return n.appendDiffs(v.Diff(w), "network_identifier", m), err
+		}, nil)
+	})
+}
+
+func FuzzSearchTransactionsResponse(f *testing.F) {
+	r := newTestRand()
+	for i := 0; i < fuzzSeeds; i++ {
+		f.Add(r.SearchTransactionsResponse().EncodeJSON(nil))
+	}
+	f.Fuzz(func(t *testing.T, data []byte) {
+		v := SearchTransactionsResponse{}
+		enc, ok := fuzzDecode(data, func(d *json.Decoder) ([]byte, error) {
+			if err := v.DecodeJSON(d); err != nil {
+				return nil, err
+			}
+			return v.EncodeJSON(nil), nil
+		})
+		if !ok {
+			return
+		}
+		w := SearchTransactionsResponse{}
+		checkRoundTrip(t, enc, func(d *json.Decoder) ([]Difference, error) {
+			err := w.DecodeJSON(d)
+			return v.Diff(w), err
+		}, nil)
+	})
+}
+
+func FuzzSignature(f *testing.F) {
+	r := newTestRand()
+	for i := 0; i < fuzzSeeds; i++ {
+		f.Add(r.Signature().EncodeJSON(nil))
+	}
+	f.Fuzz(func(t *testing.T, data []byte) {
+		v := Signature{}
+		enc, ok := fuzzDecode(data, func(d *json.Decoder) ([]byte, error) {
+			if err := v.DecodeJSON(d); err != nil {
+				return nil, err
+			}
+			return v.EncodeJSON(nil), nil
+		})
+		if !ok {
+			return
+		}
+		w := Signature{}
+		checkRoundTrip(t, enc, func(d *json.Decoder) ([]Difference, error) {
+			err := w.DecodeJSON(d)
+			return v.Diff(w), err
+		}, nil)
+	})
+}
+
+func FuzzSigningPayload(f *testing.F) {
+	r := newTestRand()
+	for i := 0; i < fuzzSeeds; i++ {
+		f.Add(r.SigningPayload().EncodeJSON(nil))
+	}
+	f.Fuzz(func(t *testing.T, data []byte) {
+		v := SigningPayload{}
+		enc, ok := fuzzDecode(data, func(d *json.Decoder) ([]byte, error) {
+			if err := v.DecodeJSON(d); err != nil {
+				return nil, err
+			}
+			return v.EncodeJSON(nil), nil
+		})
+		if !ok {
+			return
+		}
+		w := SigningPayload{}
+		checkRoundTrip(t, enc, func(d *json.Decoder) ([]Difference, error) {
+			err := w.DecodeJSON(d)
+			return v.Diff(w), err
+		}, nil)
+	})
+}
+
+func FuzzSubAccountIdentifier(f *testing.F) {
+	r := newTestRand()
+	for i := 0; i < fuzzSeeds; i++ {
+		f.Add(r.SubAccountIdentifier().EncodeJSON(nil))
+	}
+	f.Fuzz(func(t *testing.T, data []byte) {
+		v := SubAccountIdentifier{}
+		enc, ok := fuzzDecode(data, func(d *json.Decoder) ([]byte, error) {
+			if err := v.DecodeJSON(d); err != nil {
+				return nil, err
+			}
+			return v.EncodeJSON(nil), nil
+		})
+		if !ok {
+			return
+		}
+		w := SubAccountIdentifier{}
+		checkRoundTrip(t, enc, func(d *json.Decoder) ([]Difference, error) {
+			err := w.DecodeJSON(d)
+			return v.Diff(w), err
+		}, nil)
+	})
+}
+
+func FuzzSubNetworkIdentifier(f *testing.F) {
+	r := newTestRand()
+	for i := 0; i < fuzzSeeds; i++ {
+		f.Add(r.SubNetworkIdentifier().EncodeJSON(nil))
+	}
+	f.Fuzz(func(t *testing.T, data []byte) {
+		v := SubNetworkIdentifier{}
+		enc, ok := fuzzDecode(data, func(d *json.Decoder) ([]byte, error) {
+			if err := v.DecodeJSON(d); err != nil {
+				return nil, err
+			}
+			return v.EncodeJSON(nil), nil
+		})
+		if !ok {
+			return
+		}
+		w := SubNetworkIdentifier{}
+		checkRoundTrip(t, enc, func(d *json.Decoder) ([]Difference, error) {
+			err := w.DecodeJSON(d)
+			return v.Diff(w), err
+		}, nil)
+	})
+}
+
+func FuzzSyncStatus(f *testing.F) {
+	r := newTestRand()
+	for i := 0; i < fuzzSeeds; i++ {
+		f.Add(r.SyncStatus().EncodeJSON(nil))
+	}
+	f.Fuzz(func(t *testing.T, data []byte) {
+		v := SyncStatus{}
+		enc, ok := fuzzDecode(data, func(d *json.Decoder) ([]byte, error) {
+			if err := v.DecodeJSON(d); err != nil {
+				return nil, err
+			}
+			return v.EncodeJSON(nil), nil
+		})
+		if !ok {
+			return
+		}
+		w := SyncStatus{}
+		checkRoundTrip(t, enc, func(d *json.Decoder) ([]Difference, error) {
+			err := w.DecodeJSON(d)
+			return v.Diff(w), err
+		}, nil)
+	})
+}
+
+func FuzzTransaction(f *testing.F) {
+	r := newTestRand()
+	for i := 0; i < fuzzSeeds; i++ {
+		f.Add(r.Transaction().EncodeJSON(nil))
+	}
+	f.Fuzz(func(t *testing.T, data []byte) {
+		v := Transaction{}
+		enc, ok := fuzzDecode(data, func(d *json.Decoder) ([]byte, error) {
+			if err := v.DecodeJSON(d); err != nil {
+				return nil, err
+			}
+			return v.EncodeJSON(nil), nil
+		})
+		if !ok {
+			return
+		}
+		w := Transaction{}
+		checkRoundTrip(t, enc, func(d *json.Decoder) ([]Difference, error) {
+			err := w.DecodeJSON(d)
+			return v.Diff(w), err
+		}, nil)
+	})
+}
+
+func FuzzTransactionIdentifier(f *testing.F) {
+	r := newTestRand()
+	for i := 0; i < fuzzSeeds; i++ {
+		f.Add(r.TransactionIdentifier().EncodeJSON(nil))
+	}
+	f.Fuzz(func(t *testing.T, data []byte) {
+		v := TransactionIdentifier{}
+		enc, ok := fuzzDecode(data, func(d *json.Decoder) ([]byte, error) {
+			if err := v.DecodeJSON(d); err != nil {
+				return nil, err
+			}
+			return v.EncodeJSON(nil), nil
+		})
+		if !ok {
+			return
+		}
+		w := TransactionIdentifier{}
+		checkRoundTrip(t, enc, func(d *json.Decoder) ([]Difference, error) {
+			err := w.DecodeJSON(d)
+			return v.Diff(w), err
+		}, nil)
+	})
+}
+
+func FuzzTransactionIdentifierResponse(f *testing.F) {
+	r := newTestRand()
+	for i := 0; i < fuzzSeeds; i++ {
+		f.Add(r.TransactionIdentifierResponse().EncodeJSON(nil))
+	}
+	f.Fuzz(func(t *testing.T, data []byte) {
+		v := TransactionIdentifierResponse{}
+		enc, ok := fuzzDecode(data, func(d *json.Decoder) ([]byte, error) {
+			if err := v.DecodeJSON(d); err != nil {
+				return nil, err
+			}
+			return v.EncodeJSON(nil), nil
+		})
+		if !ok {
+			return
+		}
+		w := TransactionIdentifierResponse{}
+		checkRoundTrip(t, enc, func(d *json.Decoder) ([]Difference, error) {
+			err := w.DecodeJSON(d)
+			return v.Diff(w), err
+		}, nil)
+	})
+}
+
+func FuzzVersion(f *testing.F) {
+	r := newTestRand()
+	for i := 0; i < fuzzSeeds; i++ {
+		f.Add(r.Version().EncodeJSON(nil))
+	}
+	f.Fuzz(func(t *testing.T, data []byte) {
+		v := Version{}
+		enc, ok := fuzzDecode(data, func(d *json.Decoder) ([]byte, error) {
+			if err := v.DecodeJSON(d); err != nil {
+				return nil, err
+			}
+			return v.EncodeJSON(nil), nil
+		})
+		if !ok {
+			return
+		}
+		w := Version{}
+		checkRoundTrip(t, enc, func(d *json.Decoder) ([]Difference, error) {
+			err := w.DecodeJSON(d)
+			return v.Diff(w), err
+		}, nil)
+	})
+}
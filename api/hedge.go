@@ -0,0 +1,326 @@
+// Copyright 2021 Coinbase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/tav/validate-rosetta/retry"
+)
+
+const (
+	// hedgeMinSamples specifies the number of latencies that need to have
+	// been observed for an endpoint before requests to it are hedged.
+	hedgeMinSamples = 20
+	// latencyWindow specifies the number of recent latencies that are kept
+	// for each endpoint.
+	latencyWindow = 256
+)
+
+// hedgeable specifies the idempotent read endpoints that can be hedged.
+var hedgeable = map[string]bool{
+	"/account/balance":     true,
+	"/account/coins":       true,
+	"/block":               true,
+	"/block/transaction":   true,
+	"/events/blocks":       true,
+	"/mempool":             true,
+	"/mempool/transaction": true,
+	"/network/list":        true,
+	"/network/options":     true,
+	"/network/status":      true,
+	"/search/transactions": true,
+}
+
+// HedgeHandler is called whenever a hedged request is sent during a Client API
+// call, with whether the hedged request's response was the one that was used.
+type HedgeHandler func(endpoint string, won bool)
+
+// SetHedging enables the hedging of requests to idempotent read endpoints.
+// If a request hasn't returned after the given percentile of recent latencies
+// for its endpoint, e.g. 95, a duplicate request is sent, and the first
+// successful response is used. The other request is then cancelled. Hedged
+// requests count towards the concurrency limit for the base URL, and are only
+// sent if a slot is available without waiting. The given handler, if any, is
+// called whenever a hedged request is sent. A percentile of 0 disables
+// hedging.
+func (c *Client) SetHedging(percentile float64, handler HedgeHandler) {
+	c.hedge = percentile
+	c.onHedge = handler
+}
+
+// post sends the encoded request to the given endpoint, hedging the request if
+// enabled.
+func (c *Client) post(ctx context.Context, endpoint string) (*http.Response, error) {
+	if !hedgeable[endpoint] {
+		return c.send(ctx, endpoint, c.req)
+	}
+	if c.hedge <= 0 {
+		return c.send(ctx, endpoint, c.req)
+	}
+	delay := c.backend.latency(endpoint, c.hedge)
+	if delay == 0 {
+		start := time.Now()
+		hresp, err := c.send(ctx, endpoint, c.req)
+		if err == nil {
+			c.backend.observe(endpoint, time.Since(start))
+		}
+		return hresp, err
+	}
+	type result struct {
+		cancel context.CancelFunc
+		err    error
+		hedge  bool
+		hresp  *http.Response
+		lost   bool
+		x      *Exchange
+	}
+	// NOTE(tav): The requests may still be read after we return, so they
+	// can't use c.req, which is reused by the next call.
+	var (
+		body     = append([]byte(nil), c.req...)
+		cancels  [2]context.CancelFunc
+		fallback *result
+		pending  int
+		results  = make(chan *result, 2)
+		start    = time.Now()
+	)
+	send := func(hedge bool) {
+		pending++
+		ctx, cancel := context.WithCancel(ctx)
+		if hedge {
+			cancels[1] = cancel
+		} else {
+			cancels[0] = cancel
+		}
+		go func() {
+			// NOTE(tav): Requests that lost aren't recorded, as replaying
+			// them would serve their responses ahead of the ones that were
+			// used.
+			hresp, x, err := c.sendPending(ctx, endpoint, body)
+			r := &result{cancel: cancel, err: err, hedge: hedge, hresp: hresp, x: x}
+			if hedge {
+				// NOTE(tav): The hedged request's slot in the concurrency
+				// limit is released once the request is done with, i.e.
+				// when its context is cancelled. Requests that lost are
+				// released without growing the limit, unless they found
+				// the backend to be failing.
+				var once sync.Once
+				o := hedgeOutcome(err, hresp)
+				r.cancel = func() {
+					cancel()
+					once.Do(func() {
+						if r.lost && o == outcomeSuccess {
+							o = outcomeCancelled
+						}
+						c.backend.releaseHedge(o)
+					})
+				}
+			}
+			results <- r
+		}()
+	}
+	// discard cancels the request that lost, if it is still pending, and
+	// closes its response once it arrives.
+	discard := func(winner *result) {
+		if pending == 0 {
+			return
+		}
+		if winner.hedge {
+			cancels[0]()
+		} else {
+			cancels[1]()
+		}
+		go func(pending int) {
+			for ; pending > 0; pending-- {
+				r := <-results
+				if r.err == nil {
+					unrecord(r.hresp)
+					r.hresp.Body.Close()
+				}
+				r.lost = true
+				r.cancel()
+			}
+		}(pending)
+	}
+	send(false)
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+	hedged := false
+	for {
+		select {
+		case <-timer.C:
+			if ctx.Err() == nil && c.backend.acquireHedge() {
+				hedged = true
+				send(true)
+			}
+			continue
+		case r := <-results:
+			pending--
+			if r.err == nil && r.hresp.StatusCode == 200 {
+				if !r.hedge {
+					c.backend.observe(endpoint, time.Since(start))
+				}
+				if hedged && c.onHedge != nil {
+					c.onHedge(endpoint, r.hedge)
+				}
+				// NOTE(tav): The winning request's context is only
+				// cancelled once its response body has been read.
+				discard(r)
+				if fallback != nil {
+					if fallback.err == nil {
+						unrecord(fallback.hresp)
+						fallback.hresp.Body.Close()
+					}
+					fallback.lost = true
+					fallback.cancel()
+				}
+				r.hresp.Body = &cancelBody{r.hresp.Body, r.cancel}
+				return r.hresp, nil
+			}
+			if fallback == nil {
+				fallback = r
+			} else {
+				r.lost = true
+				r.cancel()
+				if r.err == nil {
+					unrecord(r.hresp)
+					r.hresp.Body.Close()
+				}
+			}
+			if pending > 0 {
+				continue
+			}
+		}
+		// NOTE(tav): Neither request succeeded, so we return the first
+		// response, and let the retry Handler decide what to do with it.
+		if hedged && c.onHedge != nil {
+			c.onHedge(endpoint, false)
+		}
+		if fallback.err != nil {
+			if fallback.x != nil {
+				c.recorder.record(fallback.x)
+			}
+			fallback.cancel()
+			return nil, fallback.err
+		}
+		fallback.hresp.Body = &cancelBody{fallback.hresp.Body, fallback.cancel}
+		return fallback.hresp, nil
+	}
+}
+
+// cancelBody cancels the context of a request once its response body has been
+// closed.
+type cancelBody struct {
+	io.ReadCloser
+	cancel context.CancelFunc
+}
+
+func (b *cancelBody) Close() error {
+	err := b.ReadCloser.Close()
+	b.cancel()
+	return err
+}
+
+// hedgeOutcome returns the outcome of a hedged request.
+func hedgeOutcome(err error, hresp *http.Response) outcome {
+	if err != nil {
+		return backendOutcome(err)
+	}
+	switch hresp.StatusCode {
+	case 200, 500:
+		// NOTE(tav): Rosetta errors are returned with a 500 status, and are
+		// not failures of the backend.
+		return outcomeSuccess
+	}
+	return backendOutcome(&StatusError{StatusCode: hresp.StatusCode})
+}
+
+// latencies keeps the recent latencies for an endpoint.
+type latencies struct {
+	idx     int
+	samples []time.Duration
+}
+
+// acquireHedge acquires a slot in the concurrency limit for a hedged request,
+// if the backend has spare capacity, i.e. its circuit breaker is closed, and
+// a slot is available without waiting, so that hedged requests can't delay
+// other calls. Each successful call must be followed by a call to
+// releaseHedge.
+func (b *backend) acquireHedge() bool {
+	if b.breaker.Status().State != retry.Closed {
+		return false
+	}
+	return b.limiter.TryAcquire()
+}
+
+// latency returns the given percentile of the recent latencies for the given
+// endpoint. It returns zero if not enough latencies have been observed.
+func (b *backend) latency(endpoint string, percentile float64) time.Duration {
+	b.mu.Lock()
+	l := b.latencies[endpoint]
+	if l == nil || len(l.samples) < hedgeMinSamples {
+		b.mu.Unlock()
+		return 0
+	}
+	samples := append([]time.Duration(nil), l.samples...)
+	b.mu.Unlock()
+	sort.Slice(samples, func(i, j int) bool {
+		return samples[i] < samples[j]
+	})
+	idx := int(percentile / 100 * float64(len(samples)))
+	if idx >= len(samples) {
+		idx = len(samples) - 1
+	}
+	return samples[idx]
+}
+
+// observe records the latency of a request to the given endpoint.
+func (b *backend) observe(endpoint string, d time.Duration) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.latencies == nil {
+		b.latencies = map[string]*latencies{}
+	}
+	l := b.latencies[endpoint]
+	if l == nil {
+		l = &latencies{}
+		b.latencies[endpoint] = l
+	}
+	if len(l.samples) < latencyWindow {
+		l.samples = append(l.samples, d)
+		return
+	}
+	l.samples[l.idx] = d
+	l.idx = (l.idx + 1) % latencyWindow
+}
+
+// releaseHedge releases the slot in the concurrency limit for a hedged request
+// acquired with acquireHedge, and adapts the limit based on its outcome.
+func (b *backend) releaseHedge(o outcome) {
+	switch o {
+	case outcomeCancelled:
+		b.limiter.Cancel()
+	case outcomeFailure:
+		b.limiter.Release(true)
+	default:
+		b.limiter.Release(false)
+	}
+}
@@ -0,0 +1,98 @@
+// Copyright 2021 Coinbase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"encoding/binary"
+)
+
+// AccountKey is a compact, comparable encoding of an AccountIdentifier, along
+// with its SubAccountIdentifier, for use as a map key. It is identical to the
+// encoding produced by AccountIdentifier.AppendKey, which can be used within
+// database keys.
+type AccountKey string
+
+// BalanceKey is a compact, comparable encoding of an AccountIdentifier and
+// Currency pair, i.e. the unit of balance reconciliation. It is the
+// concatenation of the corresponding AccountKey and CurrencyKey.
+type BalanceKey string
+
+// CurrencyKey is a compact, comparable encoding of a Currency for use as a map
+// key. It is identical to the encoding produced by Currency.AppendKey.
+type CurrencyKey string
+
+// AppendKey appends the canonical binary encoding of the AccountIdentifier to
+// the given buffer.
+//
+// Each field is length-prefixed, so that the encoding is unambiguous and can
+// be concatenated with other keys. As MapObject values are canonical,
+// semantically equal identifiers always have the same encoding.
+//
+// To look up a map keyed on AccountKey without allocating, callers can use a
+// reusable buffer, e.g. m[AccountKey(v.AppendKey(buf[:0]))].
+func (v AccountIdentifier) AppendKey(b []byte) []byte {
+	b = appendKeyString(b, v.Address)
+	b = appendKeyBytes(b, v.Metadata)
+	if !v.SubAccount.Set {
+		return append(b, 0)
+	}
+	b = append(b, 1)
+	b = appendKeyString(b, v.SubAccount.Value.Address)
+	return appendKeyBytes(b, v.SubAccount.Value.Metadata)
+}
+
+// Key returns the AccountKey for the AccountIdentifier.
+func (v AccountIdentifier) Key() AccountKey {
+	return AccountKey(v.AppendKey(nil))
+}
+
+// AppendKey appends the canonical binary encoding of the Currency to the given
+// buffer. See AccountIdentifier.AppendKey for details.
+func (v Currency) AppendKey(b []byte) []byte {
+	b = appendKeyString(b, v.Symbol)
+	var n [binary.MaxVarintLen64]byte
+	b = append(b, n[:binary.PutVarint(n[:], int64(v.Decimals))]...)
+	return appendKeyBytes(b, v.Metadata)
+}
+
+// Key returns the CurrencyKey for the Currency.
+func (v Currency) Key() CurrencyKey {
+	return CurrencyKey(v.AppendKey(nil))
+}
+
+// AppendBalanceKey appends the binary encoding of the BalanceKey for the given
+// AccountIdentifier and Currency to the given buffer.
+func AppendBalanceKey(b []byte, account AccountIdentifier, currency Currency) []byte {
+	b = account.AppendKey(b)
+	return currency.AppendKey(b)
+}
+
+// NewBalanceKey returns the BalanceKey for the given AccountIdentifier and
+// Currency.
+func NewBalanceKey(account AccountIdentifier, currency Currency) BalanceKey {
+	return BalanceKey(AppendBalanceKey(nil, account, currency))
+}
+
+func appendKeyBytes(b []byte, v []byte) []byte {
+	var n [binary.MaxVarintLen64]byte
+	b = append(b, n[:binary.PutUvarint(n[:], uint64(len(v)))]...)
+	return append(b, v...)
+}
+
+func appendKeyString(b []byte, v string) []byte {
+	var n [binary.MaxVarintLen64]byte
+	b = append(b, n[:binary.PutUvarint(n[:], uint64(len(v)))]...)
+	return append(b, v...)
+}
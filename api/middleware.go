@@ -0,0 +1,116 @@
+// Copyright 2021 Coinbase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"context"
+	"io"
+	"net/http"
+
+	"github.com/tav/validate-rosetta/json"
+	"github.com/tav/validate-rosetta/retry"
+)
+
+// Invocation represents a Client API call as seen by Middleware.
+type Invocation struct {
+	// Attempts are the outcomes of the attempts made so far.
+	Attempts []retry.Attempt
+	// Body is the raw response body of the latest attempt, if CaptureBody is
+	// set. It is only complete once the attempt's response has been decoded.
+	Body []byte
+	// CaptureBody needs to be set by Middleware before calling next for Body
+	// to be captured, as it may be large.
+	CaptureBody bool
+	// Endpoint is the endpoint being called, e.g. "/block".
+	Endpoint string
+	// Request is the encoded request. It must not be modified.
+	Request []byte
+	// Response is the value that the response is decoded into.
+	Response Response
+	// Status is the HTTP status code of the latest attempt's response. It is
+	// zero if no response has been received.
+	Status int
+}
+
+// observe records the status of the given response, and captures its body as
+// it is read.
+func (inv *Invocation) observe(hresp *http.Response) {
+	if inv == nil {
+		return
+	}
+	inv.Body = inv.Body[:0]
+	inv.Status = hresp.StatusCode
+	if inv.CaptureBody {
+		hresp.Body = &captureBody{hresp.Body, inv}
+	}
+}
+
+// Middleware wraps Client API calls, e.g. for logging, tracing, or metrics. It
+// must call next to continue the call, unless it handles the call itself, in
+// which case it must decode into the Invocation's Response.
+//
+// The returned ClientError is owned by the Client, and must not be retained.
+type Middleware func(ctx context.Context, inv *Invocation, next func(ctx context.Context) *ClientError) *ClientError
+
+// Response represents the response values of Client API calls.
+type Response interface {
+	DecodeJSON(d *json.Decoder) error
+	EncodeJSON(b []byte) []byte
+	Reset()
+}
+
+// Use adds Middleware around all API calls made by the Client. Middleware
+// that is added first is called first.
+func (c *Client) Use(m ...Middleware) {
+	c.middleware = append(c.middleware, m...)
+}
+
+// intercept calls fn within the Middleware chain.
+func (c *Client) intercept(
+	ctx context.Context, endpoint string, resp Response,
+	fn func(ctx context.Context, inv *Invocation) *ClientError,
+) *ClientError {
+	inv := &Invocation{
+		Endpoint: endpoint,
+		Request:  c.req,
+		Response: resp,
+	}
+	var next func(idx int) func(ctx context.Context) *ClientError
+	next = func(idx int) func(ctx context.Context) *ClientError {
+		return func(ctx context.Context) *ClientError {
+			if idx == len(c.middleware) {
+				return fn(ctx, inv)
+			}
+			return c.middleware[idx](ctx, inv, next(idx+1))
+		}
+	}
+	return next(0)(ctx)
+}
+
+// captureBody captures a response body into an Invocation as it is read.
+type captureBody struct {
+	rc  io.ReadCloser
+	inv *Invocation
+}
+
+func (b *captureBody) Close() error {
+	return b.rc.Close()
+}
+
+func (b *captureBody) Read(p []byte) (int, error) {
+	n, err := b.rc.Read(p)
+	b.inv.Body = append(b.inv.Body, p[:n]...)
+	return n, err
+}
@@ -0,0 +1,96 @@
+// Copyright 2021 Coinbase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/tav/validate-rosetta/json"
+	"github.com/tav/validate-rosetta/retry"
+)
+
+func TestMiddleware(t *testing.T) {
+	block := createNewBlock()
+	body := BlockResponse{Block: OptionalBlock(block)}.EncodeJSON(nil)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write(body)
+	}))
+	defer srv.Close()
+	client := NewClient(srv.URL)
+	client.SetNetwork(NetworkIdentifier{Blockchain: "test", Network: "test"})
+	var calls []string
+	client.Use(func(ctx context.Context, inv *Invocation, next func(ctx context.Context) *ClientError) *ClientError {
+		calls = append(calls, "outer:"+inv.Endpoint)
+		inv.CaptureBody = true
+		err := next(ctx)
+		if len(inv.Attempts) != 1 || inv.Attempts[0].Err != nil {
+			t.Errorf("Unexpected attempts for %s: %v", inv.Endpoint, inv.Attempts)
+		}
+		if inv.Status != 200 || string(inv.Body) != string(body) {
+			t.Errorf("Unexpected status %d and body for %s: %s", inv.Status, inv.Endpoint, inv.Body)
+		}
+		if string(inv.Request) != string(client.req) {
+			t.Errorf("Unexpected request for %s: %s", inv.Endpoint, inv.Request)
+		}
+		if resp, ok := inv.Response.(*BlockResponse); !ok || !resp.Block.Set {
+			t.Errorf("Unexpected decoded response for %s: %v", inv.Endpoint, inv.Response)
+		}
+		return err
+	}, func(ctx context.Context, inv *Invocation, next func(ctx context.Context) *ClientError) *ClientError {
+		calls = append(calls, "inner:"+inv.Endpoint)
+		return next(ctx)
+	})
+	resp := &BlockResponse{}
+	if err := client.Block(context.Background(), &BlockRequest{}, resp, retry.Never); err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	err := client.BlockStream(context.Background(), &BlockRequest{}, resp, func(txn *Transaction) error {
+		return nil
+	}, retry.Never)
+	if err != nil {
+		t.Fatalf("Unexpected error streaming block: %s", err)
+	}
+	want := []string{"outer:/block", "inner:/block", "outer:/block", "inner:/block"}
+	if len(calls) != len(want) {
+		t.Fatalf("Unexpected middleware calls: got %v, want %v", calls, want)
+	}
+	for i, call := range calls {
+		if call != want[i] {
+			t.Fatalf("Unexpected middleware calls: got %v, want %v", calls, want)
+		}
+	}
+	// NOTE(tav): Middleware can handle calls without making any requests.
+	client = NewClient("http://unused.invalid")
+	client.SetNetwork(NetworkIdentifier{Blockchain: "test", Network: "test"})
+	client.Use(func(ctx context.Context, inv *Invocation, next func(ctx context.Context) *ClientError) *ClientError {
+		dec := json.NewDecoder()
+		dec.ResetFromBytes(body)
+		inv.Response.Reset()
+		if err := inv.Response.DecodeJSON(dec); err != nil {
+			t.Fatalf("Failed to decode canned response: %s", err)
+		}
+		return nil
+	})
+	resp = &BlockResponse{}
+	if err := client.Block(context.Background(), &BlockRequest{}, resp, retry.Never); err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	if !resp.Block.Value.Equal(block) {
+		t.Errorf("Mismatching canned response:\n%s", DescribeDiffs(resp.Block.Value.Diff(block), 10))
+	}
+}
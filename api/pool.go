@@ -0,0 +1,98 @@
+// Copyright 2021 Coinbase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"context"
+	"sync"
+
+	"github.com/tav/validate-rosetta/retry"
+)
+
+// Pool hands out Clients for the same base URL, so that API calls can be made
+// from many goroutines at once. Unlike Client, it is safe for concurrent use,
+// and its endpoint methods can be called concurrently.
+//
+// The ClientError values returned by the endpoint methods of a Pool are owned
+// by the caller, and can be retained. Each concurrent call must use its own
+// `resp` value.
+type Pool struct {
+	baseURL   string
+	configure func(c *Client)
+	free      []*Client
+	mu        sync.Mutex // protects free, netjson, network
+	netjson   []byte
+	network   NetworkIdentifier
+}
+
+// BlockStream calls the /block endpoint with a Client from the Pool, passing
+// each transaction to fn. See Client.BlockStream for details.
+func (p *Pool) BlockStream(
+	ctx context.Context, req *BlockRequest, resp *BlockResponse,
+	fn func(txn *Transaction) error, retry retry.Handler,
+) *ClientError {
+	c := p.Get()
+	defer p.Put(c)
+	return c.BlockStream(ctx, req, resp, fn, retry).clone()
+}
+
+// Get returns a Client from the Pool, creating one if none are free. The
+// Client must be returned to the Pool with Put once it is no longer in use.
+func (p *Pool) Get() *Client {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	var c *Client
+	if n := len(p.free); n > 0 {
+		c = p.free[n-1]
+		p.free = p.free[:n-1]
+	} else {
+		c = NewClient(p.baseURL)
+		if p.configure != nil {
+			p.configure(c)
+		}
+	}
+	// NOTE(tav): The encoded NetworkIdentifier is only ever read by Clients,
+	// so it is shared rather than re-encoded for each Client.
+	c.netjson = p.netjson
+	c.network = p.network
+	return c
+}
+
+// Put returns a Client obtained with Get to the Pool.
+func (p *Pool) Put(c *Client) {
+	p.mu.Lock()
+	p.free = append(p.free, c)
+	p.mu.Unlock()
+}
+
+// SetNetwork sets the NetworkIdentifier used by all Clients from the Pool.
+func (p *Pool) SetNetwork(n NetworkIdentifier) {
+	netjson := EncodeNetworkForJSON(n)
+	p.mu.Lock()
+	p.netjson = netjson
+	p.network = n
+	p.mu.Unlock()
+}
+
+// NewPool instantiates a new Pool of Clients for the given base URL. If
+// configure is not nil, it is called with each new Client, e.g. to call
+// SetLimits or SetStrict on it. Any handlers set on the Clients must be safe
+// for concurrent use.
+func NewPool(baseURL string, configure func(c *Client)) *Pool {
+	return &Pool{
+		baseURL:   baseURL,
+		configure: configure,
+	}
+}
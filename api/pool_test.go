@@ -0,0 +1,93 @@
+// Copyright 2021 Coinbase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/tav/validate-rosetta/json"
+	"github.com/tav/validate-rosetta/retry"
+)
+
+func TestPool(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		dec := json.NewDecoder()
+		dec.ResetFromBytes(body)
+		req := &BlockRequest{}
+		network := &NetworkIdentifier{}
+		if err := req.DecodeJSON(dec, network); err != nil {
+			w.WriteHeader(400)
+			return
+		}
+		idx := req.BlockIdentifier.Index.Value
+		if idx%2 == 1 {
+			details, _ := MapObjectFrom(map[string]interface{}{"index": idx})
+			w.WriteHeader(500)
+			w.Write(Error{Code: 1, Details: details, Message: "odd"}.EncodeJSON(nil))
+			return
+		}
+		w.Write(BlockResponse{
+			Block: OptionalBlock(Block{BlockIdentifier: BlockIdentifier{Index: idx}}),
+		}.EncodeJSON(nil))
+	}))
+	defer srv.Close()
+	pool := NewPool(srv.URL, func(c *Client) {
+		c.SetStrict(json.StrictError, nil)
+	})
+	pool.SetNetwork(NetworkIdentifier{Blockchain: "test", Network: "test"})
+	var (
+		mu   sync.Mutex
+		errs = map[int64]*ClientError{}
+		wg   sync.WaitGroup
+	)
+	for i := int64(0); i < 64; i++ {
+		wg.Add(1)
+		go func(idx int64) {
+			defer wg.Done()
+			resp := &BlockResponse{}
+			err := pool.Block(context.Background(), &BlockRequest{
+				BlockIdentifier: PartialBlockIdentifier{Index: OptionalInt64(idx)},
+			}, resp, retry.Never)
+			if err != nil {
+				mu.Lock()
+				errs[idx] = err
+				mu.Unlock()
+				return
+			}
+			if got := resp.Block.Value.BlockIdentifier.Index; got != idx {
+				t.Errorf("Got block %d, want %d", got, idx)
+			}
+		}(i)
+	}
+	wg.Wait()
+	if len(errs) != 32 {
+		t.Fatalf("Got %d errors, want 32", len(errs))
+	}
+	// NOTE(tav): The errors are checked after all calls have completed, so
+	// that any errors sharing memory with a reused Client would be detected.
+	for idx, err := range errs {
+		want := fmt.Sprintf(`{"index":%d}`, idx)
+		if idx%2 == 0 || err.CallError != nil || string(err.RosettaError.Details) != want {
+			t.Errorf("Unexpected error for block %d: %v (details: %s)", idx, err, err.RosettaError.Details)
+		}
+	}
+}
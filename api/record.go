@@ -0,0 +1,304 @@
+// Copyright 2021 Coinbase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"crypto/sha256"
+	"encoding/hex"
+	stdjson "encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Exchange represents a recorded request to a Rosetta API server along with
+// its response.
+type Exchange struct {
+	// Body is the response body. It is base64-encoded within the archive, so
+	// that bodies which aren't valid UTF-8 are replayed exactly.
+	Body []byte `json:"body,omitempty"`
+	// Endpoint is the endpoint that the request was made to, e.g. "/block".
+	Endpoint string `json:"endpoint"`
+	// Error is the error that occurred instead of a response being received.
+	Error string `json:"error,omitempty"`
+	// Hash is the RequestHash of the request.
+	Hash string `json:"hash"`
+	// Latency is the time, in nanoseconds, taken by the request, including
+	// reading the response body.
+	Latency time.Duration `json:"latency"`
+	// Request is the encoded request.
+	Request stdjson.RawMessage `json:"request"`
+	// Status is the HTTP status code of the response.
+	Status int `json:"status,omitempty"`
+	// Truncated indicates that the response body exceeded the Client's
+	// MaxSize limit, and only the first MaxSize+1 bytes were recorded, so
+	// that replaying it exceeds the limit too.
+	Truncated bool `json:"truncated,omitempty"`
+}
+
+// Recorder records Exchanges to a gzip-compressed JSONL archive, i.e. with one
+// JSON-encoded Exchange per line. It is safe for concurrent use, so a single
+// Recorder can be set on many Clients.
+type Recorder struct {
+	err error
+	mu  sync.Mutex // protects err, zw
+	zw  *gzip.Writer
+}
+
+// Close flushes any buffered data to the underlying writer. It doesn't close
+// the underlying writer.
+func (r *Recorder) Close() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if err := r.zw.Close(); err != nil && r.err == nil {
+		r.err = err
+	}
+	return r.err
+}
+
+// Err returns the first error encountered while recording.
+func (r *Recorder) Err() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.err
+}
+
+func (r *Recorder) record(x *Exchange) {
+	line, err := stdjson.Marshal(x)
+	if err != nil {
+		err = fmt.Errorf("api: failed to encode recorded exchange: %w", err)
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.err != nil {
+		return
+	}
+	if err != nil {
+		r.err = err
+		return
+	}
+	// NOTE(tav): We flush after each exchange, so that the archive is usable
+	// even if the process doesn't exit cleanly.
+	line = append(line, '\n')
+	if _, err := r.zw.Write(line); err != nil {
+		r.err = fmt.Errorf("api: failed to write recorded exchange: %w", err)
+		return
+	}
+	if err := r.zw.Flush(); err != nil {
+		r.err = fmt.Errorf("api: failed to write recorded exchange: %w", err)
+	}
+}
+
+// Replay serves the responses from an archive written by a Recorder. It
+// implements http.RoundTripper, so that it can be used as the RoundTripper of
+// a Transport, and is safe for concurrent use.
+//
+// Requests are matched to Exchanges by their RequestHash. If the same request
+// was recorded multiple times, the responses are served in the order they
+// were recorded, with the last one being repeated. Requests that weren't
+// recorded get a 404 response.
+type Replay struct {
+	endpoints map[string]bool
+	exchanges map[string][]*Exchange
+	mu        sync.Mutex // protects exchanges
+}
+
+// Len returns the number of distinct requests in the Replay.
+func (r *Replay) Len() int {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return len(r.exchanges)
+}
+
+// RoundTrip implements the http.RoundTripper interface.
+func (r *Replay) RoundTrip(hreq *http.Request) (*http.Response, error) {
+	var body io.Reader = http.NoBody
+	if hreq.Body != nil {
+		body = hreq.Body
+		defer hreq.Body.Close()
+	}
+	if hreq.Header.Get("Content-Encoding") == "gzip" {
+		zr, err := gzip.NewReader(body)
+		if err != nil {
+			return nil, fmt.Errorf("api: failed to decompress request to replay: %w", err)
+		}
+		body = zr
+	}
+	req, err := io.ReadAll(body)
+	if err != nil {
+		return nil, fmt.Errorf("api: failed to read request to replay: %w", err)
+	}
+	r.mu.Lock()
+	// NOTE(tav): The base URL may have a path prefix, so we match the path
+	// against the longest recorded endpoint that it ends with.
+	endpoint := hreq.URL.Path
+	match := ""
+	for elem := range r.endpoints {
+		if strings.HasSuffix(endpoint, elem) && len(elem) > len(match) {
+			match = elem
+		}
+	}
+	if match != "" {
+		endpoint = match
+	}
+	hash := RequestHash(endpoint, req)
+	xs := r.exchanges[hash]
+	var x *Exchange
+	if len(xs) > 0 {
+		x = xs[0]
+		if len(xs) > 1 {
+			r.exchanges[hash] = xs[1:]
+		}
+	}
+	r.mu.Unlock()
+	resp := &http.Response{
+		Header:     http.Header{},
+		Proto:      "HTTP/1.1",
+		ProtoMajor: 1,
+		ProtoMinor: 1,
+		Request:    hreq,
+	}
+	if x == nil {
+		msg := "api: no recorded response for " + endpoint + " request " + hash
+		resp.Body = io.NopCloser(strings.NewReader(msg))
+		resp.ContentLength = int64(len(msg))
+		resp.Status = "404 Not Found"
+		resp.StatusCode = 404
+		return resp, nil
+	}
+	if x.Error != "" {
+		return nil, errors.New(x.Error)
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(x.Body))
+	resp.ContentLength = int64(len(x.Body))
+	resp.Header.Set("Content-Type", "application/json")
+	resp.Status = strconv.Itoa(x.Status) + " " + http.StatusText(x.Status)
+	resp.StatusCode = x.Status
+	return resp, nil
+}
+
+// recordBody records an Exchange once the response body has been closed, unless
+// it has been unrecorded. If max is positive, at most max+1 bytes of the body
+// are recorded.
+type recordBody struct {
+	buf   bytes.Buffer
+	max   int
+	rc    io.ReadCloser
+	rec   *Recorder
+	start time.Time
+	x     *Exchange
+}
+
+func (b *recordBody) Close() error {
+	if b.x == nil {
+		return b.rc.Close()
+	}
+	// NOTE(tav): The response may not have been read in full, e.g. if it
+	// failed to decode, so we read the remainder to record it, up to the
+	// limit.
+	if b.max <= 0 {
+		io.Copy(&b.buf, b.rc)
+	} else if n := b.max + 1 - b.buf.Len(); n > 0 {
+		io.CopyN(&b.buf, b.rc, int64(n))
+	}
+	err := b.rc.Close()
+	b.x.Body = b.buf.Bytes()
+	b.x.Latency = time.Since(b.start)
+	b.x.Truncated = b.max > 0 && b.buf.Len() > b.max
+	b.rec.record(b.x)
+	b.x = nil
+	return err
+}
+
+func (b *recordBody) Read(p []byte) (int, error) {
+	n, err := b.rc.Read(p)
+	b.write(p[:n])
+	return n, err
+}
+
+func (b *recordBody) write(p []byte) {
+	if b.max > 0 {
+		if n := b.max + 1 - b.buf.Len(); n < len(p) {
+			if n <= 0 {
+				return
+			}
+			p = p[:n]
+		}
+	}
+	b.buf.Write(p)
+}
+
+// unrecord stops the Exchange for the given response, if any, from being
+// recorded.
+func unrecord(hresp *http.Response) {
+	if b, ok := hresp.Body.(*recordBody); ok {
+		b.x = nil
+	}
+}
+
+// NewRecorder returns a Recorder that writes to the given writer.
+func NewRecorder(w io.Writer) *Recorder {
+	return &Recorder{
+		zw: gzip.NewWriter(w),
+	}
+}
+
+// NewReplay returns a Replay of the archive read from the given reader.
+func NewReplay(r io.Reader) (*Replay, error) {
+	zr, err := gzip.NewReader(r)
+	if err != nil {
+		return nil, fmt.Errorf("api: failed to read replay archive: %w", err)
+	}
+	replay := &Replay{
+		endpoints: map[string]bool{},
+		exchanges: map[string][]*Exchange{},
+	}
+	s := bufio.NewScanner(zr)
+	s.Buffer(nil, 1<<30)
+	for line := 1; s.Scan(); line++ {
+		if len(bytes.TrimSpace(s.Bytes())) == 0 {
+			continue
+		}
+		x := &Exchange{}
+		if err := stdjson.Unmarshal(s.Bytes(), x); err != nil {
+			return nil, fmt.Errorf(
+				"api: failed to decode exchange on line %d of replay archive: %w", line, err,
+			)
+		}
+		replay.endpoints[x.Endpoint] = true
+		replay.exchanges[x.Hash] = append(replay.exchanges[x.Hash], x)
+	}
+	if err := s.Err(); err != nil {
+		return nil, fmt.Errorf("api: failed to read replay archive: %w", err)
+	}
+	return replay, nil
+}
+
+// RequestHash returns the hash used to match requests to recorded Exchanges.
+func RequestHash(endpoint string, req []byte) string {
+	h := sha256.New()
+	h.Write([]byte(endpoint))
+	h.Write([]byte{'\n'})
+	h.Write(req)
+	return hex.EncodeToString(h.Sum(nil))
+}
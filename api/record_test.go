@@ -0,0 +1,239 @@
+// Copyright 2021 Coinbase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	stdjson "encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/tav/validate-rosetta/json"
+	"github.com/tav/validate-rosetta/retry"
+)
+
+func TestRecordReplay(t *testing.T) {
+	block := createNewBlock()
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/block" {
+			w.Write(BlockResponse{Block: OptionalBlock(block)}.EncodeJSON(nil))
+			return
+		}
+		w.WriteHeader(500)
+		w.Write(Error{Code: 7, Message: "unavailable"}.EncodeJSON(nil))
+	}))
+	defer srv.Close()
+	network := NetworkIdentifier{Blockchain: "test", Network: "test"}
+	buf := &bytes.Buffer{}
+	rec := NewRecorder(buf)
+	client := NewClient(srv.URL)
+	client.SetNetwork(network)
+	client.SetRecorder(rec)
+	call := func(client *Client) (*BlockResponse, *ClientError) {
+		t.Helper()
+		resp := &BlockResponse{}
+		err := client.Block(context.Background(), &BlockRequest{
+			BlockIdentifier: PartialBlockIdentifier{Index: OptionalInt64(1)},
+		}, resp, retry.Never)
+		if err != nil {
+			t.Fatalf("Unexpected error calling /block: %s", err)
+		}
+		return resp, client.NetworkStatus(
+			context.Background(), &NetworkRequest{}, &NetworkStatusResponse{}, retry.Never,
+		).clone()
+	}
+	want, wantErr := call(client)
+	if err := rec.Close(); err != nil {
+		t.Fatalf("Failed to close recorder: %s", err)
+	}
+	replay, err := NewReplay(buf)
+	if err != nil {
+		t.Fatalf("Failed to load replay: %s", err)
+	}
+	if n := replay.Len(); n != 2 {
+		t.Fatalf("Got %d recorded requests, want 2", n)
+	}
+	baseURL := "http://replay.invalid/rosetta"
+	SetTransport(baseURL, Transport{
+		GzipRequests: true,
+		RoundTripper: replay,
+	})
+	client = NewClient(baseURL)
+	client.SetNetwork(network)
+	got, gotErr := call(client)
+	if !got.Equal(*want) {
+		t.Errorf("Mismatching replayed /block response:\n%s", DescribeDiffs(got.Diff(*want), 10))
+	}
+	if gotErr == nil || gotErr.RosettaError.Code != wantErr.RosettaError.Code {
+		t.Errorf("Mismatching replayed /network/status error: got %v, want %v", gotErr, wantErr)
+	}
+	client.SetNetwork(NetworkIdentifier{Blockchain: "test", Network: "other"})
+	cerr := client.NetworkStatus(context.Background(), &NetworkRequest{}, &NetworkStatusResponse{}, retry.Never)
+	if cerr == nil {
+		t.Fatalf("Expected an error for an unrecorded request")
+	}
+	if serr, ok := cerr.CallError.(*StatusError); !ok || serr.StatusCode != 404 {
+		t.Errorf("Expected a 404 for an unrecorded request, got: %v", cerr)
+	}
+}
+
+func TestRecordTruncated(t *testing.T) {
+	body := bytes.Repeat([]byte(" "), 1<<16)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write(body)
+	}))
+	defer srv.Close()
+	network := NetworkIdentifier{Blockchain: "test", Network: "test"}
+	limits := json.Limits{MaxSize: 100}
+	call := func(client *Client) {
+		t.Helper()
+		err := client.Block(context.Background(), &BlockRequest{}, &BlockResponse{}, retry.Never)
+		if err == nil {
+			t.Fatalf("Expected a limit error")
+		}
+		if _, ok := err.CallError.(*json.LimitError); !ok {
+			t.Fatalf("Expected a limit error, got: %v", err)
+		}
+	}
+	buf := &bytes.Buffer{}
+	rec := NewRecorder(buf)
+	client := NewClient(srv.URL)
+	client.SetNetwork(network)
+	client.SetLimits(limits, nil)
+	client.SetRecorder(rec)
+	call(client)
+	if err := rec.Close(); err != nil {
+		t.Fatalf("Failed to close recorder: %s", err)
+	}
+	zr, err := gzip.NewReader(bytes.NewReader(buf.Bytes()))
+	if err != nil {
+		t.Fatalf("Failed to read archive: %s", err)
+	}
+	x := &Exchange{}
+	if err := stdjson.NewDecoder(zr).Decode(x); err != nil {
+		t.Fatalf("Failed to decode recorded exchange: %s", err)
+	}
+	if !x.Truncated || len(x.Body) != limits.MaxSize+1 {
+		t.Fatalf("Got a recorded body of %d bytes (truncated: %v), want %d truncated bytes", len(x.Body), x.Truncated, limits.MaxSize+1)
+	}
+	replay, err := NewReplay(bytes.NewReader(buf.Bytes()))
+	if err != nil {
+		t.Fatalf("Failed to load replay: %s", err)
+	}
+	baseURL := "http://replay-truncated.invalid"
+	SetTransport(baseURL, Transport{RoundTripper: replay})
+	client = NewClient(baseURL)
+	client.SetNetwork(network)
+	client.SetLimits(limits, nil)
+	call(client)
+}
+
+func TestRecordHedging(t *testing.T) {
+	var hits int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch atomic.AddInt32(&hits, 1) {
+		case hedgeMinSamples + 1:
+			time.Sleep(30 * time.Millisecond)
+			w.WriteHeader(503)
+		case hedgeMinSamples + 2:
+			time.Sleep(60 * time.Millisecond)
+			w.Write(BlockResponse{}.EncodeJSON(nil))
+		default:
+			w.Write(BlockResponse{}.EncodeJSON(nil))
+		}
+	}))
+	defer srv.Close()
+	buf := &bytes.Buffer{}
+	rec := NewRecorder(buf)
+	client := NewClient(srv.URL)
+	client.SetNetwork(NetworkIdentifier{Blockchain: "test", Network: "test"})
+	client.SetRecorder(rec)
+	var hedges []bool
+	client.SetHedging(90, func(endpoint string, won bool) {
+		hedges = append(hedges, won)
+	})
+	for i := 0; i <= hedgeMinSamples; i++ {
+		if err := client.Block(context.Background(), &BlockRequest{}, &BlockResponse{}, retry.Never); err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+	}
+	if len(hedges) != 1 || !hedges[0] {
+		t.Fatalf("Expected a single winning hedged request, got: %v", hedges)
+	}
+	if err := rec.Close(); err != nil {
+		t.Fatalf("Failed to close recorder: %s", err)
+	}
+	// NOTE(tav): The failed response to the request that lost must not be
+	// recorded, as it would otherwise be replayed first.
+	zr, err := gzip.NewReader(buf)
+	if err != nil {
+		t.Fatalf("Failed to read archive: %s", err)
+	}
+	dec := stdjson.NewDecoder(zr)
+	n := 0
+	for ; dec.More(); n++ {
+		x := &Exchange{}
+		if err := dec.Decode(x); err != nil {
+			t.Fatalf("Failed to decode recorded exchange: %s", err)
+		}
+		if x.Status != 200 {
+			t.Fatalf("Got a recorded exchange with status %d, want 200", x.Status)
+		}
+	}
+	if n != hedgeMinSamples+1 {
+		t.Fatalf("Got %d recorded exchanges, want %d", n, hedgeMinSamples+1)
+	}
+}
+
+func TestRecordInvalidUTF8(t *testing.T) {
+	body := []byte("\xff\xfe{}")
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write(body)
+	}))
+	defer srv.Close()
+	buf := &bytes.Buffer{}
+	rec := NewRecorder(buf)
+	client := NewClient(srv.URL)
+	client.SetNetwork(NetworkIdentifier{Blockchain: "test", Network: "test"})
+	client.SetRecorder(rec)
+	if err := client.Block(context.Background(), &BlockRequest{}, &BlockResponse{}, retry.Never); err == nil {
+		t.Fatalf("Expected an error decoding an invalid response")
+	}
+	if err := rec.Close(); err != nil {
+		t.Fatalf("Failed to close recorder: %s", err)
+	}
+	replay, err := NewReplay(buf)
+	if err != nil {
+		t.Fatalf("Failed to load replay: %s", err)
+	}
+	hreq := httptest.NewRequest("POST", "/block", bytes.NewReader(client.req))
+	hresp, err := replay.RoundTrip(hreq)
+	if err != nil {
+		t.Fatalf("Unexpected error replaying request: %s", err)
+	}
+	got, err := io.ReadAll(hresp.Body)
+	if err != nil {
+		t.Fatalf("Failed to read replayed body: %s", err)
+	}
+	if !bytes.Equal(got, body) {
+		t.Fatalf("Got a replayed body of %q, want %q", got, body)
+	}
+}
@@ -0,0 +1,164 @@
+// Copyright 2021 Coinbase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/tav/validate-rosetta/retry"
+)
+
+// defaultStreamThreshold specifies the default size of response from which
+// BlockStream calls decode the response incrementally.
+const defaultStreamThreshold = 1 << 20
+
+var blockTransactionsPath = []string{"block", "transactions"}
+
+// BlockStream calls the /block endpoint like Block, but decodes the
+// transactions of the returned Block one at a time, and calls fn with each of
+// them in turn, so that large blocks don't need to be held in memory. The
+// Block within resp is left without any Transactions.
+//
+// The Transaction passed to fn is reused across calls, so it must not be
+// retained. If fn returns an error, the call is aborted, and the error is set
+// as the CallError. Once fn has been called, the call will not be retried, so
+// that fn never sees the same transaction twice.
+//
+// Responses that are smaller than the threshold set by SetStreamThreshold are
+// decoded in full before fn is called.
+func (c *Client) BlockStream(
+	ctx context.Context, req *BlockRequest, resp *BlockResponse,
+	fn func(txn *Transaction) error, retry retry.Handler,
+) *ClientError {
+	if len(c.netjson) == 0 {
+		c.err.reset()
+		c.err.CallError = errors.New(
+			"api: the SetNetwork method must be called before making a Client.BlockStream call",
+		)
+		return c.err
+	}
+	c.req = req.EncodeJSON(c.req[:0], c.netjson)
+	if len(c.middleware) > 0 {
+		return c.intercept(ctx, "/block", resp, func(ctx context.Context, inv *Invocation) *ClientError {
+			return c.doBlockStream(ctx, inv, resp, fn, retry)
+		})
+	}
+	return c.doBlockStream(ctx, nil, resp, fn, retry)
+}
+
+func (c *Client) doBlockStream(
+	ctx context.Context, inv *Invocation, resp *BlockResponse,
+	fn func(txn *Transaction) error, retry retry.Handler,
+) *ClientError {
+	it := retry.WithClassifier(classifyError).Iter()
+	var (
+		err      error
+		hresp    *http.Response
+		streamed bool
+		txn      = &Transaction{}
+	)
+	decodeTxn := func(idx int) error {
+		txn.Reset()
+		if err := txn.DecodeJSON(c.dec); err != nil {
+			return err
+		}
+		if err := c.dec.End(); err != nil {
+			return err
+		}
+		streamed = true
+		return fn(txn)
+	}
+	for it.NextContext(ctx) {
+		if err = c.backend.acquire(ctx, "/block"); err != nil {
+			c.reportAttempt(inv, "/block", it.Record(err))
+			continue
+		}
+		hresp, err = c.post(ctx, "/block")
+		if err != nil {
+			c.record(inv, "/block", &it, err)
+			continue
+		}
+		inv.observe(hresp)
+		switch hresp.StatusCode {
+		case 200:
+			if size := hresp.ContentLength; size >= 0 && size < c.stream {
+				err = c.dec.ResetFromReadCloser(hresp.Body)
+			} else {
+				err = c.dec.ResetFromStream(hresp.Body, blockTransactionsPath, decodeTxn)
+			}
+			if err == nil {
+				resp.Reset()
+				err = resp.DecodeJSON(c.dec)
+			}
+			if err == nil {
+				err = c.dec.End()
+			}
+			// NOTE(tav): Transactions that weren't streamed, e.g. as the
+			// response was small, are passed to fn here.
+			if err == nil && resp.Block.Set {
+				txns := resp.Block.Value.Transactions
+				for i := range txns {
+					streamed = true
+					if err = fn(&txns[i]); err != nil {
+						break
+					}
+				}
+				resp.Block.Value.Transactions = txns[:0]
+			}
+			if err == nil {
+				c.reportFindings("/block")
+				c.record(inv, "/block", &it, nil)
+				return nil
+			}
+		case 500:
+			c.err.reset()
+			err = c.dec.ResetFromReadCloser(hresp.Body)
+			if err == nil {
+				err = c.err.RosettaError.DecodeJSON(c.dec)
+			}
+			if err == nil {
+				err = c.dec.End()
+			}
+			if err == nil {
+				c.reportFindings("/block")
+				err = c.err
+			}
+		default:
+			err = newStatusError("/block", hresp)
+		}
+		c.record(inv, "/block", &it, err)
+		if streamed {
+			break
+		}
+	}
+	// NOTE(tav): The context may have been done before the first attempt.
+	if err == nil {
+		err = ctx.Err()
+	}
+	// NOTE(tav): Rosetta errors are returned as is once they are no longer
+	// being retried.
+	if err == c.err {
+		return c.err
+	}
+	if err != nil {
+		c.reportLimit("/block", err)
+		c.err.reset()
+		c.err.CallError = err
+		return c.err
+	}
+	return nil
+}
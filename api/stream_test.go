@@ -0,0 +1,87 @@
+// Copyright 2021 Coinbase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/tav/validate-rosetta/json"
+	"github.com/tav/validate-rosetta/retry"
+)
+
+func TestBlockStream(t *testing.T) {
+	block := createNewBlock()
+	body := BlockResponse{Block: OptionalBlock(block)}.EncodeJSON(nil)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write(body)
+	}))
+	defer srv.Close()
+	client := NewClient(srv.URL)
+	client.SetNetwork(NetworkIdentifier{Blockchain: "test", Network: "test"})
+	for _, threshold := range []int64{0, 1 << 30} {
+		client.SetStreamThreshold(threshold)
+		resp := &BlockResponse{}
+		var txns [][]byte
+		err := client.BlockStream(context.Background(), &BlockRequest{}, resp, func(txn *Transaction) error {
+			txns = append(txns, txn.EncodeJSON(nil))
+			return nil
+		}, retry.Never)
+		if err != nil {
+			t.Fatalf("Failed to stream block with threshold %d: %s", threshold, err)
+		}
+		if len(txns) != len(block.Transactions) {
+			t.Fatalf("Got %d transactions with threshold %d, want %d", len(txns), threshold, len(block.Transactions))
+		}
+		for i, txn := range block.Transactions {
+			if string(txns[i]) != string(txn.EncodeJSON(nil)) {
+				t.Errorf("Mismatching transaction %d with threshold %d: %s", i, threshold, txns[i])
+			}
+		}
+		got := resp.Block.Value
+		if !resp.Block.Set || len(got.Transactions) != 0 {
+			t.Errorf("Unexpected block with threshold %d: %s", threshold, got.EncodeJSON(nil))
+		}
+		got.Transactions = block.Transactions
+		if !got.Equal(block) {
+			t.Errorf("Mismatching block with threshold %d: %s", threshold, got.EncodeJSON(nil))
+		}
+	}
+	client.SetStreamThreshold(0)
+	abort := errors.New("abort")
+	calls := 0
+	err := client.BlockStream(context.Background(), &BlockRequest{}, &BlockResponse{}, func(txn *Transaction) error {
+		calls++
+		return abort
+	}, retry.Default)
+	if err == nil || err.CallError != abort || calls != 1 {
+		t.Errorf("Expected the call to be aborted without retries, got %v after %d calls", err, calls)
+	}
+	// NOTE(tav): The size limit applies to the whole response, even though
+	// each streamed transaction is smaller than it.
+	var limited *json.LimitError
+	client.SetLimits(json.Limits{MaxSize: len(body) - 1}, func(endpoint string, err *json.LimitError) {
+		limited = err
+	})
+	err = client.BlockStream(context.Background(), &BlockRequest{}, &BlockResponse{}, func(txn *Transaction) error {
+		return nil
+	}, retry.Never)
+	if err == nil || limited == nil || limited.Kind != json.SizeLimit {
+		t.Errorf("Expected the size limit to be enforced on the streamed response, got %v", err)
+	}
+}
@@ -18,19 +18,54 @@ import (
 	"bytes"
 	stdjson "encoding/json"
 	"fmt"
+	"io"
 	"net/http"
+	"strconv"
+	"strings"
 	"time"
 
+	"github.com/tav/validate-rosetta/amount"
 	"github.com/tav/validate-rosetta/json"
+	"github.com/tav/validate-rosetta/retry"
 )
 
-// HTTPClient represents the global HTTP Client used to make all API calls. If
-// necessary, callers should replace this global variable with their own HTTP
-// Client before making any API calls.
+// maxRetryAfter specifies the longest interval honored from a server's
+// Retry-After header. Longer intervals are capped, so that a misbehaving server
+// can't stall calls indefinitely.
+const maxRetryAfter = 5 * time.Minute
+
+// HTTPClient represents the global HTTP Client used to make all API calls to
+// base URLs that don't have a Transport set with SetTransport. If necessary,
+// callers should replace this global variable with their own HTTP Client
+// before making any API calls.
 var HTTPClient = &http.Client{
 	Timeout: 30 * time.Second,
 }
 
+// Format returns the Amount in the standard unit of its Currency, followed by
+// the Currency symbol, e.g. "1.5 BTC". If the Value is invalid, it is used as
+// is.
+func (v Amount) Format() string {
+	x, err := amount.Parse(v.Value)
+	if err != nil {
+		return v.Value + " " + v.Currency.Symbol
+	}
+	return x.Format(v.Currency.Decimals) + " " + v.Currency.Symbol
+}
+
+// ParseValue parses the Value of the Amount.
+func (v Amount) ParseValue() (amount.Int, error) {
+	x, err := amount.Parse(v.Value)
+	if err != nil {
+		return x, fmt.Errorf("api: failed to parse Amount value: %w", err)
+	}
+	return x, nil
+}
+
+// AttemptHandler is called with the outcome of each attempt made during a
+// Client API call, e.g. to record metrics.
+type AttemptHandler func(endpoint string, a retry.Attempt)
+
 // Client handles requests to Rosetta API servers. A Client can only be used to
 // do one API call at a time. That is, do not reuse a Client while a previous
 // call is still being handled.
@@ -39,19 +74,102 @@ var HTTPClient = &http.Client{
 // before the response JSON is decoded, so it can be reused across multiple
 // Client API calls.
 type Client struct {
-	baseURL string
-	dec     *json.Decoder
-	err     *ClientError
-	netjson []byte
-	network NetworkIdentifier
-	req     []byte
+	backend    *backend
+	baseURL    string
+	dec        *json.Decoder
+	err        *ClientError
+	hedge      float64
+	middleware []Middleware
+	netjson    []byte
+	network    NetworkIdentifier
+	onAttempt  AttemptHandler
+	onFinding  FindingHandler
+	onHedge    HedgeHandler
+	onLimit    LimitHandler
+	recorder   *Recorder
+	req        []byte
+	stream     int64
 }
 
+// FindingHandler is called with the findings from decoding the response to a
+// Client API call in the json.StrictWarn mode. The findings must not be
+// retained after the handler returns.
+type FindingHandler func(endpoint string, findings []json.Finding)
+
 func (c *Client) SetNetwork(n NetworkIdentifier) {
 	c.network = n
 	c.netjson = EncodeNetworkForJSON(n)
 }
 
+// SetAttemptHandler sets the handler that is called with the outcome of each
+// attempt made during Client API calls.
+func (c *Client) SetAttemptHandler(handler AttemptHandler) {
+	c.onAttempt = handler
+}
+
+// SetLimits sets the resource limits enforced when reading and decoding
+// responses. If a call fails due to a limit being exceeded, the given handler
+// is called with the resulting error, which is also set as the CallError.
+func (c *Client) SetLimits(l json.Limits, handler LimitHandler) {
+	c.dec.SetLimits(l)
+	c.onLimit = handler
+}
+
+// SetRecorder sets the Recorder that records every request made by the Client
+// along with its response. Hedged requests whose responses weren't used aren't
+// recorded. A nil Recorder stops the recording.
+func (c *Client) SetRecorder(r *Recorder) {
+	c.recorder = r
+}
+
+// SetStreamThreshold sets the size of response, in bytes, from which
+// BlockStream calls decode the response incrementally. Responses of unknown
+// size are always decoded incrementally. The default is 1MiB.
+func (c *Client) SetStreamThreshold(n int64) {
+	c.stream = n
+}
+
+// SetStrict sets the strictness with which responses are decoded. In the
+// json.StrictWarn mode, the given handler is called with any findings after
+// each successfully decoded response.
+func (c *Client) SetStrict(s json.Strictness, handler FindingHandler) {
+	c.dec.SetStrict(s)
+	c.onFinding = handler
+}
+
+// record releases the backend for an attempt, and records its outcome.
+func (c *Client) record(inv *Invocation, endpoint string, it *retry.Iterator, err error) {
+	c.backend.release(err)
+	c.reportAttempt(inv, endpoint, it.Record(err))
+}
+
+func (c *Client) reportAttempt(inv *Invocation, endpoint string, a retry.Attempt) {
+	if inv != nil {
+		inv.Attempts = append(inv.Attempts, a)
+	}
+	if c.onAttempt != nil {
+		c.onAttempt(endpoint, a)
+	}
+}
+
+func (c *Client) reportFindings(endpoint string) {
+	if c.onFinding == nil {
+		return
+	}
+	if findings := c.dec.Findings(); len(findings) > 0 {
+		c.onFinding(endpoint, findings)
+	}
+}
+
+func (c *Client) reportLimit(endpoint string, err error) {
+	if c.onLimit == nil {
+		return
+	}
+	if lerr, ok := err.(*json.LimitError); ok {
+		c.onLimit(endpoint, lerr)
+	}
+}
+
 // ClientError represents the error encountered when making a Client API call.
 // Only one of the CallError or RosettaError fields will be set.
 //
@@ -80,11 +198,72 @@ func (c ClientError) Retriable() bool {
 	return c.CallError != nil || c.RosettaError.Retriable
 }
 
+// clone returns a copy of the ClientError that doesn't share any memory with
+// it, so that it can be retained after the Client that returned it is reused.
+func (c *ClientError) clone() *ClientError {
+	if c == nil {
+		return nil
+	}
+	err := &ClientError{
+		CallError:    c.CallError,
+		RosettaError: c.RosettaError,
+	}
+	if len(c.RosettaError.Details) > 0 {
+		err.RosettaError.Details = append(MapObject(nil), c.RosettaError.Details...)
+	}
+	return err
+}
+
+func (c *ClientError) reset() {
+	c.CallError = nil
+	c.RosettaError.Reset()
+}
+
+// Difference represents a differing value between two API values, as returned
+// by their Diff methods.
+type Difference struct {
+	// A is the JSON encoding of the value within the first API value. It is
+	// empty if the value is absent.
+	A string
+	// B is the JSON encoding of the value within the second API value. It is
+	// empty if the value is absent.
+	B string
+	// Path is the path of the value, e.g.
+	// "transactions[3].operations[1].amount.value". It is empty for the
+	// top-level value.
+	Path string
+}
+
+// String returns a description of the Difference.
+func (d Difference) String() string {
+	a, b := d.A, d.B
+	if a == "" {
+		a = "<absent>"
+	}
+	if b == "" {
+		b = "<absent>"
+	}
+	path := d.Path
+	if path == "" {
+		path = "<value>"
+	}
+	return path + ": " + a + " != " + b
+}
+
+// LimitHandler is called when a Client API call fails due to a resource limit
+// being exceeded while reading or decoding the response.
+type LimitHandler func(endpoint string, err *json.LimitError)
+
 // MapObject represents a canonical encoding of a raw map value that is used to
 // represent metadata and options within the Rosetta API.
+//
+// The encoding is produced by json.Canonicalize, so that MapObject values are
+// independent of the key order, number formatting, and string escaping used
+// by the original JSON.
 type MapObject []byte
 
-// Equal returns whether two MapObject values are equal.
+// Equal returns whether two MapObject values are equal. As MapObject values
+// are canonically encoded, this compares the values semantically.
 func (m MapObject) Equal(o MapObject) bool {
 	if len(m) != len(o) {
 		return false
@@ -127,6 +306,46 @@ func (m MapObject) RawWithJSONNumber() (map[string]interface{}, error) {
 	return raw, nil
 }
 
+// StatusError represents an unexpected HTTP status code in the response to a
+// Client API call.
+type StatusError struct {
+	Endpoint string
+	// RetryAfter specifies the interval requested by the server's Retry-After
+	// header for 429 and 503 responses, capped at 5 minutes. It is zero if no
+	// valid interval was given.
+	RetryAfter time.Duration
+	StatusCode int
+}
+
+// Error implements the error interface.
+func (e *StatusError) Error() string {
+	return fmt.Sprintf("api: got HTTP status code %d from %s", e.StatusCode, e.Endpoint)
+}
+
+// Retriable indicates whether the request could succeed if retried, i.e. if
+// the status code indicates a timeout, rate limiting, or a server error.
+func (e *StatusError) Retriable() bool {
+	return e.StatusCode == 408 || e.StatusCode == 429 || e.StatusCode >= 500
+}
+
+// DescribeDiffs returns a description of the given differences, with one
+// difference per line. At most max differences are described, or all of them
+// if max is zero.
+func DescribeDiffs(diffs []Difference, max int) string {
+	var b strings.Builder
+	for i, diff := range diffs {
+		if max > 0 && i == max {
+			fmt.Fprintf(&b, "\n... and %d more", len(diffs)-max)
+			break
+		}
+		if i > 0 {
+			b.WriteByte('\n')
+		}
+		b.WriteString(diff.String())
+	}
+	return b.String()
+}
+
 // EncodeNetworkForJSON will create a reusable encoding of the given
 // NetworkIdentifier for use in EncodeJSON calls.
 func EncodeNetworkForJSON(n NetworkIdentifier) []byte {
@@ -151,24 +370,47 @@ func MapObjectFrom(v map[string]interface{}) (MapObject, error) {
 		return nil, nil
 	}
 	enc, err := stdjson.Marshal(v)
-	// NOTE(tav): We depend on Go's lexicographic ordering of object keys for
-	// this to be deterministic.
 	if err != nil {
 		return nil, fmt.Errorf("api: failed to encode MapObject: %w", err)
 	}
+	enc, err = json.Canonicalize(nil, enc)
+	if err != nil {
+		return nil, fmt.Errorf("api: failed to canonicalize MapObject: %w", err)
+	}
 	return MapObject(enc), nil
 }
 
 // NewClient instantiates a new Client.
 func NewClient(baseURL string) *Client {
 	return &Client{
+		backend: getBackend(baseURL),
 		baseURL: baseURL,
 		dec:     json.NewDecoder(),
 		err:     &ClientError{},
 		req:     make([]byte, 0, 1024),
+		stream:  defaultStreamThreshold,
 	}
 }
 
+// appendStringSliceDiffs appends the differences between the elements of the
+// given string slices.
+func appendStringSliceDiffs(d []Difference, path string, a, b []string) []Difference {
+	for i := 0; i < len(a) || i < len(b); i++ {
+		diff := Difference{}
+		if i < len(a) {
+			diff.A = string(json.AppendString(nil, a[i]))
+		}
+		if i < len(b) {
+			diff.B = string(json.AppendString(nil, b[i]))
+		}
+		if diff.A != diff.B {
+			diff.Path = diffIndex(path, i)
+			d = append(d, diff)
+		}
+	}
+	return d
+}
+
 func appendMapObject(b []byte, m MapObject) []byte {
 	if len(m) == 0 {
 		return append(b, "{}"...)
@@ -176,6 +418,120 @@ func appendMapObject(b []byte, m MapObject) []byte {
 	return append(b, m...)
 }
 
+// classifyError classifies the errors from the attempts made during Client API
+// calls.
+func classifyError(err error) (bool, time.Duration) {
+	switch err := err.(type) {
+	case *BreakerError:
+		return true, err.RetryAfter
+	case *ClientError:
+		return err.Retriable(), 0
+	case *StatusError:
+		return err.Retriable(), err.RetryAfter
+	case *json.LimitError:
+		// NOTE(tav): Responses which exceed our limits are likely to do so
+		// again, so we don't bother retrying.
+		return false, 0
+	}
+	return true, 0
+}
+
+// decodeMapObject decodes a JSON object into the given MapObject in its
+// canonical encoding. Null and empty objects are decoded as an empty
+// MapObject.
+func decodeMapObject(d *json.Decoder, m MapObject) (MapObject, error) {
+	m = m[:0]
+	if d.Null() {
+		return m, nil
+	}
+	if d.Peek() != '{' {
+		return m, d.Errorf("expected object for MapObject value")
+	}
+	m, err := d.Canonical(m)
+	if err != nil {
+		return m[:0], err
+	}
+	if len(m) == 2 {
+		return m[:0], nil
+	}
+	return m, nil
+}
+
+// decodeStrings decodes a JSON array of strings, and appends the values to
+// the given slice. Null is decoded as an empty slice.
+func decodeStrings(d *json.Decoder, xs []string) ([]string, error) {
+	if d.Null() {
+		return xs, nil
+	}
+	more, err := d.ArrayStart()
+	for more {
+		var s []byte
+		if s, err = d.String(); err != nil {
+			return xs, err
+		}
+		xs = append(xs, string(s))
+		if more, err = d.ArrayNext(); err != nil {
+			return xs, err
+		}
+	}
+	return xs, err
+}
+
+// diffIndex returns the path of the element at the given index within the
+// array at the given path.
+func diffIndex(path string, idx int) string {
+	return path + "[" + strconv.Itoa(idx) + "]"
+}
+
+// diffPath returns the path of the given key within the object at the given
+// path.
+func diffPath(path string, key string) string {
+	if path == "" {
+		return key
+	}
+	return path + "." + key
+}
+
+// newStatusError returns a StatusError for the given response, after
+// discarding its body.
+func newStatusError(endpoint string, hresp *http.Response) *StatusError {
+	io.Copy(io.Discard, hresp.Body)
+	hresp.Body.Close()
+	err := &StatusError{
+		Endpoint:   endpoint,
+		StatusCode: hresp.StatusCode,
+	}
+	if hresp.StatusCode == 429 || hresp.StatusCode == 503 {
+		err.RetryAfter = parseRetryAfter(hresp.Header.Get("Retry-After"), time.Now())
+	}
+	return err
+}
+
+// parseRetryAfter parses the value of a Retry-After header, which can either
+// be a number of seconds, or an HTTP date. Invalid and negative values are
+// ignored, and intervals longer than maxRetryAfter are capped.
+func parseRetryAfter(v string, now time.Time) time.Duration {
+	if v == "" {
+		return 0
+	}
+	if secs, err := strconv.ParseUint(v, 10, 64); err == nil {
+		// NOTE(tav): We compare in seconds, as converting large values to a
+		// Duration would overflow.
+		if secs > uint64(maxRetryAfter/time.Second) {
+			return maxRetryAfter
+		}
+		return time.Duration(secs) * time.Second
+	}
+	t, err := http.ParseTime(v)
+	if err != nil || !t.After(now) {
+		return 0
+	}
+	if d := t.Sub(now); d < maxRetryAfter {
+		return d
+	}
+	return maxRetryAfter
+}
+
 // StringSliceEqual returns whether the given string slice values are equal.
 func stringSliceEqual(a, b []string) bool {
 	if len(a) != len(b) {
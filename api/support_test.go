@@ -0,0 +1,263 @@
+// Copyright 2021 Coinbase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"encoding/json"
+	"math"
+	"math/rand"
+	"reflect"
+	"strconv"
+	"strings"
+	"testing"
+
+	jsonpkg "github.com/tav/validate-rosetta/json"
+)
+
+const (
+	fuzzSeeds  = 4
+	roundTrips = 50
+)
+
+// testRunes are used to generate random strings that exercise the escaping
+// done by the encoder.
+var testRunes = []rune{
+	0, '\b', '\t', '\n', '\f', '\r', 0x1f, ' ', '"', '&', '/', '0', '<', '>',
+	'A', '\\', 'z', 0x7f, 0xe9, 0x2028, 0x2029, 0x20ac, 0xfeff, 0xfffd, 0x1f600,
+}
+
+// testRand generates random API values for the generated round-trip tests and
+// fuzz targets. The methods for each model are defined in api_test.go.
+type testRand struct {
+	*rand.Rand
+}
+
+func (r *testRand) bool() bool {
+	return r.Intn(2) == 0
+}
+
+func (r *testRand) bytes() []byte {
+	b := make([]byte, r.Intn(8))
+	r.Read(b)
+	return b
+}
+
+func (r *testRand) float64(minZero bool) float64 {
+	v := r.NormFloat64() * math.Pow(10, float64(r.Intn(50)-25))
+	if minZero {
+		return math.Abs(v)
+	}
+	return v
+}
+
+func (r *testRand) int32(minZero bool) int32 {
+	v := r.Int31() >> r.Intn(31)
+	if !minZero && r.bool() {
+		return -v - 1
+	}
+	return v
+}
+
+func (r *testRand) int64(minZero bool) int64 {
+	v := r.Int63() >> r.Intn(63)
+	if !minZero && r.bool() {
+		return -v - 1
+	}
+	return v
+}
+
+// jsonValue appends a random JSON value, nesting at most depth levels of
+// arrays and objects.
+func (r *testRand) jsonValue(b []byte, depth int) []byte {
+	n := 7
+	if depth == 0 {
+		n = 5
+	}
+	switch r.Intn(n) {
+	case 0:
+		return append(b, "null"...)
+	case 1:
+		return strconv.AppendBool(b, r.bool())
+	case 2:
+		return strconv.AppendInt(b, r.int64(false), 10)
+	case 3:
+		return strconv.AppendFloat(b, r.float64(false), 'g', -1, 64)
+	case 4:
+		return jsonpkg.AppendString(b, r.string())
+	case 5:
+		b = append(b, '[')
+		for i := r.length(); i > 0; i-- {
+			b = r.jsonValue(b, depth-1)
+			if i > 1 {
+				b = append(b, ',')
+			}
+		}
+		return append(b, ']')
+	}
+	return r.jsonObject(b, depth-1)
+}
+
+func (r *testRand) jsonObject(b []byte, depth int) []byte {
+	b = append(b, '{')
+	for i := r.length() + 1; i > 0; i-- {
+		b = jsonpkg.AppendString(b, r.string())
+		b = append(b, ':')
+		b = r.jsonValue(b, depth)
+		if i > 1 {
+			b = append(b, ',')
+		}
+	}
+	return append(b, '}')
+}
+
+func (r *testRand) length() int {
+	return r.Intn(3)
+}
+
+func (r *testRand) mapObject() MapObject {
+	if r.bool() {
+		return nil
+	}
+	m, err := jsonpkg.Canonicalize(nil, r.jsonObject(nil, 2))
+	if err != nil {
+		panic(err)
+	}
+	return m
+}
+
+func (r *testRand) oneOf(variants ...string) string {
+	return variants[r.Intn(len(variants))]
+}
+
+func (r *testRand) optional() bool {
+	return r.bool()
+}
+
+func (r *testRand) string() string {
+	if r.bool() {
+		return strconv.FormatUint(r.Uint64(), 36)
+	}
+	var b strings.Builder
+	for i := r.Intn(12); i > 0; i-- {
+		b.WriteRune(testRunes[r.Intn(len(testRunes))])
+	}
+	return b.String()
+}
+
+func (r *testRand) strings() []string {
+	var xs []string
+	for i := r.length(); i > 0; i-- {
+		xs = append(xs, r.string())
+	}
+	return xs
+}
+
+// checkRoundTrip decodes the given encoding of a random API value, and checks
+// that the decoded value has no differences from the original. If sdk is not
+// nil, the encoding is also decoded into the rosetta-sdk-go value, and the
+// re-encoding by encoding/json is checked to be semantically equal.
+func checkRoundTrip(t *testing.T, enc []byte, decode func(d *jsonpkg.Decoder) ([]Difference, error), sdk interface{}) {
+	t.Helper()
+	dec := jsonpkg.NewDecoder()
+	dec.SetStrict(jsonpkg.StrictError)
+	dec.ResetFromBytes(enc)
+	diffs, err := decode(dec)
+	if err == nil {
+		err = dec.End()
+	}
+	if err != nil {
+		t.Fatalf("Failed to decode value: %s\n\n%s", err, jsonpkg.Pretty(enc))
+	}
+	if len(diffs) > 0 {
+		t.Fatalf("Mismatching value after round-trip:\n%s", DescribeDiffs(diffs, 10))
+	}
+	if sdk == nil {
+		return
+	}
+	if err := json.Unmarshal(enc, sdk); err != nil {
+		t.Fatalf("Failed to decode value with rosetta-sdk-go: %s\n\n%s", err, jsonpkg.Pretty(enc))
+	}
+	sdkEnc, err := json.Marshal(sdk)
+	if err != nil {
+		t.Fatalf("Failed to encode value with rosetta-sdk-go: %s", err)
+	}
+	var a, b interface{}
+	if err := json.Unmarshal(enc, &a); err != nil {
+		t.Fatalf("Failed to decode value with encoding/json: %s", err)
+	}
+	if err := json.Unmarshal(sdkEnc, &b); err != nil {
+		t.Fatalf("Failed to decode rosetta-sdk-go value with encoding/json: %s", err)
+	}
+	if path, ok := semanticEqual(a, b, ""); !ok {
+		t.Fatalf(
+			"Mismatching encoding from rosetta-sdk-go at %q:\n\n%s\n\n%s",
+			path, jsonpkg.Pretty(enc), jsonpkg.Pretty(sdkEnc),
+		)
+	}
+}
+
+// fuzzDecode decodes arbitrary data using the given function, and returns the
+// re-encoded value if the data was decoded without any errors or findings.
+func fuzzDecode(data []byte, decode func(d *jsonpkg.Decoder) ([]byte, error)) ([]byte, bool) {
+	dec := jsonpkg.NewDecoder()
+	dec.SetStrict(jsonpkg.StrictWarn)
+	dec.ResetFromBytes(data)
+	enc, err := decode(dec)
+	if err != nil || dec.End() != nil || len(dec.Findings()) > 0 {
+		return nil, false
+	}
+	return enc, true
+}
+
+func newTestRand() *testRand {
+	return &testRand{rand.New(rand.NewSource(1))}
+}
+
+// semanticEqual returns whether the given values, as decoded by encoding/json,
+// are equal. Deprecated fields that are only present in b, i.e. derived by
+// rosetta-sdk-go from their replacements, are ignored. If the values aren't
+// equal, the path of the first difference is returned.
+func semanticEqual(a interface{}, b interface{}, path string) (string, bool) {
+	switch a := a.(type) {
+	case map[string]interface{}:
+		b, ok := b.(map[string]interface{})
+		if !ok {
+			return path, false
+		}
+		for key, val := range a {
+			if path, ok := semanticEqual(val, b[key], diffPath(path, key)); !ok {
+				return path, false
+			}
+		}
+		for key := range b {
+			if _, ok := a[key]; !ok && !deprecatedKeys[key] {
+				return diffPath(path, key), false
+			}
+		}
+		return "", true
+	case []interface{}:
+		b, ok := b.([]interface{})
+		if !ok || len(a) != len(b) {
+			return path, false
+		}
+		for i, elem := range a {
+			if path, ok := semanticEqual(elem, b[i], diffIndex(path, i)); !ok {
+				return path, false
+			}
+		}
+		return "", true
+	}
+	return path, reflect.DeepEqual(a, b)
+}
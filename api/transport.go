@@ -0,0 +1,310 @@
+// Copyright 2021 Coinbase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"crypto/tls"
+	"fmt"
+	"net"
+	"net/http"
+	"sync/atomic"
+	"time"
+
+	"golang.org/x/net/http2"
+)
+
+// Defaults for Transport settings, which match those of the HTTPClient.
+const (
+	defaultDialTimeout         = 30 * time.Second
+	defaultIdleConnTimeout     = 90 * time.Second
+	defaultTimeout             = 30 * time.Second
+	defaultTLSHandshakeTimeout = 10 * time.Second
+)
+
+// EndpointTransport overrides the timeouts of a Transport for a specific
+// endpoint. Zero values inherit the timeouts of the Transport.
+type EndpointTransport struct {
+	DialTimeout           time.Duration
+	ResponseHeaderTimeout time.Duration
+	TLSHandshakeTimeout   time.Duration
+	Timeout               time.Duration
+}
+
+// Transport defines the HTTP settings used by all Clients for a base URL.
+// Zero values use the same defaults as the HTTPClient.
+type Transport struct {
+	// DialTimeout limits the time taken to establish connections. It
+	// defaults to 30 seconds.
+	DialTimeout time.Duration
+	// DisableCompression stops responses from being requested with gzip
+	// compression.
+	DisableCompression bool
+	// DisableHTTP2 stops HTTP/2 from being used for TLS connections.
+	DisableHTTP2 bool
+	// Endpoints overrides the timeouts for specific endpoints, e.g. "/block".
+	Endpoints map[string]EndpointTransport
+	// GzipRequests compresses request bodies with gzip.
+	GzipRequests bool
+	// H2C uses HTTP/2 without TLS, i.e. "h2c", for all connections. As
+	// requests are multiplexed over a single connection to each host,
+	// MaxIdleConnsPerHost doesn't apply.
+	H2C bool
+	// Headers specifies static headers to send with each request, e.g. API
+	// keys. A "Host" header overrides the host of requests.
+	Headers http.Header
+	// IdleConnTimeout limits how long idle connections are kept open. It
+	// defaults to 90 seconds.
+	IdleConnTimeout time.Duration
+	// MaxIdleConnsPerHost limits the number of idle connections that are kept
+	// open. It defaults to 2.
+	MaxIdleConnsPerHost int
+	// RoundTripper, if set, is used to make requests instead of a transport
+	// built from these settings, e.g. a Replay.
+	RoundTripper http.RoundTripper
+	// ResponseHeaderTimeout limits the time spent waiting for response
+	// headers after a request has been written. It is unlimited by default.
+	ResponseHeaderTimeout time.Duration
+	// TLS specifies the TLS configuration, e.g. with client certificates or
+	// custom root CAs.
+	TLS *tls.Config
+	// TLSHandshakeTimeout limits the time taken by TLS handshakes. It
+	// defaults to 10 seconds.
+	TLSHandshakeTimeout time.Duration
+	// Timeout limits the total time taken by each request, including reading
+	// the response body. It defaults to 30 seconds.
+	Timeout time.Duration
+}
+
+// endpoint returns the settings for the given endpoint.
+func (t Transport) endpoint(endpoint string) EndpointTransport {
+	e := t.Endpoints[endpoint]
+	if e.DialTimeout == 0 {
+		e.DialTimeout = t.DialTimeout
+	}
+	if e.ResponseHeaderTimeout == 0 {
+		e.ResponseHeaderTimeout = t.ResponseHeaderTimeout
+	}
+	if e.TLSHandshakeTimeout == 0 {
+		e.TLSHandshakeTimeout = t.TLSHandshakeTimeout
+	}
+	if e.Timeout == 0 {
+		e.Timeout = t.Timeout
+	}
+	return e
+}
+
+// httpTransport holds the HTTP Clients built from a Transport.
+type httpTransport struct {
+	// NOTE(tav): Endpoints only get their own HTTP Client, and thus their
+	// own connections, if they override the dial or TLS handshake timeouts.
+	clients map[string]*http.Client
+	client  *http.Client
+	config  Transport
+	host    string
+}
+
+// SetTransport sets the HTTP settings used by all Clients for the given base
+// URL, in place of the global HTTPClient.
+func SetTransport(baseURL string, t Transport) {
+	if t.DialTimeout == 0 {
+		t.DialTimeout = defaultDialTimeout
+	}
+	if t.IdleConnTimeout == 0 {
+		t.IdleConnTimeout = defaultIdleConnTimeout
+	}
+	if t.TLSHandshakeTimeout == 0 {
+		t.TLSHandshakeTimeout = defaultTLSHandshakeTimeout
+	}
+	if t.Timeout == 0 {
+		t.Timeout = defaultTimeout
+	}
+	headers := http.Header{}
+	for key, vals := range t.Headers {
+		headers[http.CanonicalHeaderKey(key)] = append([]string(nil), vals...)
+	}
+	t.Headers = headers
+	ht := &httpTransport{
+		client:  newHTTPClient(t, t.endpoint("")),
+		clients: map[string]*http.Client{},
+		config:  t,
+		host:    headers.Get("Host"),
+	}
+	headers.Del("Host")
+	for endpoint, e := range t.Endpoints {
+		if e.DialTimeout != 0 || e.TLSHandshakeTimeout != 0 {
+			ht.clients[endpoint] = newHTTPClient(t, t.endpoint(endpoint))
+		}
+	}
+	b := getBackend(baseURL)
+	b.mu.Lock()
+	b.transport = ht
+	b.mu.Unlock()
+}
+
+// send sends the given encoded request to the given endpoint, recording the
+// exchange if a Recorder has been set.
+func (c *Client) send(ctx context.Context, endpoint string, body []byte) (*http.Response, error) {
+	hresp, x, err := c.sendPending(ctx, endpoint, body)
+	if x != nil {
+		c.recorder.record(x)
+	}
+	return hresp, err
+}
+
+// sendPending is like send, except that if the request fails, the Exchange
+// for it is returned instead of being recorded, so that the caller can decide
+// whether to record it, e.g. hedged requests that lost aren't recorded.
+func (c *Client) sendPending(
+	ctx context.Context, endpoint string, body []byte,
+) (*http.Response, *Exchange, error) {
+	if c.recorder == nil {
+		hresp, err := c.roundTrip(ctx, endpoint, body)
+		return hresp, nil, err
+	}
+	x := &Exchange{
+		Endpoint: endpoint,
+		Hash:     RequestHash(endpoint, body),
+		Request:  append([]byte(nil), body...),
+	}
+	start := time.Now()
+	hresp, err := c.roundTrip(ctx, endpoint, body)
+	if err != nil {
+		x.Error = err.Error()
+		x.Latency = time.Since(start)
+		return nil, x, err
+	}
+	x.Status = hresp.StatusCode
+	hresp.Body = &recordBody{
+		max:   c.dec.Limits().MaxSize,
+		rc:    hresp.Body,
+		rec:   c.recorder,
+		start: start,
+		x:     x,
+	}
+	return hresp, nil, nil
+}
+
+// roundTrip sends the given encoded request to the given endpoint.
+func (c *Client) roundTrip(ctx context.Context, endpoint string, body []byte) (*http.Response, error) {
+	c.backend.mu.Lock()
+	ht := c.backend.transport
+	c.backend.mu.Unlock()
+	if ht == nil {
+		hreq, err := http.NewRequestWithContext(ctx, "POST", c.baseURL+endpoint, bytes.NewReader(body))
+		if err != nil {
+			return nil, err
+		}
+		hreq.Header.Set("Content-Type", "application/json")
+		return HTTPClient.Do(hreq)
+	}
+	t := ht.config
+	if t.GzipRequests {
+		buf := &bytes.Buffer{}
+		zw := gzip.NewWriter(buf)
+		zw.Write(body)
+		if err := zw.Close(); err != nil {
+			return nil, fmt.Errorf("api: failed to compress request: %w", err)
+		}
+		body = buf.Bytes()
+	}
+	e := t.endpoint(endpoint)
+	ctx, cancel := context.WithTimeout(ctx, e.Timeout)
+	hreq, err := http.NewRequestWithContext(ctx, "POST", c.baseURL+endpoint, bytes.NewReader(body))
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+	for key, vals := range t.Headers {
+		hreq.Header[key] = vals
+	}
+	hreq.Header.Set("Content-Type", "application/json")
+	if t.GzipRequests {
+		hreq.Header.Set("Content-Encoding", "gzip")
+	}
+	if ht.host != "" {
+		hreq.Host = ht.host
+	}
+	client := ht.clients[endpoint]
+	if client == nil {
+		client = ht.client
+	}
+	// NOTE(tav): We enforce the response header timeout ourselves, as it
+	// isn't supported by the HTTP/2 transport, and can vary by endpoint.
+	var expired int32
+	if e.ResponseHeaderTimeout > 0 {
+		timer := time.AfterFunc(e.ResponseHeaderTimeout, func() {
+			atomic.StoreInt32(&expired, 1)
+			cancel()
+		})
+		defer timer.Stop()
+	}
+	hresp, err := client.Do(hreq)
+	if err != nil {
+		cancel()
+		if atomic.LoadInt32(&expired) == 1 {
+			return nil, fmt.Errorf(
+				"api: timed out waiting for response headers from %s after %s",
+				endpoint, e.ResponseHeaderTimeout,
+			)
+		}
+		return nil, err
+	}
+	hresp.Body = &cancelBody{hresp.Body, cancel}
+	return hresp, nil
+}
+
+func newHTTPClient(t Transport, e EndpointTransport) *http.Client {
+	if t.RoundTripper != nil {
+		return &http.Client{Transport: t.RoundTripper}
+	}
+	dialer := &net.Dialer{
+		KeepAlive: 30 * time.Second,
+		Timeout:   e.DialTimeout,
+	}
+	if t.H2C {
+		return &http.Client{
+			Transport: &http2.Transport{
+				AllowHTTP: true,
+				DialTLSContext: func(
+					ctx context.Context, network, addr string, cfg *tls.Config,
+				) (net.Conn, error) {
+					return dialer.DialContext(ctx, network, addr)
+				},
+				DisableCompression: t.DisableCompression,
+				IdleConnTimeout:    t.IdleConnTimeout,
+			},
+		}
+	}
+	var tlsConfig *tls.Config
+	if t.TLS != nil {
+		tlsConfig = t.TLS.Clone()
+	}
+	return &http.Client{
+		Transport: &http.Transport{
+			DialContext:         dialer.DialContext,
+			DisableCompression:  t.DisableCompression,
+			ForceAttemptHTTP2:   !t.DisableHTTP2,
+			IdleConnTimeout:     t.IdleConnTimeout,
+			MaxIdleConns:        100,
+			MaxIdleConnsPerHost: t.MaxIdleConnsPerHost,
+			Proxy:               http.ProxyFromEnvironment,
+			TLSClientConfig:     tlsConfig,
+			TLSHandshakeTimeout: e.TLSHandshakeTimeout,
+		},
+	}
+}
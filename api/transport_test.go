@@ -0,0 +1,102 @@
+// Copyright 2021 Coinbase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"compress/gzip"
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/tav/validate-rosetta/retry"
+	"golang.org/x/net/http2"
+	"golang.org/x/net/http2/h2c"
+)
+
+func TestTransport(t *testing.T) {
+	type request struct {
+		body    string
+		host    string
+		key     string
+		path    string
+		version int
+	}
+	reqs := make(chan request, 1)
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body io.Reader = r.Body
+		if r.Header.Get("Content-Encoding") == "gzip" {
+			zr, err := gzip.NewReader(r.Body)
+			if err != nil {
+				w.WriteHeader(400)
+				return
+			}
+			body = zr
+		}
+		data, _ := io.ReadAll(body)
+		reqs <- request{string(data), r.Host, r.Header.Get("X-Api-Key"), r.URL.Path, r.ProtoMajor}
+		if r.URL.Path == "/network/status" {
+			time.Sleep(50 * time.Millisecond)
+		}
+		w.Write(BlockResponse{}.EncodeJSON(nil))
+	})
+	for _, h2 := range []bool{false, true} {
+		var srv *httptest.Server
+		if h2 {
+			srv = httptest.NewServer(h2c.NewHandler(handler, &http2.Server{}))
+		} else {
+			srv = httptest.NewServer(handler)
+		}
+		SetTransport(srv.URL, Transport{
+			Endpoints: map[string]EndpointTransport{
+				"/network/status": {ResponseHeaderTimeout: 10 * time.Millisecond},
+			},
+			GzipRequests: true,
+			H2C:          h2,
+			Headers: http.Header{
+				"host":      {"rosetta.test"},
+				"x-api-key": {"secret"},
+			},
+		})
+		client := NewClient(srv.URL)
+		client.SetNetwork(NetworkIdentifier{Blockchain: "test", Network: "test"})
+		if err := client.Block(context.Background(), &BlockRequest{}, &BlockResponse{}, retry.Never); err != nil {
+			t.Fatalf("Unexpected error with h2c=%v: %s", h2, err)
+		}
+		req := <-reqs
+		want := request{
+			body:    string(BlockRequest{}.EncodeJSON(nil, client.netjson)),
+			host:    "rosetta.test",
+			key:     "secret",
+			path:    "/block",
+			version: 1,
+		}
+		if h2 {
+			want.version = 2
+		}
+		if req != want {
+			t.Errorf("Unexpected request with h2c=%v: got %+v, want %+v", h2, req, want)
+		}
+		err := client.NetworkStatus(context.Background(), &NetworkRequest{}, &NetworkStatusResponse{}, retry.Never)
+		<-reqs
+		if err == nil || !strings.Contains(err.Error(), "timed out waiting for response headers") {
+			t.Errorf("Expected response header timeout with h2c=%v, got: %v", h2, err)
+		}
+		srv.Close()
+	}
+}
@@ -12,7 +12,8 @@
 // See the License for the specific language governing permissions and
 // limitations under the License.
 
-// Command genapi generates api/api.go from the Rosetta spec.
+// Command genapi generates api/api.go from the Rosetta spec, along with the
+// round-trip tests and fuzz targets for its models.
 package main
 
 import (
@@ -75,6 +76,11 @@ type Model struct {
 	Validate        bool
 }
 
+// Deprecated returns whether the field has been deprecated in the spec.
+func (f *Field) Deprecated() bool {
+	return strings.HasPrefix(f.Description, "[DEPRECATED")
+}
+
 func (m *Model) ValidateStatus() bool {
 	return len(m.Enum) > 0 || m.MinZero
 }
@@ -148,6 +154,7 @@ func genFile(endpoints []*Endpoint, models []*Model) []byte {
 	writePrelude(buf)
 	writeEnums(buf, models)
 	writeEndpoints(buf, endpoints)
+	writePoolEndpoints(buf, endpoints)
 	writeModels(buf, models)
 	if exitBefore == "format:noprint" {
 		os.Exit(0)
@@ -156,16 +163,42 @@ func genFile(endpoints []*Endpoint, models []*Model) []byte {
 		fmt.Println(buf.String())
 		os.Exit(0)
 	}
-	src := buf.Bytes()
+	dst := formatFile(buf.Bytes())
+	if exitBefore == "writeFile" {
+		fmt.Println(string(dst))
+		os.Exit(0)
+	}
+	return dst
+}
+
+func genFuzzFile(models []*Model) []byte {
+	buf := &bytes.Buffer{}
+	writeTestPrelude(buf, "go1.18")
+	for _, model := range models {
+		if model.Type == "struct" {
+			writeFuzzFunc(buf, model)
+		}
+	}
+	return formatFile(buf.Bytes())
+}
+
+func genTestFile(models []*Model) []byte {
+	buf := &bytes.Buffer{}
+	writeTestPrelude(buf, "")
+	writeDeprecatedKeys(buf, models)
+	for _, model := range models {
+		writeRandFunc(buf, model)
+	}
+	writeRoundTripFunc(buf, models)
+	return formatFile(buf.Bytes())
+}
+
+func formatFile(src []byte) []byte {
 	dst, err := format.Source(src)
 	if err != nil {
 		logFormatError(src, err)
 		log.Fatalf("Failed to format generated Go code: %s", err)
 	}
-	if exitBefore == "writeFile" {
-		fmt.Println(string(dst))
-		os.Exit(0)
-	}
 	return dst
 }
 
@@ -595,6 +628,94 @@ func writeCommentLine(b *bytes.Buffer, src []byte, prefix []byte, limit int) {
 	}
 }
 
+func writeDecodeJSONField(b *bytes.Buffer, field *Field) {
+	ident := field.Ident
+	if field.OptionalType != "" {
+		// NOTE(tav): We treat null values as being equivalent to the optional
+		// field being unset, but report them in strict mode.
+		fmt.Fprintf(b, `		if d.Null() {
+			v.%s.Set = false
+			err = d.Report(json.NullOptional)
+			break
+		}
+		v.%s.Set = true
+`, ident, ident)
+		ident += ".Value"
+	} else if field.Optional {
+		fmt.Fprintf(b, `		if d.Null() {
+			v.%s = v.%s[:0]
+			err = d.Report(json.NullOptional)
+			break
+		}
+`, ident, ident)
+	}
+	switch field.Type {
+	case "string":
+		fmt.Fprintf(b, `		var s []byte
+		s, err = d.String()
+		v.%s = string(s)
+`, ident)
+	case "int32":
+		fmt.Fprintf(b, "\t\tv.%s, err = d.Int32()\n", ident)
+	case "int64":
+		fmt.Fprintf(b, "\t\tv.%s, err = d.Int64()\n", ident)
+	case "bool":
+		fmt.Fprintf(b, "\t\tv.%s, err = d.Bool()\n", ident)
+	case "float64":
+		fmt.Fprintf(b, "\t\tv.%s, err = d.Float64()\n", ident)
+	case "MapObject":
+		fmt.Fprintf(b, "\t\tv.%s, err = decodeMapObject(d, v.%s)\n", ident, ident)
+	case "[]byte":
+		fmt.Fprintf(b, "\t\tv.%s, err = d.HexBytes(v.%s[:0])\n", ident, ident)
+	case "[]string":
+		fmt.Fprintf(b, "\t\tv.%s, err = decodeStrings(d, v.%s[:0])\n", ident, ident)
+	default:
+		switch field.Model.Type {
+		case "struct":
+			if field.Slice {
+				fmt.Fprintf(b, `		v.%s = v.%s[:0]
+		if d.Null() {
+			break
+		}
+		var next bool
+		if next, err = d.ArrayStart(); err != nil {
+			break
+		}
+		for next {
+			n := len(v.%s)
+			if n < cap(v.%s) {
+				v.%s = v.%s[:n+1]
+				v.%s[n].Reset()
+			} else {
+				v.%s = append(v.%s, %s{})
+			}
+			if err = v.%s[n].DecodeJSON(d); err != nil {
+				break
+			}
+			if next, err = d.ArrayNext(); err != nil {
+				break
+			}
+		}
+`, ident, ident, ident, ident, ident, ident, ident, ident, ident, field.Model.Name, ident)
+			} else {
+				fmt.Fprintf(b, "\t\terr = v.%s.DecodeJSON(d)\n", ident)
+			}
+		case "string":
+			fmt.Fprintf(b, `		var s []byte
+		s, err = d.String()
+		v.%s = %s(s)
+`, ident, field.Model.Name)
+		case "int64":
+			fmt.Fprintf(b, `		var n int64
+		n, err = d.Int64()
+		v.%s = %s(n)
+`, ident, field.Model.Name)
+		default:
+			log.Fatalf("Unexpected field for DecodeJSON: %s", field.Ident)
+		}
+	}
+}
+
 func writeDecodeJSONFunc(b *bytes.Buffer, model *Model) {
 	article := "a"
 	switch model.Name[0] {
@@ -603,19 +724,215 @@ func writeDecodeJSONFunc(b *bytes.Buffer, model *Model) {
 	}
 	fmt.Fprintf(b, "// DecodeJSON decodes %s %s value from JSON.\n", article, model.Name)
 	if model.Network {
-		fmt.Fprintf(b, `func (v %s) DecodeJSON(d *json.Decoder, network *NetworkIdentifier) error {
+		fmt.Fprintf(b, `func (v *%s) DecodeJSON(d *json.Decoder, network *NetworkIdentifier) error {
 `, model.Name)
 	} else {
-		fmt.Fprintf(b, `func (v %s) DecodeJSON(d *json.Decoder) error {
+		fmt.Fprintf(b, `func (v *%s) DecodeJSON(d *json.Decoder) error {
 `, model.Name)
 	}
+	var required []*Field
 	for _, field := range model.Fields {
-		_ = field
+		if !field.Optional {
+			required = append(required, field)
+		}
 	}
-	b.WriteString(`	fmt.Println(string(d.Buf))
-	return nil
+	if len(required) > 64 {
+		log.Fatalf("Too many required fields for DecodeJSON in %s", model.Name)
+	}
+	b.WriteString(`	more, err := d.ObjectStart()
+	if err != nil {
+		return err
+	}
+`)
+	if len(required) > 0 {
+		b.WriteString("\tseen := uint64(0)\n")
+	}
+	b.WriteString(`	for more {
+		var key []byte
+		if key, err = d.Key(); err != nil {
+			return err
+		}
+		switch string(key) {
+`)
+	if model.Network {
+		b.WriteString(`	case "network_identifier":
+		if network == nil {
+			err = d.Skip()
+		} else {
+			err = network.DecodeJSON(d)
+		}
+`)
+	}
+	bit := 0
+	for _, field := range model.Fields {
+		fmt.Fprintf(b, "\tcase %q:\n", field.Name)
+		writeDecodeJSONField(b, field)
+		if !field.Optional {
+			fmt.Fprintf(b, "\t\tseen |= 1 << %d\n", bit)
+			bit++
+		}
+	}
+	b.WriteString(`		default:
+			if err = d.Report(json.UnknownKey); err == nil {
+				err = d.Skip()
+			}
+		}
+		if err != nil {
+			return err
+		}
+		if more, err = d.ObjectNext(); err != nil {
+			return err
+		}
+	}
+`)
+	for i, field := range required {
+		fmt.Fprintf(b, `	if seen&(1 << %d) == 0 {
+		return d.Errorf(%q)
+	}
+`, i, fmt.Sprintf("missing required field %q in %s", field.Name, model.Name))
+	}
+	b.WriteString(`	return nil
+}
+
+`)
 }
+
+func writeDeprecatedKeys(b *bytes.Buffer, models []*Model) {
+	keys := map[string]bool{}
+	for _, model := range models {
+		for _, field := range model.Fields {
+			if field.Deprecated() {
+				keys[field.Name] = true
+			}
+		}
+	}
+	var names []string
+	for name := range keys {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	b.WriteString(`// deprecatedKeys specifies the JSON keys of deprecated fields, which are
+// derived from their replacements when encoded by rosetta-sdk-go.
+var deprecatedKeys = map[string]bool{
 `)
+	for _, name := range names {
+		fmt.Fprintf(b, "\t%q: true,\n", name)
+	}
+	b.WriteString("}\n\n")
+}
+
+// writeDiffField writes the code to compare the given field, where ident is
+// the field's identifier, including any ".Value" suffix for optional fields.
+func writeDiffField(b *bytes.Buffer, field *Field, ident string, path string, tabs string) {
+	var enc, ne string
+	switch field.Type {
+	case "string":
+		enc = "json.AppendString(nil, %s)"
+	case "int64":
+		enc = "json.AppendInt(nil, %s)"
+	case "int32":
+		enc = "json.AppendInt(nil, int64(%s))"
+	case "bool":
+		enc = "json.AppendBool(nil, %s)"
+	case "float64":
+		enc = "json.AppendFloat(nil, %s)"
+	case "MapObject":
+		enc = "appendMapObject(nil, %s)"
+		ne = "string(v.%s) != string(o.%s)"
+	case "[]byte":
+		enc = "json.AppendHexBytes(nil, %s)"
+		ne = "string(v.%s) != string(o.%s)"
+	case "[]string":
+		fmt.Fprintf(b, "%sd = appendStringSliceDiffs(d, %s, v.%s, o.%s)\n", tabs, path, ident, ident)
+		return
+	default:
+		switch field.Model.Type {
+		case "struct":
+			if field.Slice {
+				fmt.Fprintf(b, `%[1]sfor i := 0; i < len(v.%[2]s) || i < len(o.%[2]s); i++ {
+%[1]s	p := diffIndex(%[3]s, i)
+%[1]s	switch {
+%[1]s	case i >= len(o.%[2]s):
+%[1]s		d = append(d, Difference{A: string(v.%[2]s[i].EncodeJSON(nil)), Path: p})
+%[1]s	case i >= len(v.%[2]s):
+%[1]s		d = append(d, Difference{B: string(o.%[2]s[i].EncodeJSON(nil)), Path: p})
+%[1]s	default:
+%[1]s		d = v.%[2]s[i].appendDiffs(d, p, o.%[2]s[i])
+%[1]s	}
+%[1]s}
+`, tabs, ident, path)
+			} else {
+				fmt.Fprintf(b, "%sd = v.%s.appendDiffs(d, %s, o.%s)\n", tabs, ident, path, ident)
+			}
+			return
+		case "string":
+			enc = "json.AppendString(nil, string(%s))"
+		case "int64":
+			enc = "json.AppendInt(nil, int64(%s))"
+		default:
+			log.Fatalf("Unexpected field for Diff: %s", field.Ident)
+		}
+	}
+	if ne == "" {
+		ne = "v.%s != o.%s"
+	}
+	fmt.Fprintf(b, `%sif %s {
+%s	d = append(d, Difference{
+%s		A:    string(%s),
+%s		B:    string(%s),
+%s		Path: %s,
+%s	})
+%s}
+`, tabs, fmt.Sprintf(ne, ident, ident), tabs, tabs, fmt.Sprintf(enc, "v."+ident),
+		tabs, fmt.Sprintf(enc, "o."+ident), tabs, path, tabs, tabs)
+}
+
+func writeDiffFunc(b *bytes.Buffer, model *Model) {
+	fmt.Fprintf(b, `// Diff returns the differences between two %s values.
+func (v %s) Diff(o %s) []Difference {
+	return v.appendDiffs(nil, "", o)
+}
+
+func (v %s) appendDiffs(d []Difference, path string, o %s) []Difference {
+`, model.Name, model.Name, model.Name, model.Name, model.Name)
+	for _, field := range model.Fields {
+		path := fmt.Sprintf("diffPath(path, %q)", field.Name)
+		if field.OptionalType == "" {
+			writeDiffField(b, field, field.Ident, path, "\t")
+			continue
+		}
+		enc := "v.%s.Value.EncodeJSON(nil)"
+		switch field.Type {
+		case "string":
+			enc = "json.AppendString(nil, v.%s.Value)"
+		case "int64":
+			enc = "json.AppendInt(nil, v.%s.Value)"
+		case "int32":
+			enc = "json.AppendInt(nil, int64(v.%s.Value))"
+		case "bool":
+			enc = "json.AppendBool(nil, v.%s.Value)"
+		case "float64":
+			enc = "json.AppendFloat(nil, v.%s.Value)"
+		default:
+			switch field.Model.Type {
+			case "string":
+				enc = "json.AppendString(nil, string(v.%s.Value))"
+			case "int64":
+				enc = "json.AppendInt(nil, int64(v.%s.Value))"
+			}
+		}
+		fmt.Fprintf(b, `	switch {
+	case v.%[1]s.Set && o.%[1]s.Set:
+`, field.Ident)
+		writeDiffField(b, field, field.Ident+".Value", path, "\t\t")
+		fmt.Fprintf(b, `	case v.%[1]s.Set:
+		d = append(d, Difference{A: string(%[2]s), Path: %[3]s})
+	case o.%[1]s.Set:
+		d = append(d, Difference{B: string(%[4]s), Path: %[3]s})
+	}
+`, field.Ident, fmt.Sprintf(enc, field.Ident), path, strings.Replace(fmt.Sprintf(enc, field.Ident), "v.", "o.", 1))
+	}
+	b.WriteString("\treturn d\n}\n\n")
 }
 
 func writeEncodeJSONField(b *bytes.Buffer, field *Field, opt *EncoderOpt, cond string, enc string) {
@@ -726,9 +1043,10 @@ func writeEncodeJSONFunc(b *bytes.Buffer, model *Model) {
 	if model.Network {
 		if len(model.Fields) == 0 {
 			log.Fatalf("Unexpected API request model with no fields: %s", model.Name)
-		} else if len(model.Fields) > 1 {
-			opt.Comma = true
 		}
+		// NOTE(tav): The encoded network always ends with a comma, which needs
+		// to be replaced if none of the fields are set.
+		opt.Comma = true
 		opt.Prefix = ""
 		fmt.Fprintf(b, `func (v %s) EncodeJSON(b []byte, network []byte) []byte {
 	b = append(b, network...)
@@ -806,6 +1124,18 @@ func writeEncodeJSONFunc(b *bytes.Buffer, model *Model) {
 		// TODO
 		log.Fatalf("%s.%s", model.Name, "x")
 	}
+	empty := !model.Network
+	for _, field := range model.Fields {
+		if !field.Optional {
+			empty = false
+		}
+	}
+	if empty {
+		b.WriteString(`	if b[len(b) - 1] == '{' {
+		return append(b, "}"...)
+	}
+`)
+	}
 	fmt.Fprintf(b, `	b[len(b) - 1] = '}'
 	return b
 }
@@ -828,71 +1158,95 @@ func writeEndpoints(b *bytes.Buffer, endpoints []*Endpoint) {
 		if e.Name == "NetworkList" {
 			enc = ")"
 		}
-		fmt.Fprintf(b, `func (c *Client) %s(
-	ctx context.Context, req *%s, resp *%s, retry retry.Handler,
+		fmt.Fprintf(b, `func (c *Client) %[1]s(
+	ctx context.Context, req *%[2]s, resp *%[3]s, retry retry.Handler,
 ) *ClientError {
 	if len(c.netjson) == 0 {
 		c.err.reset()
 		c.err.CallError = errors.New(
-			"api: the SetNetwork method must be called before making a Client.%s call",
+			"api: the SetNetwork method must be called before making a Client.%[1]s call",
 		)
 		return c.err
 	}
-	c.req = req.EncodeJSON(c.req[:0], %s
-	it := retry.Iter()
+	c.req = req.EncodeJSON(c.req[:0], %[4]s
+	if len(c.middleware) > 0 {
+		return c.intercept(ctx, "%[5]s", resp, func(ctx context.Context, inv *Invocation) *ClientError {
+			return c.do%[1]s(ctx, inv, resp, retry)
+		})
+	}
+	return c.do%[1]s(ctx, nil, resp, retry)
+}
+
+func (c *Client) do%[1]s(
+	ctx context.Context, inv *Invocation, resp *%[3]s, retry retry.Handler,
+) *ClientError {
+	it := retry.WithClassifier(classifyError).Iter()
 	var (
 		err   error
-		hreq  *http.Request
 		hresp *http.Response
 	)
-	for it.Next() {
-		hreq, err = http.NewRequestWithContext(ctx, "POST", c.baseURL+"%s", bytes.NewReader(c.req))
-		if err != nil {
+	for it.NextContext(ctx) {
+		if err = c.backend.acquire(ctx, "%[5]s"); err != nil {
+			c.reportAttempt(inv, "%[5]s", it.Record(err))
 			continue
 		}
-		hreq.Header.Set("Content-Type", "application/json")
-		hresp, err = HTTPClient.Do(hreq)
+		hresp, err = c.post(ctx, "%[5]s")
 		if err != nil {
+			c.record(inv, "%[5]s", &it, err)
 			continue
 		}
+		inv.observe(hresp)
 		switch hresp.StatusCode {
 		case 200:
 			err = c.dec.ResetFromReadCloser(hresp.Body)
-			if err != nil {
-				continue
+			if err == nil {
+				resp.Reset()
+				err = resp.DecodeJSON(c.dec)
+			}
+			if err == nil {
+				err = c.dec.End()
 			}
-			resp.Reset()
-			err = resp.DecodeJSON(c.dec)
 			if err == nil {
+				c.reportFindings("%[5]s")
+				c.record(inv, "%[5]s", &it, nil)
 				return nil
 			}
 		case 500:
+			c.err.reset()
 			err = c.dec.ResetFromReadCloser(hresp.Body)
-			if err != nil {
-				continue
+			if err == nil {
+				err = c.err.RosettaError.DecodeJSON(c.dec)
 			}
-			c.err.reset()
-			err = c.err.RosettaError.DecodeJSON(c.dec)
 			if err == nil {
-				return c.err
+				err = c.dec.End()
+			}
+			if err == nil {
+				c.reportFindings("%[5]s")
+				err = c.err
 			}
 		default:
-			io.Copy(io.Discard, hresp.Body)
-			hresp.Body.Close()
-			err = fmt.Errorf(
-				"api: got HTTP status code %%d from %s",
-				hresp.StatusCode,
-			)
+			err = newStatusError("%[5]s", hresp)
 		}
+		c.record(inv, "%[5]s", &it, err)
+	}
+	// NOTE(tav): The context may have been done before the first attempt.
+	if err == nil {
+		err = ctx.Err()
+	}
+	// NOTE(tav): Rosetta errors are returned as is once they are no longer
+	// being retried.
+	if err == c.err {
+		return c.err
 	}
 	if err != nil {
+		c.reportLimit("%[5]s", err)
 		c.err.reset()
 		c.err.CallError = err
 		return c.err
 	}
 	return nil
 }
-`, e.Name, e.Request, e.Response, e.Name, enc, e.URL, e.URL)
+`, e.Name, e.Request, e.Response, enc, e.URL)
 	}
 }
 
@@ -960,8 +1314,8 @@ func (v %s) Equal(o %s) bool {
 	b.WriteString("\n}\n\n")
 }
 
-func writeFile(root string, src []byte) {
-	outPath := filepath.Join(root, "api", "api.go")
+func writeFile(root string, filename string, src []byte) {
+	outPath := filepath.Join(root, "api", filename)
 	f, err := os.Create(outPath)
 	if err != nil {
 		log.Fatalf("Failed to create %s: %s", outPath, err)
@@ -974,6 +1328,38 @@ func writeFile(root string, src []byte) {
 	}
 }
 
+func writeFuzzFunc(b *bytes.Buffer, model *Model) {
+	decode, encode, seed := "v.DecodeJSON(d)", "v.EncodeJSON(nil)", "r.%s().EncodeJSON(nil)"
+	if model.Network {
+		decode = "v.DecodeJSON(d, &n)"
+		encode = "v.EncodeJSON(nil, EncodeNetworkForJSON(n))"
+		seed = "r.%s().EncodeJSON(nil, EncodeNetworkForJSON(r.NetworkIdentifier()))"
+	}
+	fmt.Fprintf(b, `func Fuzz%s(f *testing.F) {
+	r := newTestRand()
+	for i := 0; i < fuzzSeeds; i++ {
+		f.Add(%s)
+	}
+	f.Fuzz(func(t *testing.T, data []byte) {
+`, model.Name, fmt.Sprintf(seed, model.Name))
+	if model.Network {
+		b.WriteString("\t\tn := NetworkIdentifier{}\n")
+	}
+	fmt.Fprintf(b, `		v := %s{}
+		enc, ok := fuzzDecode(data, func(d *json.Decoder) ([]byte, error) {
+			if err := %s; err != nil {
+				return nil, err
+			}
+			return %s, nil
+		})
+		if !ok {
+			return
+		}
+`, model.Name, decode, encode)
+	writeRoundTripCheck(b, model, "enc", "nil", "\t\t")
+	b.WriteString("\t})\n}\n\n")
+}
+
 func writeInt64Model(b *bytes.Buffer, model *Model) {
 	fmt.Fprintf(b, "type %s int64\n\n", model.Name)
 	if model.MinZero {
@@ -1014,6 +1400,7 @@ func writeModels(b *bytes.Buffer, models []*Model) {
 		case "struct":
 			writeStructModel(b, model)
 			writeDecodeJSONFunc(b, model)
+			writeDiffFunc(b, model)
 			writeEncodeJSONFunc(b, model)
 			writeEqualFunc(b, model, equals)
 			writeResetFunc(b, model)
@@ -1072,6 +1459,22 @@ func Optional%s(v %s) Optional%sType {
 	b.WriteString("\n")
 }
 
+func writePoolEndpoints(b *bytes.Buffer, endpoints []*Endpoint) {
+	for _, e := range endpoints {
+		fmt.Fprintf(b, `// %[1]s calls the %[4]s endpoint with a Client from the Pool. See
+// Client.%[1]s for details.
+func (p *Pool) %[1]s(
+	ctx context.Context, req *%[2]s, resp *%[3]s, retry retry.Handler,
+) *ClientError {
+	c := p.Get()
+	defer p.Put(c)
+	return c.%[1]s(ctx, req, resp, retry).clone()
+}
+
+`, e.Name, e.Request, e.Response, e.URL)
+	}
+}
+
 func writePrelude(b *bytes.Buffer) {
 	b.WriteString(`// DO NOT EDIT.
 // Generated by running: go run cmd/genapi/genapi.go
@@ -1094,11 +1497,9 @@ func writePrelude(b *bytes.Buffer) {
 package api
 
 import (
-	"bytes"
 	"context"
 	"errors"
 	"fmt"
-	"io"
 	"net/http"
 
 	"github.com/tav/validate-rosetta/json"
@@ -1149,6 +1550,112 @@ func (v *%s) Reset() {
 	b.WriteString("}\n\n")
 }
 
+func writeRandFunc(b *bytes.Buffer, model *Model) {
+	fmt.Fprintf(b, `// %s returns a random %s value.
+func (r *testRand) %s() %s {
+`, model.Name, model.Name, model.Name, model.Name)
+	switch model.Type {
+	case "string":
+		if len(model.Enum) == 0 {
+			fmt.Fprintf(b, "\treturn %s(r.string())\n}\n\n", model.Name)
+			return
+		}
+		fmt.Fprintf(b, "\treturn %s(r.oneOf(", model.Name)
+		for i, variant := range model.Enum {
+			if i != 0 {
+				b.WriteString(", ")
+			}
+			fmt.Fprintf(b, "%q", variant)
+		}
+		b.WriteString("))\n}\n\n")
+		return
+	case "int64":
+		fmt.Fprintf(b, "\treturn %s(r.int64(%t))\n}\n\n", model.Name, model.MinZero)
+		return
+	}
+	fmt.Fprintf(b, "\tv := %s{}\n", model.Name)
+	for _, field := range model.Fields {
+		// NOTE(tav): Deprecated fields are left unset, as rosetta-sdk-go
+		// derives them from their replacements.
+		if field.Optional && field.Deprecated() {
+			continue
+		}
+		var gen string
+		switch field.Type {
+		case "string":
+			gen = "r.string()"
+		case "int32", "int64", "float64":
+			gen = fmt.Sprintf("r.%s(%t)", field.Type, field.MinZero)
+		case "bool":
+			gen = "r.bool()"
+		case "MapObject":
+			gen = "r.mapObject()"
+		case "[]byte":
+			gen = "r.bytes()"
+		case "[]string":
+			gen = "r.strings()"
+		default:
+			if field.Slice {
+				fmt.Fprintf(b, `	for i := r.length(); i > 0; i-- {
+		v.%s = append(v.%s, r.%s())
+	}
+`, field.Ident, field.Ident, field.Model.Name)
+				continue
+			}
+			gen = fmt.Sprintf("r.%s()", field.Model.Name)
+		}
+		if field.OptionalType == "" {
+			fmt.Fprintf(b, "\tv.%s = %s\n", field.Ident, gen)
+			continue
+		}
+		fmt.Fprintf(b, `	if r.optional() {
+		v.%s = Optional%s(%s)
+	}
+`, field.Ident, field.OptionalType, gen)
+	}
+	b.WriteString("\treturn v\n}\n\n")
+}
+
+// writeRoundTripCheck writes the code to decode the given encoding of the value
+// v, and check it against v using checkRoundTrip.
+func writeRoundTripCheck(b *bytes.Buffer, model *Model, enc string, sdk string, tabs string) {
+	decode, diff := "w.DecodeJSON(d)", "v.Diff(w)"
+	if model.Network {
+		fmt.Fprintf(b, "%sm := NetworkIdentifier{}\n", tabs)
+		decode = "w.DecodeJSON(d, &m)"
+		diff = `n.appendDiffs(v.Diff(w), "network_identifier", m)`
+	}
+	fmt.Fprintf(b, `%[1]sw := %[2]s{}
+%[1]scheckRoundTrip(t, %[3]s, func(d *json.Decoder) ([]Difference, error) {
+%[1]s	err := %[4]s
+%[1]s	return %[5]s, err
+%[1]s}, %[6]s)
+`, tabs, model.Name, enc, decode, diff, sdk)
+}
+
+func writeRoundTripFunc(b *bytes.Buffer, models []*Model) {
+	b.WriteString(`func TestRoundTrip(t *testing.T) {
+	r := newTestRand()
+`)
+	for _, model := range models {
+		if model.Type != "struct" {
+			continue
+		}
+		fmt.Fprintf(b, `	t.Run(%q, func(t *testing.T) {
+		for i := 0; i < roundTrips; i++ {
+`, model.Name)
+		enc := "v.EncodeJSON(nil)"
+		if model.Network {
+			b.WriteString("\t\t\tn := r.NetworkIdentifier()\n")
+			enc = "v.EncodeJSON(nil, EncodeNetworkForJSON(n))"
+		}
+		fmt.Fprintf(b, "\t\t\tv := r.%s()\n", model.Name)
+		writeRoundTripCheck(b, model, enc, fmt.Sprintf("&types.%s{}", model.Name), "\t\t\t")
+		b.WriteString("\t\t}\n\t})\n")
+	}
+	b.WriteString("}\n")
+}
+
 func writeSliceEqualFuncs(b *bytes.Buffer, equals map[string]string) {
 	eqTypes := make([]string, len(equals))
 	idx := 0
@@ -1209,13 +1716,53 @@ func writeStructModel(b *bytes.Buffer, model *Model) {
 	b.WriteString("}\n\n")
 }
 
+// writeTestPrelude writes the header for the generated test files, with the
+// given build constraint, if any.
+func writeTestPrelude(b *bytes.Buffer, constraint string) {
+	b.WriteString(`// DO NOT EDIT.
+// Generated by running: go run cmd/genapi/genapi.go
+
+// Copyright 2021 Coinbase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+`)
+	if constraint != "" {
+		fmt.Fprintf(b, "//go:build %s\n// +build %s\n\n", constraint, constraint)
+	}
+	b.WriteString(`package api
+
+import (
+	"testing"
+
+`)
+	if constraint == "" {
+		b.WriteString("\t\"github.com/coinbase/rosetta-sdk-go/types\"\n")
+	}
+	b.WriteString(`	"github.com/tav/validate-rosetta/json"
+)
+
+`)
+}
+
 func main() {
 	root := getGitRoot()
 	specDir, spec := getSpec(root)
 	endpoints, reqs := processEndpoints(specDir, spec)
 	models := processModels(specDir, spec, reqs)
-	src := genFile(endpoints, models)
-	writeFile(root, src)
+	writeFile(root, "api.go", genFile(endpoints, models))
+	writeFile(root, "api_test.go", genTestFile(models))
+	writeFile(root, "fuzz_test.go", genFuzzFile(models))
 }
 
 func init() {
@@ -0,0 +1,562 @@
+// Copyright 2021 Coinbase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package json
+
+import (
+	"errors"
+	"sort"
+	"strconv"
+	"unicode/utf16"
+	"unicode/utf8"
+)
+
+// maxExponentDigits specifies the maximum number of significant digits that
+// are supported within the exponent of a number being canonicalized.
+const maxExponentDigits = 9
+
+const hexDigits = "0123456789abcdef"
+
+var errExponentRange = errors.New("number exponent is out of range")
+
+type canonicalMember struct {
+	key   []byte
+	value []byte
+}
+
+// Canonical reads the next value, and appends its canonical encoding to dst.
+//
+// The canonical encoding follows the JSON Canonicalization Scheme of RFC 8785:
+// insignificant whitespace is removed, object members are sorted by the UTF-16
+// code units of their keys, and strings only escape the characters that must
+// be escaped, using the shortest escape sequences. Numbers are formatted using
+// the ECMAScript rules of the scheme, but with all significant digits kept,
+// instead of being rounded to float64 values, so that large integers survive
+// canonicalization.
+//
+// Unlike RFC 8785, duplicate keys are not rejected, and the last value for a
+// key wins, as with encoding/json. Likewise, invalid UTF-8 and lone surrogates
+// within strings are replaced with U+FFFD.
+//
+// Values which are already in canonical form are appended as is.
+func (d *Decoder) Canonical(dst []byte) ([]byte, error) {
+	d.SkipWhitespace()
+	start := d.cursor
+	raw, err := d.Raw()
+	if err != nil {
+		return dst, err
+	}
+	if isCanonical(raw) {
+		return append(dst, raw...), nil
+	}
+	c := canonicalizer{src: raw}
+	if dst, err = c.value(dst); err != nil {
+		d.cursor = start + c.pos
+		return dst, d.Errorf("%s", err)
+	}
+	return dst, nil
+}
+
+// Canonicalize appends the canonical encoding of the given JSON value to dst.
+// See the Decoder's Canonical method for details of the encoding.
+func Canonicalize(dst []byte, src []byte) ([]byte, error) {
+	d := NewDecoder()
+	d.ResetFromBytes(src)
+	dst, err := d.Canonical(dst)
+	if err != nil {
+		return dst, err
+	}
+	return dst, d.End()
+}
+
+// canonicalizer produces the canonical encoding of a value that has already
+// been validated by the Decoder.
+type canonicalizer struct {
+	pos int
+	src []byte
+}
+
+func (c *canonicalizer) array(dst []byte) ([]byte, error) {
+	c.pos++
+	dst = append(dst, '[')
+	c.skipWhitespace()
+	if c.src[c.pos] == ']' {
+		c.pos++
+		return append(dst, ']'), nil
+	}
+	for {
+		var err error
+		if dst, err = c.value(dst); err != nil {
+			return dst, err
+		}
+		c.skipWhitespace()
+		if c.src[c.pos] == ']' {
+			c.pos++
+			return append(dst, ']'), nil
+		}
+		c.pos++
+		dst = append(dst, ',')
+	}
+}
+
+func (c *canonicalizer) number(dst []byte) ([]byte, error) {
+	start := c.pos
+	for c.pos < len(c.src) && isNumberChar(c.src[c.pos]) {
+		c.pos++
+	}
+	return appendCanonicalNumber(dst, c.src[start:c.pos])
+}
+
+func (c *canonicalizer) object(dst []byte) ([]byte, error) {
+	c.pos++
+	c.skipWhitespace()
+	if c.src[c.pos] == '}' {
+		c.pos++
+		return append(dst, "{}"...), nil
+	}
+	var members []canonicalMember
+	for {
+		c.skipWhitespace()
+		key := c.string(nil)
+		c.skipWhitespace()
+		c.pos++
+		value, err := c.value(nil)
+		if err != nil {
+			return dst, err
+		}
+		members = append(members, canonicalMember{key: key, value: value})
+		c.skipWhitespace()
+		if c.src[c.pos] == '}' {
+			c.pos++
+			break
+		}
+		c.pos++
+	}
+	// NOTE(tav): The sort is stable so that the last of any duplicate keys can
+	// be kept.
+	sort.SliceStable(members, func(i, j int) bool {
+		return compareUTF16(members[i].key, members[j].key) < 0
+	})
+	dst = append(dst, '{')
+	first := true
+	for i, member := range members {
+		if i+1 < len(members) && string(member.key) == string(members[i+1].key) {
+			continue
+		}
+		if !first {
+			dst = append(dst, ',')
+		}
+		first = false
+		dst = appendCanonicalString(dst, member.key)
+		dst = append(dst, ':')
+		dst = append(dst, member.value...)
+	}
+	return append(dst, '}'), nil
+}
+
+func (c *canonicalizer) skipWhitespace() {
+	for c.pos < len(c.src) && whitespace[c.src[c.pos]] {
+		c.pos++
+	}
+}
+
+// string unescapes the string at the current position, and appends it to
+// dst.
+func (c *canonicalizer) string(dst []byte) []byte {
+	c.pos++
+	for {
+		ch := c.src[c.pos]
+		switch ch {
+		case '"':
+			c.pos++
+			return dst
+		case '\\':
+			c.pos++
+			ch = c.src[c.pos]
+			c.pos++
+			switch ch {
+			case 'b':
+				ch = '\b'
+			case 'f':
+				ch = '\f'
+			case 'n':
+				ch = '\n'
+			case 'r':
+				ch = '\r'
+			case 't':
+				ch = '\t'
+			case 'u':
+				r := parseHex4(c.src[c.pos:])
+				c.pos += 4
+				if utf16.IsSurrogate(r) {
+					r2 := utf8.RuneError
+					if c.pos+6 <= len(c.src) && c.src[c.pos] == '\\' && c.src[c.pos+1] == 'u' {
+						r2 = parseHex4(c.src[c.pos+2:])
+					}
+					if r = utf16.DecodeRune(r, r2); r != utf8.RuneError {
+						c.pos += 6
+					}
+				}
+				dst = appendRune(dst, r)
+				continue
+			}
+			dst = append(dst, ch)
+		default:
+			if ch < utf8.RuneSelf {
+				dst = append(dst, ch)
+				c.pos++
+				continue
+			}
+			r, size := utf8.DecodeRune(c.src[c.pos:])
+			dst = appendRune(dst, r)
+			c.pos += size
+		}
+	}
+}
+
+func (c *canonicalizer) value(dst []byte) ([]byte, error) {
+	c.skipWhitespace()
+	switch c.src[c.pos] {
+	case '{':
+		return c.object(dst)
+	case '[':
+		return c.array(dst)
+	case '"':
+		return appendCanonicalString(dst, c.string(nil)), nil
+	case 't':
+		c.pos += 4
+		return append(dst, "true"...), nil
+	case 'f':
+		c.pos += 5
+		return append(dst, "false"...), nil
+	case 'n':
+		c.pos += 4
+		return append(dst, "null"...), nil
+	}
+	return c.number(dst)
+}
+
+// appendCanonicalNumber appends the canonical form of the given number, which
+// must be valid according to the JSON grammar.
+func appendCanonicalNumber(dst []byte, num []byte) ([]byte, error) {
+	neg := false
+	if num[0] == '-' {
+		neg = true
+		num = num[1:]
+	}
+	// Split the number into its integer, fraction, and exponent parts.
+	i := 0
+	for i < len(num) && num[i] >= '0' && num[i] <= '9' {
+		i++
+	}
+	intPart := num[:i]
+	var frac []byte
+	if i < len(num) && num[i] == '.' {
+		j := i + 1
+		for j < len(num) && num[j] >= '0' && num[j] <= '9' {
+			j++
+		}
+		frac = num[i+1 : j]
+		i = j
+	}
+	exp := 0
+	if i < len(num) {
+		// Skip past the 'e' or 'E'.
+		i++
+		expNeg := false
+		switch num[i] {
+		case '-':
+			expNeg = true
+			i++
+		case '+':
+			i++
+		}
+		for i < len(num) && num[i] == '0' {
+			i++
+		}
+		if len(num)-i > maxExponentDigits {
+			return dst, errExponentRange
+		}
+		for ; i < len(num); i++ {
+			exp = exp*10 + int(num[i]-'0')
+		}
+		if expNeg {
+			exp = -exp
+		}
+	}
+	// Find the significant digits, i.e. the digits of the integer and fraction
+	// parts without any leading or trailing zeros.
+	var (
+		digits  [64]byte
+		sig     = digits[:0]
+		leading = true
+	)
+	if len(intPart)+len(frac) > len(digits) {
+		sig = make([]byte, 0, len(intPart)+len(frac))
+	}
+	for _, part := range [2][]byte{intPart, frac} {
+		for _, ch := range part {
+			if leading && ch == '0' {
+				continue
+			}
+			leading = false
+			sig = append(sig, ch)
+		}
+	}
+	trailing := 0
+	for trailing < len(sig) && sig[len(sig)-1-trailing] == '0' {
+		trailing++
+	}
+	sig = sig[:len(sig)-trailing]
+	if len(sig) == 0 {
+		return append(dst, '0'), nil
+	}
+	if neg {
+		dst = append(dst, '-')
+	}
+	// The value is sig × 10^(exp - len(frac) + trailing), or equivalently,
+	// 0.sig × 10^n.
+	n := len(sig) + exp - len(frac) + trailing
+	return appendScaledDigits(dst, sig, n), nil
+}
+
+// appendCanonicalString appends the given unescaped string, which must be
+// valid UTF-8, as a canonically escaped JSON string.
+func appendCanonicalString(dst []byte, s []byte) []byte {
+	dst = append(dst, '"')
+	for _, ch := range s {
+		if !stringSpecial[ch] {
+			dst = append(dst, ch)
+			continue
+		}
+		switch ch {
+		case '"', '\\':
+			dst = append(dst, '\\', ch)
+		case '\b':
+			dst = append(dst, '\\', 'b')
+		case '\f':
+			dst = append(dst, '\\', 'f')
+		case '\n':
+			dst = append(dst, '\\', 'n')
+		case '\r':
+			dst = append(dst, '\\', 'r')
+		case '\t':
+			dst = append(dst, '\\', 't')
+		default:
+			dst = append(dst, '\\', 'u', '0', '0', hexDigits[ch>>4], hexDigits[ch&0xf])
+		}
+	}
+	return append(dst, '"')
+}
+
+func appendRune(dst []byte, r rune) []byte {
+	var buf [utf8.UTFMax]byte
+	n := utf8.EncodeRune(buf[:], r)
+	return append(dst, buf[:n]...)
+}
+
+// appendScaledDigits appends the value 0.sig × 10^n using the formatting rules
+// of ECMAScript's Number.prototype.toString.
+func appendScaledDigits(dst []byte, sig []byte, n int) []byte {
+	k := len(sig)
+	switch {
+	case k <= n && n <= 21:
+		dst = append(dst, sig...)
+		for i := k; i < n; i++ {
+			dst = append(dst, '0')
+		}
+	case 0 < n && n <= 21:
+		dst = append(dst, sig[:n]...)
+		dst = append(dst, '.')
+		dst = append(dst, sig[n:]...)
+	case -6 < n && n <= 0:
+		dst = append(dst, "0."...)
+		for i := n; i < 0; i++ {
+			dst = append(dst, '0')
+		}
+		dst = append(dst, sig...)
+	default:
+		dst = append(dst, sig[0])
+		if k > 1 {
+			dst = append(dst, '.')
+			dst = append(dst, sig[1:]...)
+		}
+		dst = append(dst, 'e')
+		if n-1 < 0 {
+			dst = append(dst, '-')
+			dst = strconv.AppendInt(dst, int64(1-n), 10)
+		} else {
+			dst = append(dst, '+')
+			dst = strconv.AppendInt(dst, int64(n-1), 10)
+		}
+	}
+	return dst
+}
+
+// compareUTF16 compares two valid UTF-8 strings by their UTF-16 code units.
+func compareUTF16(a []byte, b []byte) int {
+	for len(a) > 0 && len(b) > 0 {
+		ra, na := utf8.DecodeRune(a)
+		rb, nb := utf8.DecodeRune(b)
+		if ra != rb {
+			// NOTE(tav): Code points above the BMP are encoded as surrogate
+			// pairs, which sort before the code points from U+E000 upwards.
+			ua, ub := utf16Lead(ra), utf16Lead(rb)
+			if ua == ub {
+				ua, ub = ra, rb
+			}
+			if ua < ub {
+				return -1
+			}
+			return 1
+		}
+		a, b = a[na:], b[nb:]
+	}
+	return len(a) - len(b)
+}
+
+// isCanonical returns whether the given valid JSON value is already in
+// canonical form. It is conservative, and may return false for some values
+// that are canonical, e.g. objects with keys that need escaping.
+func isCanonical(src []byte) bool {
+	var (
+		num   [32]byte
+		stack [16][]byte
+	)
+	keys := stack[:0]
+	for i := 0; i < len(src); {
+		ch := src[i]
+		switch {
+		case ch == '{':
+			keys = append(keys, nil)
+			i++
+		case ch == '}':
+			keys = keys[:len(keys)-1]
+			i++
+		case ch == '[' || ch == ']' || ch == ',' || ch == ':':
+			i++
+		case ch == '"':
+			end, plain, ok := scanCanonicalString(src, i)
+			if !ok {
+				return false
+			}
+			// Strings directly followed by a colon are object keys, which must
+			// be in strictly ascending order.
+			if end < len(src) && src[end] == ':' {
+				if !plain {
+					return false
+				}
+				key := src[i+1 : end-1]
+				top := len(keys) - 1
+				if keys[top] != nil && compareUTF16(keys[top], key) >= 0 {
+					return false
+				}
+				keys[top] = key
+			}
+			i = end
+		case ch == 't':
+			i += 4
+		case ch == 'f':
+			i += 5
+		case ch == 'n':
+			i += 4
+		case ch == '-' || (ch >= '0' && ch <= '9'):
+			start := i
+			for i < len(src) && isNumberChar(src[i]) {
+				i++
+			}
+			out, err := appendCanonicalNumber(num[:0], src[start:i])
+			if err != nil || string(out) != string(src[start:i]) {
+				return false
+			}
+		default:
+			return false
+		}
+	}
+	return true
+}
+
+func isNumberChar(c byte) bool {
+	return (c >= '0' && c <= '9') || c == '-' || c == '+' || c == '.' || c == 'e' || c == 'E'
+}
+
+// parseHex4 parses the 4 hex digits at the start of the given slice, which
+// have already been validated by the Decoder.
+func parseHex4(b []byte) rune {
+	var r rune
+	for _, ch := range b[:4] {
+		v := unhex(ch)
+		if v == 0xff {
+			return utf8.RuneError
+		}
+		r = r<<4 | rune(v)
+	}
+	return r
+}
+
+// scanCanonicalString scans the string starting at the given offset, and
+// returns the offset after its closing quote, whether it had no escapes, and
+// whether it is in canonical form.
+func scanCanonicalString(src []byte, i int) (int, bool, bool) {
+	plain := true
+	i++
+	for {
+		ch := src[i]
+		switch {
+		case ch == '"':
+			return i + 1, plain, true
+		case ch == '\\':
+			plain = false
+			switch src[i+1] {
+			case '"', '\\', 'b', 'f', 'n', 'r', 't':
+				i += 2
+			case 'u':
+				// Only control characters without a short escape sequence are
+				// escaped with \u in canonical form, using lowercase hex.
+				if src[i+2] != '0' || src[i+3] != '0' || src[i+4] > '1' {
+					return i, plain, false
+				}
+				switch src[i+5] {
+				case '8', '9', 'c', 'a', 'd':
+					// \b, \t, \n, \f, \r
+					if src[i+4] == '0' {
+						return i, plain, false
+					}
+				case 'A', 'B', 'C', 'D', 'E', 'F':
+					return i, plain, false
+				}
+				i += 6
+			default:
+				return i, plain, false
+			}
+		case ch < utf8.RuneSelf:
+			i++
+		default:
+			r, size := utf8.DecodeRune(src[i:])
+			if r == utf8.RuneError && size == 1 {
+				return i, plain, false
+			}
+			i += size
+		}
+	}
+}
+
+// utf16Lead returns the first UTF-16 code unit for the given code point.
+func utf16Lead(r rune) rune {
+	if r < 0x10000 {
+		return r
+	}
+	r1, _ := utf16.EncodeRune(r)
+	return r1
+}
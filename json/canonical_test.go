@@ -0,0 +1,133 @@
+// Copyright 2021 Coinbase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package json
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestCanonicalize(t *testing.T) {
+	for _, test := range []struct {
+		src  string
+		want string
+	}{
+		{`{}`, `{}`},
+		{` { "b" : 1 , "a" : [ 1 , 2 ] } `, `{"a":[1,2],"b":1}`},
+		{`{"b":{"d":null,"c":true},"a":false}`, `{"a":false,"b":{"c":true,"d":null}}`},
+		{`{"a":1,"a":2}`, `{"a":2}`},
+		{`{"\u0061":1}`, `{"a":1}`},
+		{`{"\ufb01":1,"\ud83d\ude00":2,"\u20ac":3}`, "{\"\u20ac\":3,\"\U0001f600\":2,\"\ufb01\":1}"},
+		{`{"b":1,"\r":2}`, `{"\r":2,"b":1}`},
+		{`"\u00e9\/\u001F\u0008\""`, "\"\u00e9/\\u001f\\b\\\"\""},
+		{`"\ud800"`, "\"\ufffd\""},
+		{"\"a\xffb\"", "\"a\ufffdb\""},
+		{`0`, `0`},
+		{`-0`, `0`},
+		{`-0.0e10`, `0`},
+		{`1.0`, `1`},
+		{`1.50`, `1.5`},
+		{`100`, `100`},
+		{`1e2`, `100`},
+		{`1E+2`, `100`},
+		{`0.001`, `0.001`},
+		{`1e-7`, `1e-7`},
+		{`0.000001`, `0.000001`},
+		{`12.5e-1`, `1.25`},
+		{`1e21`, `1e+21`},
+		{`123e20`, `1.23e+22`},
+		{`100000000000000000000`, `100000000000000000000`},
+		{`12345678901234567890123`, `1.2345678901234567890123e+22`},
+		{`-12345678901234567890`, `-12345678901234567890`},
+		{`0.1234567890123456789`, `0.1234567890123456789`},
+		{`1e0000000000000005`, `100000`},
+	} {
+		got, err := Canonicalize(nil, []byte(test.src))
+		if err != nil {
+			t.Errorf("Unexpected error canonicalizing %s: %s", test.src, err)
+			continue
+		}
+		if string(got) != test.want {
+			t.Errorf("Canonicalize(%s) = %s, want %s", test.src, got, test.want)
+		}
+		again, err := Canonicalize(nil, got)
+		if err != nil || string(again) != string(got) {
+			t.Errorf("Canonical form of %s is not stable: %s", test.src, again)
+		}
+		if !strings.Contains(string(got), `\`) && !isCanonical(got) {
+			t.Errorf("Canonical form of %s was not detected as canonical: %s", test.src, got)
+		}
+	}
+	for _, src := range []string{
+		`{"a":1,}`,
+		`[1 2]`,
+		`{"a":1} x`,
+		`1e1234567890`,
+	} {
+		if _, err := Canonicalize(nil, []byte(src)); err == nil {
+			t.Errorf("Expected error canonicalizing %s", src)
+		}
+	}
+}
+
+func TestIsCanonical(t *testing.T) {
+	for _, test := range []struct {
+		src  string
+		want bool
+	}{
+		{`{"a":[1,"x",{"b":null}],"b":true}`, true},
+		{`{"b":1,"a":2}`, false},
+		{`{"a":1,"a":2}`, false},
+		{`{"a": 1}`, false},
+		{`"\u00e9"`, false},
+		{`"\u000a"`, false},
+		{`"\u001F"`, false},
+		{`"\u001f"`, true},
+		{`1.0`, false},
+		{`1e2`, false},
+	} {
+		if got := isCanonical([]byte(test.src)); got != test.want {
+			t.Errorf("isCanonical(%s) = %v, want %v", test.src, got, test.want)
+		}
+	}
+}
+
+func BenchmarkCanonical(b *testing.B) {
+	canonical := []byte(`{"account":{"address":"0x1234567890abcdef","sub":[1,2,3]},"gas":21000,"memo":"hello world","nonce":7}`)
+	scrambled := []byte(`{"nonce": 7, "memo": "hello world", "gas": 2.1e4, "account": {"sub": [1, 2, 3], "address": "0x1234567890abcdef"}}`)
+	for _, bench := range []struct {
+		name string
+		src  []byte
+	}{
+		{"Canonical", canonical},
+		{"Scrambled", scrambled},
+	} {
+		b.Run(bench.name, func(b *testing.B) {
+			d := NewDecoder()
+			buf := make([]byte, 0, 256)
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				d.ResetFromBytes(bench.src)
+				var err error
+				if buf, err = d.Canonical(buf[:0]); err != nil {
+					b.Fatal(err)
+				}
+			}
+			if !strings.HasPrefix(string(buf), `{"account":`) {
+				b.Fatalf("Unexpected canonical encoding: %s", buf)
+			}
+		})
+	}
+}
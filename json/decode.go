@@ -25,18 +25,1038 @@
 package json
 
 import (
+	"bufio"
+	"bytes"
+	"fmt"
 	"io"
+	"math"
+	"strconv"
+	"unicode/utf16"
+	"unicode/utf8"
+	"unsafe"
 )
 
+// maxFindings specifies the maximum number of findings that are recorded for
+// each decoded input.
+const maxFindings = 100
+
+// snippetContext specifies the number of bytes of input on either side of an
+// error's offset to include within its snippet.
+const snippetContext = 24
+
+var (
+	stringSpecial = func() (t [256]bool) {
+		for i := 0; i < 0x20; i++ {
+			t[i] = true
+		}
+		t['"'] = true
+		t['\\'] = true
+		return t
+	}()
+	whitespace = [256]bool{'\t': true, '\n': true, '\r': true, ' ': true}
+)
+
+var findingMessages = map[FindingKind]string{
+	DuplicateKey:  "duplicate key",
+	InvalidUTF8:   "invalid UTF-8 in string",
+	LeadingZero:   "number with leading zeros",
+	LoneSurrogate: "lone surrogate in \\u escape sequence",
+	NullOptional:  "null value for optional field",
+	UnknownKey:    "unknown field",
+}
+
 // Decoder provides support for decoding JSON data.
 //
 // To use, first use one of the ResetFrom* methods to set the data to decode,
 // and then pass the Decoder as a parameter into an API value's DecodeJSON
 // method.
+//
+// The Decoder works directly on a null-terminated copy of the data, with the
+// various read methods advancing a cursor through it. Byte slices returned by
+// the read methods point into this buffer, and are only valid until the
+// Decoder is next reset.
 type Decoder struct {
-	buf    []byte
-	cursor int
-	start  int
+	buf      []byte
+	cursor   int
+	findings []Finding
+	keys     [][]byte
+	limits   Limits
+	origin   position
+	path     []pathFrame
+	reader   *bufio.Reader
+	skeleton []byte
+	start    int
+	strict   Strictness
+}
+
+// DecodeError represents an error encountered while decoding JSON. It
+// describes where in the input the error occurred, so that the offending value
+// can be easily located.
+type DecodeError struct {
+	// Column is the 1-based byte column of Offset within its line.
+	Column int
+	// Expected describes what was expected at Offset, if applicable.
+	Expected string
+	// Line is the 1-based line number of Offset.
+	Line int
+	Msg  string
+	// Offset is the byte offset within the input at which the error occurred.
+	Offset int
+	// Path is the path of the value being decoded, e.g.
+	// "block.transactions[3].operations[1].amount.value". It is empty for
+	// the top-level value.
+	Path string
+	// Snippet is an excerpt of the input surrounding Offset.
+	Snippet string
+}
+
+func (e *DecodeError) Error() string {
+	path := ""
+	if e.Path != "" {
+		path = " in " + e.Path
+	}
+	return fmt.Sprintf(
+		"json: %s at line %d, column %d (offset %d)%s near %q",
+		e.Msg, e.Line, e.Column, e.Offset, path, e.Snippet,
+	)
+}
+
+// LimitError represents a resource limit being exceeded while reading or
+// decoding JSON.
+type LimitError struct {
+	// Err describes where the limit was exceeded. It is nil for SizeLimit
+	// errors, as they occur while reading the input.
+	Err   *DecodeError
+	Kind  LimitKind
+	Limit int
+}
+
+func (e *LimitError) Error() string {
+	if e.Err == nil {
+		return fmt.Sprintf("json: input exceeds the maximum size of %d bytes", e.Limit)
+	}
+	return e.Err.Error()
+}
+
+// LimitKind specifies the type of resource limit within a LimitError.
+type LimitKind string
+
+// LimitKind values.
+const (
+	ArrayLengthLimit  LimitKind = "array_length"
+	DepthLimit        LimitKind = "depth"
+	SizeLimit         LimitKind = "size"
+	StringLengthLimit LimitKind = "string_length"
+)
+
+// Limits specifies the resource limits enforced by a Decoder. A zero value
+// disables the corresponding limit.
+type Limits struct {
+	// MaxArrayLength specifies the maximum number of elements in an array.
+	MaxArrayLength int
+	// MaxDepth specifies the maximum nesting depth of arrays and objects.
+	MaxDepth int
+	// MaxSize specifies the maximum size of the input in bytes. It is
+	// enforced by ResetFromReadCloser as the input is read.
+	MaxSize int
+	// MaxStringLength specifies the maximum length of a string in bytes, as
+	// encoded within the input.
+	MaxStringLength int
+}
+
+// Finding represents input that is technically decodable, but that doesn't
+// conform to the spec, e.g. objects with unknown or duplicate keys.
+type Finding struct {
+	Err  *DecodeError
+	Kind FindingKind
+}
+
+// FindingKind specifies the type of a Finding.
+type FindingKind string
+
+// FindingKind values.
+const (
+	DuplicateKey  FindingKind = "duplicate_key"
+	InvalidUTF8   FindingKind = "invalid_utf8"
+	LeadingZero   FindingKind = "leading_zero"
+	LoneSurrogate FindingKind = "lone_surrogate"
+	NullOptional  FindingKind = "null_optional"
+	UnknownKey    FindingKind = "unknown_key"
+)
+
+// Strictness specifies how a Decoder handles findings.
+type Strictness int
+
+// Strictness values.
+const (
+	// Lenient ignores all findings. This is the default.
+	Lenient Strictness = iota
+	// StrictWarn records findings so that they can be retrieved with the
+	// Findings method, but otherwise continues decoding.
+	StrictWarn
+	// StrictError fails decoding with a DecodeError on the first finding.
+	StrictError
+)
+
+// pathFrame tracks the position within an object or array that is currently
+// being decoded. The key points into the Decoder's buffer. For objects, keys
+// is the index into the Decoder's keys stack at which the keys of the object
+// start.
+type pathFrame struct {
+	index int // -1 for objects
+	key   []byte
+	keys  int
+}
+
+// ArrayNext consumes the delimiter after an array element. It returns true if
+// another element follows, and false if the closing bracket was consumed.
+func (d *Decoder) ArrayNext() (bool, error) {
+	d.SkipWhitespace()
+	switch d.buf[d.cursor] {
+	case ',':
+		d.cursor++
+		if n := len(d.path); n > 0 {
+			frame := &d.path[n-1]
+			frame.index++
+			if max := d.limits.MaxArrayLength; max > 0 && frame.index >= max {
+				return false, d.limitError(
+					ArrayLengthLimit, max, "array exceeds the maximum length of %d elements",
+				)
+			}
+		}
+		return true, nil
+	case ']':
+		d.cursor++
+		d.pop()
+		return false, nil
+	}
+	return false, d.unexpected("',' or ']'")
+}
+
+// ArrayStart consumes the opening bracket of an array. It returns false if
+// the array is empty, in which case the closing bracket is also consumed.
+func (d *Decoder) ArrayStart() (bool, error) {
+	d.SkipWhitespace()
+	if d.buf[d.cursor] != '[' {
+		return false, d.unexpected("array")
+	}
+	d.cursor++
+	d.SkipWhitespace()
+	if d.buf[d.cursor] == ']' {
+		d.cursor++
+		return false, nil
+	}
+	if err := d.checkDepth(); err != nil {
+		return false, err
+	}
+	d.path = append(d.path, pathFrame{index: 0})
+	return true, nil
+}
+
+// Bool reads a boolean value.
+func (d *Decoder) Bool() (bool, error) {
+	d.SkipWhitespace()
+	if d.literal("true") {
+		return true, nil
+	}
+	if d.literal("false") {
+		return false, nil
+	}
+	return false, d.unexpected("boolean")
+}
+
+// Findings returns the findings recorded since the Decoder was last reset. The
+// returned slice is reused by the Decoder, so it must not be retained.
+func (d *Decoder) Findings() []Finding {
+	return d.findings
+}
+
+// End checks that nothing but whitespace remains after the top-level value.
+func (d *Decoder) End() error {
+	d.SkipWhitespace()
+	if !d.eof() {
+		return d.unexpected("end of input")
+	}
+	return nil
+}
+
+// Errorf returns a DecodeError with the given message at the current offset.
+func (d *Decoder) Errorf(format string, args ...interface{}) error {
+	return d.newError(fmt.Sprintf(format, args...), "")
+}
+
+// Float64 reads a number as a float64 value.
+func (d *Decoder) Float64() (float64, error) {
+	d.SkipWhitespace()
+	start := d.cursor
+	if err := d.skipNumber(); err != nil {
+		return 0, err
+	}
+	num := d.buf[start:d.cursor]
+	v, err := strconv.ParseFloat(*(*string)(unsafe.Pointer(&num)), 64)
+	if err != nil {
+		d.cursor = start
+		return 0, d.Errorf("number %s is out of range for a float64", num)
+	}
+	return v, nil
+}
+
+// HexBytes reads a hex-encoded string, and appends the decoded bytes to dst.
+func (d *Decoder) HexBytes(dst []byte) ([]byte, error) {
+	d.SkipWhitespace()
+	if d.buf[d.cursor] != '"' {
+		return dst, d.unexpected("hex-encoded string")
+	}
+	start := d.cursor
+	d.cursor++
+	for {
+		hi := unhex(d.buf[d.cursor])
+		if hi > 0x0f {
+			break
+		}
+		lo := unhex(d.buf[d.cursor+1])
+		if lo > 0x0f {
+			d.cursor++
+			if d.buf[d.cursor] == '"' {
+				err := d.Errorf("hex-encoded string has an odd length")
+				d.cursor = start
+				return dst, err
+			}
+			break
+		}
+		dst = append(dst, hi<<4|lo)
+		d.cursor += 2
+	}
+	if err := d.checkStringLength(start+1, d.cursor); err != nil {
+		return dst, err
+	}
+	if d.buf[d.cursor] != '"' {
+		if d.eof() {
+			return dst, d.Errorf("unexpected end of input in string")
+		}
+		err := d.Errorf("invalid character %s in hex-encoded string", quoteChar(d.buf[d.cursor]))
+		d.cursor = start
+		return dst, err
+	}
+	d.cursor++
+	return dst, nil
+}
+
+// Int32 reads an integer as an int32 value.
+func (d *Decoder) Int32() (int32, error) {
+	start := d.cursor
+	v, err := d.Int64()
+	if err != nil {
+		return 0, err
+	}
+	if v < math.MinInt32 || v > math.MaxInt32 {
+		d.cursor = start
+		return 0, d.Errorf("integer %d is out of range for an int32", v)
+	}
+	return int32(v), nil
+}
+
+// Int64 reads an integer as an int64 value.
+func (d *Decoder) Int64() (int64, error) {
+	d.SkipWhitespace()
+	start := d.cursor
+	neg := false
+	if d.buf[d.cursor] == '-' {
+		neg = true
+		d.cursor++
+	}
+	v, err := d.readDigits(start, "integer")
+	if err != nil {
+		return 0, err
+	}
+	if neg {
+		if v > 1<<63 {
+			d.cursor = start
+			return 0, d.Errorf("integer is out of range for an int64")
+		}
+		return -int64(v), nil
+	}
+	if v > math.MaxInt64 {
+		d.cursor = start
+		return 0, d.Errorf("integer is out of range for an int64")
+	}
+	return int64(v), nil
+}
+
+// Key reads an object key along with the colon that follows it. The returned
+// slice follows the same rules as the String method.
+func (d *Decoder) Key() ([]byte, error) {
+	key, err := d.String()
+	if err != nil {
+		return nil, err
+	}
+	d.SkipWhitespace()
+	if d.buf[d.cursor] != ':' {
+		return nil, d.unexpected("':'")
+	}
+	d.cursor++
+	if err := d.setKey(key); err != nil {
+		return nil, err
+	}
+	return key, nil
+}
+
+// Limits returns the resource limits enforced by the Decoder.
+func (d *Decoder) Limits() Limits {
+	return d.limits
+}
+
+// Null consumes a null value if it is next. It returns whether a null value
+// was consumed.
+func (d *Decoder) Null() bool {
+	d.SkipWhitespace()
+	return d.literal("null")
+}
+
+// ObjectNext consumes the delimiter after an object member. It returns true if
+// another member follows, and false if the closing brace was consumed.
+func (d *Decoder) ObjectNext() (bool, error) {
+	d.SkipWhitespace()
+	switch d.buf[d.cursor] {
+	case ',':
+		d.cursor++
+		return true, nil
+	case '}':
+		d.cursor++
+		d.pop()
+		return false, nil
+	}
+	return false, d.unexpected("',' or '}'")
+}
+
+// ObjectStart consumes the opening brace of an object. It returns false if
+// the object is empty, in which case the closing brace is also consumed.
+func (d *Decoder) ObjectStart() (bool, error) {
+	d.SkipWhitespace()
+	if d.buf[d.cursor] != '{' {
+		return false, d.unexpected("object")
+	}
+	d.cursor++
+	d.SkipWhitespace()
+	if d.buf[d.cursor] == '}' {
+		d.cursor++
+		return false, nil
+	}
+	if err := d.checkDepth(); err != nil {
+		return false, err
+	}
+	d.path = append(d.path, pathFrame{index: -1, keys: len(d.keys)})
+	return true, nil
+}
+
+// Peek returns the first byte of the next value without consuming it. A null
+// byte is returned at the end of the input.
+func (d *Decoder) Peek() byte {
+	d.SkipWhitespace()
+	return d.buf[d.cursor]
+}
+
+// Raw skips the next value and returns its raw encoding.
+func (d *Decoder) Raw() ([]byte, error) {
+	d.SkipWhitespace()
+	start := d.cursor
+	if err := d.Skip(); err != nil {
+		return nil, err
+	}
+	return d.buf[start:d.cursor], nil
+}
+
+// Report handles a finding of the given kind at the current offset, according
+// to the Decoder's Strictness. A DecodeError is returned if the Decoder is in
+// the StrictError mode.
+func (d *Decoder) Report(kind FindingKind) error {
+	return d.report(kind, d.cursor)
+}
+
+// SetLimits sets the resource limits enforced by the Decoder.
+func (d *Decoder) SetLimits(l Limits) {
+	d.limits = l
+}
+
+// SetStrict sets the Strictness of the Decoder.
+func (d *Decoder) SetStrict(s Strictness) {
+	d.strict = s
+}
+
+// Skip skips over the next value.
+func (d *Decoder) Skip() error {
+	switch d.Peek() {
+	case '{':
+		more, err := d.ObjectStart()
+		for more {
+			d.SkipWhitespace()
+			start := d.cursor
+			if err := d.skipString(); err != nil {
+				return err
+			}
+			if err := d.setKey(d.skippedKey(start+1, d.cursor-1)); err != nil {
+				return err
+			}
+			d.SkipWhitespace()
+			if d.buf[d.cursor] != ':' {
+				return d.unexpected("':'")
+			}
+			d.cursor++
+			if err := d.Skip(); err != nil {
+				return err
+			}
+			if more, err = d.ObjectNext(); err != nil {
+				return err
+			}
+		}
+		return err
+	case '[':
+		more, err := d.ArrayStart()
+		for more {
+			if err := d.Skip(); err != nil {
+				return err
+			}
+			if more, err = d.ArrayNext(); err != nil {
+				return err
+			}
+		}
+		return err
+	case '"':
+		return d.skipString()
+	case 't', 'f':
+		_, err := d.Bool()
+		return err
+	case 'n':
+		if !d.literal("null") {
+			return d.unexpected("value")
+		}
+		return nil
+	}
+	return d.skipNumber()
+}
+
+// SkipWhitespace advances the cursor past any whitespace.
+func (d *Decoder) SkipWhitespace() {
+	for whitespace[d.buf[d.cursor]] {
+		d.cursor++
+	}
+}
+
+// String reads a string value. If the string has no escape sequences, the
+// returned slice is a sub-slice of the input. Otherwise, the string is
+// unescaped in place, i.e. the returned slice is still within the Decoder's
+// buffer, but the raw input for the string is overwritten.
+//
+// Invalid surrogates within \u escape sequences are replaced with U+FFFD, as
+// with encoding/json. Other bytes, including invalid UTF-8, are passed
+// through as is.
+func (d *Decoder) String() ([]byte, error) {
+	d.SkipWhitespace()
+	if d.buf[d.cursor] != '"' {
+		return nil, d.unexpected("string")
+	}
+	d.cursor++
+	start := d.cursor
+	buf := d.buf
+	for {
+		c := buf[d.cursor]
+		if !stringSpecial[c] {
+			d.cursor++
+			continue
+		}
+		switch {
+		case c == '"':
+			d.cursor++
+			str := buf[start : d.cursor-1]
+			if err := d.checkStringLength(start, d.cursor-1); err != nil {
+				return nil, err
+			}
+			if d.strict != Lenient && !utf8.Valid(str) {
+				if err := d.report(InvalidUTF8, start-1); err != nil {
+					return nil, err
+				}
+			}
+			return str, nil
+		case c == '\\':
+			return d.unescapeString(start)
+		}
+		return nil, d.invalidStringChar()
+	}
+}
+
+// Uint64 reads a non-negative integer as a uint64 value.
+func (d *Decoder) Uint64() (uint64, error) {
+	d.SkipWhitespace()
+	return d.readDigits(d.cursor, "non-negative integer")
+}
+
+func (d *Decoder) checkDepth() error {
+	if max := d.limits.MaxDepth; max > 0 && len(d.path) >= max {
+		return d.limitError(DepthLimit, max, "value exceeds the maximum nesting depth of %d")
+	}
+	return nil
+}
+
+// checkStringLength checks the length of the encoded string between the given
+// offsets.
+func (d *Decoder) checkStringLength(start int, end int) error {
+	if max := d.limits.MaxStringLength; max > 0 && end-start > max {
+		cursor := d.cursor
+		d.cursor = start - 1
+		err := d.limitError(StringLengthLimit, max, "string exceeds the maximum length of %d bytes")
+		d.cursor = cursor
+		return err
+	}
+	return nil
+}
+
+func (d *Decoder) eof() bool {
+	return d.cursor >= len(d.buf)-1
+}
+
+func (d *Decoder) invalidStringChar() error {
+	if d.eof() {
+		return d.Errorf("unexpected end of input in string")
+	}
+	return d.Errorf("invalid control character %s in string", quoteChar(d.buf[d.cursor]))
+}
+
+func (d *Decoder) limitError(kind LimitKind, limit int, format string) error {
+	return &LimitError{
+		Err:   d.newError(fmt.Sprintf(format, limit), ""),
+		Kind:  kind,
+		Limit: limit,
+	}
+}
+
+func (d *Decoder) literal(s string) bool {
+	if len(d.buf)-d.cursor <= len(s) {
+		return false
+	}
+	if string(d.buf[d.cursor:d.cursor+len(s)]) != s {
+		return false
+	}
+	d.cursor += len(s)
+	return true
+}
+
+// readDigits reads the digits of an integer value. The cursor is rewound to
+// start on errors.
+func (d *Decoder) readDigits(start int, expected string) (uint64, error) {
+	c := d.buf[d.cursor]
+	if c < '0' || c > '9' {
+		d.cursor = start
+		return 0, d.unexpected(expected)
+	}
+	if c == '0' && d.strict != Lenient {
+		if next := d.buf[d.cursor+1]; next >= '0' && next <= '9' {
+			if err := d.report(LeadingZero, d.cursor); err != nil {
+				d.cursor = start
+				return 0, err
+			}
+		}
+	}
+	v := uint64(0)
+	for ; c >= '0' && c <= '9'; c = d.buf[d.cursor] {
+		digit := uint64(c - '0')
+		if v > (math.MaxUint64-digit)/10 {
+			d.cursor = start
+			return 0, d.Errorf("integer is out of range for a uint64")
+		}
+		v = v*10 + digit
+		d.cursor++
+	}
+	switch c {
+	case '.', 'e', 'E':
+		d.cursor = start
+		return 0, d.Errorf("expected %s, got a number with a fraction or exponent", expected)
+	}
+	return v, nil
+}
+
+// readHex4 reads the 4 hex digits of a \u escape sequence at the given
+// position.
+func (d *Decoder) readHex4(pos int) (rune, bool) {
+	r := rune(0)
+	for i := pos; i < pos+4; i++ {
+		v := unhex(d.buf[i])
+		if v > 0x0f {
+			return 0, false
+		}
+		r = r<<4 | rune(v)
+	}
+	return r, true
+}
+
+// skipNumber advances the cursor past a number, validating it against the
+// JSON grammar.
+func (d *Decoder) skipNumber() error {
+	start := d.cursor
+	if d.buf[d.cursor] == '-' {
+		d.cursor++
+	}
+	if d.buf[d.cursor] == '0' && d.strict != Lenient {
+		if next := d.buf[d.cursor+1]; next >= '0' && next <= '9' {
+			if err := d.report(LeadingZero, d.cursor); err != nil {
+				return err
+			}
+		}
+	}
+	if !d.skipDigits() {
+		d.cursor = start
+		return d.unexpected("value")
+	}
+	if d.buf[d.cursor] == '.' {
+		d.cursor++
+		if !d.skipDigits() {
+			return d.unexpected("digit")
+		}
+	}
+	if c := d.buf[d.cursor]; c == 'e' || c == 'E' {
+		d.cursor++
+		if c := d.buf[d.cursor]; c == '+' || c == '-' {
+			d.cursor++
+		}
+		if !d.skipDigits() {
+			return d.unexpected("digit")
+		}
+	}
+	return nil
+}
+
+func (d *Decoder) skipDigits() bool {
+	start := d.cursor
+	for c := d.buf[d.cursor]; c >= '0' && c <= '9'; c = d.buf[d.cursor] {
+		d.cursor++
+	}
+	return d.cursor > start
+}
+
+// skipString advances the cursor past a string without unescaping it.
+func (d *Decoder) skipString() error {
+	d.SkipWhitespace()
+	if d.buf[d.cursor] != '"' {
+		return d.unexpected("string")
+	}
+	start := d.cursor
+	d.cursor++
+	for {
+		c := d.buf[d.cursor]
+		if !stringSpecial[c] {
+			d.cursor++
+			continue
+		}
+		switch {
+		case c == '"':
+			d.cursor++
+			if err := d.checkStringLength(start+1, d.cursor-1); err != nil {
+				return err
+			}
+			if d.strict != Lenient && !utf8.Valid(d.buf[start+1:d.cursor-1]) {
+				return d.report(InvalidUTF8, start)
+			}
+			return nil
+		case c == '\\':
+			d.cursor++
+			switch d.buf[d.cursor] {
+			case '"', '\\', '/', 'b', 'f', 'n', 'r', 't':
+				d.cursor++
+			case 'u':
+				r, ok := d.readHex4(d.cursor + 1)
+				if !ok {
+					return d.Errorf("invalid \\u escape sequence in string")
+				}
+				escape := d.cursor - 1
+				d.cursor += 5
+				if d.strict == Lenient || !utf16.IsSurrogate(r) {
+					break
+				}
+				if r < 0xdc00 && d.buf[d.cursor] == '\\' && d.buf[d.cursor+1] == 'u' {
+					if r2, ok := d.readHex4(d.cursor + 2); ok && r2 >= 0xdc00 && r2 <= 0xdfff {
+						d.cursor += 6
+						break
+					}
+				}
+				if err := d.report(LoneSurrogate, escape); err != nil {
+					return err
+				}
+			default:
+				return d.invalidEscape()
+			}
+			continue
+		}
+		return d.invalidStringChar()
+	}
+}
+
+// skippedKey returns the key between the given offsets, which has been
+// validated by skipString, with its escape sequences decoded like String.
+//
+// NOTE(tav): Unlike String, escaped keys are decoded into a copy, as we don't
+// want to modify the raw input of skipped values.
+func (d *Decoder) skippedKey(start int, end int) []byte {
+	raw := d.buf[start:end]
+	if bytes.IndexByte(raw, '\\') == -1 {
+		return raw
+	}
+	key := make([]byte, 0, len(raw))
+	for i := start; i < end; i++ {
+		c := d.buf[i]
+		if c != '\\' {
+			key = append(key, c)
+			continue
+		}
+		i++
+		switch c = d.buf[i]; c {
+		case 'b':
+			c = '\b'
+		case 'f':
+			c = '\f'
+		case 'n':
+			c = '\n'
+		case 'r':
+			c = '\r'
+		case 't':
+			c = '\t'
+		case 'u':
+			r, _ := d.readHex4(i + 1)
+			i += 4
+			if utf16.IsSurrogate(r) {
+				r2 := utf8.RuneError
+				if i+6 < end && d.buf[i+1] == '\\' && d.buf[i+2] == 'u' {
+					if v, ok := d.readHex4(i + 3); ok {
+						r2 = v
+					}
+				}
+				if dec := utf16.DecodeRune(r, r2); dec != utf8.RuneError {
+					r = dec
+					i += 6
+				} else {
+					r = utf8.RuneError
+				}
+			}
+			key = utf8.AppendRune(key, r)
+			continue
+		}
+		key = append(key, c)
+	}
+	return key
+}
+
+func (d *Decoder) invalidEscape() error {
+	if d.eof() {
+		return d.Errorf("unexpected end of input in string")
+	}
+	return d.Errorf("invalid escape character %s in string", quoteChar(d.buf[d.cursor]))
+}
+
+func (d *Decoder) unescapeString(start int) ([]byte, error) {
+	buf := d.buf
+	w := d.cursor
+	for {
+		c := buf[d.cursor]
+		if !stringSpecial[c] {
+			buf[w] = c
+			w++
+			d.cursor++
+			continue
+		}
+		switch {
+		case c == '"':
+			d.cursor++
+			if err := d.checkStringLength(start, d.cursor-1); err != nil {
+				return nil, err
+			}
+			str := buf[start:w]
+			if d.strict != Lenient && !utf8.Valid(str) {
+				if err := d.report(InvalidUTF8, start-1); err != nil {
+					return nil, err
+				}
+			}
+			return str, nil
+		case c == '\\':
+			d.cursor++
+			c = buf[d.cursor]
+			switch c {
+			case '"', '\\', '/':
+			case 'b':
+				c = '\b'
+			case 'f':
+				c = '\f'
+			case 'n':
+				c = '\n'
+			case 'r':
+				c = '\r'
+			case 't':
+				c = '\t'
+			case 'u':
+				r, ok := d.readHex4(d.cursor + 1)
+				if !ok {
+					return nil, d.Errorf("invalid \\u escape sequence in string")
+				}
+				escape := d.cursor - 1
+				d.cursor += 5
+				if utf16.IsSurrogate(r) {
+					r2 := utf8.RuneError
+					if buf[d.cursor] == '\\' && buf[d.cursor+1] == 'u' {
+						if v, ok := d.readHex4(d.cursor + 2); ok {
+							r2 = v
+						}
+					}
+					if dec := utf16.DecodeRune(r, r2); dec != utf8.RuneError {
+						r = dec
+						d.cursor += 6
+					} else {
+						r = utf8.RuneError
+						if err := d.report(LoneSurrogate, escape); err != nil {
+							return nil, err
+						}
+					}
+				}
+				// NOTE(tav): The encoded rune is never longer than the escape
+				// sequence it came from, so it's safe to write it in place.
+				w += utf8.EncodeRune(buf[w:], r)
+				continue
+			default:
+				return nil, d.invalidEscape()
+			}
+			buf[w] = c
+			w++
+			d.cursor++
+			continue
+		}
+		return nil, d.invalidStringChar()
+	}
+}
+
+// newError creates a DecodeError for the current position of the Decoder.
+func (d *Decoder) newError(msg string, expected string) *DecodeError {
+	offset := d.cursor
+	end := len(d.buf) - 1
+	if end < 0 {
+		end = 0
+	}
+	if offset > end {
+		offset = end
+	}
+	line, lineStart := 1, 0
+	for i := 0; i < offset; i++ {
+		if d.buf[i] == '\n' {
+			line++
+			lineStart = i + 1
+		}
+	}
+	from, to := offset-snippetContext, offset+snippetContext
+	if from < lineStart {
+		from = lineStart
+	}
+	if to > end {
+		to = end
+	}
+	if idx := bytes.IndexByte(d.buf[offset:to], '\n'); idx >= 0 {
+		to = offset + idx
+	}
+	column := offset - lineStart + 1
+	if line == 1 {
+		column += d.origin.column
+	}
+	return &DecodeError{
+		Column:   column,
+		Expected: expected,
+		Line:     line + d.origin.line,
+		Msg:      msg,
+		Offset:   offset + d.origin.offset,
+		Path:     d.pathString(),
+		Snippet:  string(d.buf[from:to]),
+	}
+}
+
+// pathString renders the current path of the Decoder, e.g.
+// "transactions[3].operations[1].amount".
+func (d *Decoder) pathString() string {
+	var b []byte
+	for _, frame := range d.path {
+		if frame.index >= 0 {
+			b = append(b, '[')
+			b = strconv.AppendInt(b, int64(frame.index), 10)
+			b = append(b, ']')
+			continue
+		}
+		if frame.key == nil {
+			break
+		}
+		if isIdentKey(frame.key) {
+			if len(b) > 0 {
+				b = append(b, '.')
+			}
+			b = append(b, frame.key...)
+		} else {
+			b = append(b, '[')
+			b = strconv.AppendQuote(b, string(frame.key))
+			b = append(b, ']')
+		}
+	}
+	return string(b)
+}
+
+func (d *Decoder) pop() {
+	n := len(d.path)
+	if n == 0 {
+		return
+	}
+	if frame := d.path[n-1]; frame.index < 0 {
+		d.keys = d.keys[:frame.keys]
+	}
+	d.path = d.path[:n-1]
+}
+
+// report handles a finding of the given kind at the given offset.
+func (d *Decoder) report(kind FindingKind, offset int) error {
+	if d.strict == Lenient {
+		return nil
+	}
+	cursor := d.cursor
+	d.cursor = offset
+	err := d.newError(findingMessages[kind], "")
+	d.cursor = cursor
+	if d.strict == StrictError {
+		return err
+	}
+	if len(d.findings) < maxFindings {
+		d.findings = append(d.findings, Finding{Err: err, Kind: kind})
+	}
+	return nil
+}
+
+// setKey sets the key for the object currently being decoded. In strict
+// modes, the key is also checked against the previous keys of the object.
+func (d *Decoder) setKey(key []byte) error {
+	n := len(d.path)
+	if n == 0 {
+		return nil
+	}
+	frame := &d.path[n-1]
+	frame.key = key
+	if d.strict == Lenient {
+		return nil
+	}
+	for _, prev := range d.keys[frame.keys:] {
+		if string(prev) == string(key) {
+			return d.report(DuplicateKey, d.cursor)
+		}
+	}
+	d.keys = append(d.keys, key)
+	return nil
+}
+
+func (d *Decoder) unexpected(expected string) error {
+	if d.eof() {
+		return d.newError("unexpected end of input, expected "+expected, expected)
+	}
+	return d.newError(
+		"unexpected character "+quoteChar(d.buf[d.cursor])+", expected "+expected,
+		expected,
+	)
 }
 
 // ResetFromBytes will reset the Decoder's buffer and copy the given data into
@@ -51,11 +1071,16 @@ func (d *Decoder) ResetFromBytes(data []byte) {
 	copy(d.buf, data)
 	d.buf[l] = 0
 	d.cursor = 0
+	d.findings = d.findings[:0]
+	d.origin = position{}
+	d.keys = d.keys[:0]
+	d.path = d.path[:0]
 }
 
 // ResetFromReadCloser will reset the Decoder's buffer, and attempt to fill it by
 // reading everything from the given Reader. The Reader will be closed when the
-// method exits.
+// method exits. If the input exceeds the MaxSize limit, a LimitError is
+// returned as soon as the limit is exceeded.
 //
 // This method has been adapted from Go's io.ReadAll function.
 //
@@ -71,6 +1096,10 @@ func (d *Decoder) ResetFromReadCloser(r io.ReadCloser) error {
 		}
 		n, err := r.Read(b[len(b):cap(b)])
 		b = b[:len(b)+n]
+		if max := d.limits.MaxSize; max > 0 && len(b) > max {
+			b = append(b[:0], 0)
+			err = &LimitError{Kind: SizeLimit, Limit: max}
+		}
 		if err != nil {
 			if err == io.EOF {
 				// NOTE(tav): We append a null byte at the end, so as to make
@@ -81,6 +1110,10 @@ func (d *Decoder) ResetFromReadCloser(r io.ReadCloser) error {
 			r.Close()
 			d.buf = b
 			d.cursor = 0
+			d.findings = d.findings[:0]
+			d.origin = position{}
+			d.keys = d.keys[:0]
+			d.path = d.path[:0]
 			return err
 		}
 	}
@@ -92,3 +1125,38 @@ func NewDecoder() *Decoder {
 		buf: make([]byte, 0, 1024),
 	}
 }
+
+// isIdentKey returns whether the given key can be rendered within a path
+// without quoting.
+func isIdentKey(key []byte) bool {
+	if len(key) == 0 {
+		return false
+	}
+	for _, c := range key {
+		switch {
+		case c >= 'a' && c <= 'z', c >= 'A' && c <= 'Z', c >= '0' && c <= '9', c == '_', c == '-':
+		default:
+			return false
+		}
+	}
+	return true
+}
+
+func quoteChar(c byte) string {
+	if c >= 0x20 && c < 0x7f {
+		return strconv.QuoteRune(rune(c))
+	}
+	return fmt.Sprintf("'\\x%02x'", c)
+}
+
+func unhex(c byte) byte {
+	switch {
+	case c >= '0' && c <= '9':
+		return c - '0'
+	case c >= 'a' && c <= 'f':
+		return c - 'a' + 10
+	case c >= 'A' && c <= 'F':
+		return c - 'A' + 10
+	}
+	return 0xff
+}
@@ -0,0 +1,472 @@
+// Copyright 2021 Coinbase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package json
+
+import (
+	stdjson "encoding/json"
+	"errors"
+	"io"
+	"math"
+	"strings"
+	"testing"
+)
+
+var (
+	benchString = []byte(`"bad16a07c079f99117d9914855815b28bed3110d7fdc31645eaf9c1f1402f15b"`)
+	benchValue  = []byte(`{
+	"block_identifier": {"index": 16075615, "hash": "bad16a07c079f99117d9914855815b28bed3110d7fdc31645eaf9c1f1402f15b"},
+	"timestamp": 1624897505000,
+	"transactions": [{
+		"operations": [
+			{"operation_identifier": {"index": 0}, "type": "transfer", "status": "SUCCESS", "amount": {"value": "-1", "currency": {"symbol": "ONT", "decimals": 0}}},
+			{"operation_identifier": {"index": 1}, "type": "transfer", "status": "SUCCESS", "amount": {"value": "1", "currency": {"symbol": "ONT", "decimals": 0}}}
+		],
+		"metadata": {"memo": "café 😀", "fee": 0.05, "ok": true, "note": null}
+	}]
+}`)
+	resultBytes []byte
+	resultInt   int64
+)
+
+func BenchmarkSkipOld(b *testing.B) {
+	var v stdjson.RawMessage
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		if err := stdjson.Unmarshal(benchValue, &v); err != nil {
+			b.Fatalf("Failed to decode value: %s", err)
+		}
+	}
+	resultBytes = v
+}
+
+func BenchmarkSkipNew(b *testing.B) {
+	d := NewDecoder()
+	var v []byte
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		d.ResetFromBytes(benchValue)
+		raw, err := d.Raw()
+		if err != nil {
+			b.Fatalf("Failed to decode value: %s", err)
+		}
+		v = raw
+	}
+	resultBytes = v
+}
+
+func BenchmarkStringOld(b *testing.B) {
+	var v string
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		if err := stdjson.Unmarshal(benchString, &v); err != nil {
+			b.Fatalf("Failed to decode value: %s", err)
+		}
+	}
+	resultBytes = []byte(v)
+}
+
+func BenchmarkStringNew(b *testing.B) {
+	d := NewDecoder()
+	var v []byte
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		d.ResetFromBytes(benchString)
+		s, err := d.String()
+		if err != nil {
+			b.Fatalf("Failed to decode value: %s", err)
+		}
+		v = s
+	}
+	resultBytes = v
+}
+
+func BenchmarkInt64Old(b *testing.B) {
+	src := []byte("-1624897505000")
+	var v int64
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		if err := stdjson.Unmarshal(src, &v); err != nil {
+			b.Fatalf("Failed to decode value: %s", err)
+		}
+	}
+	resultInt = v
+}
+
+func BenchmarkInt64New(b *testing.B) {
+	src := []byte("-1624897505000")
+	d := NewDecoder()
+	var v int64
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		d.ResetFromBytes(src)
+		n, err := d.Int64()
+		if err != nil {
+			b.Fatalf("Failed to decode value: %s", err)
+		}
+		v = n
+	}
+	resultInt = v
+}
+
+func TestDecodeNumbers(t *testing.T) {
+	d := NewDecoder()
+	for _, test := range []struct {
+		src  string
+		want int64
+		ok   bool
+	}{
+		{"0", 0, true},
+		{" -42 ", -42, true},
+		{"9223372036854775807", math.MaxInt64, true},
+		{"-9223372036854775808", math.MinInt64, true},
+		{"9223372036854775808", 0, false},
+		{"18446744073709551616", 0, false},
+		{"1.5", 0, false},
+		{"1e3", 0, false},
+		{"-", 0, false},
+		{`"1"`, 0, false},
+	} {
+		d.ResetFromBytes([]byte(test.src))
+		got, err := d.Int64()
+		if test.ok {
+			if err != nil {
+				t.Errorf("Unexpected error decoding int64 %q: %s", test.src, err)
+			} else if got != test.want {
+				t.Errorf("Decoded int64 %q as %d, want %d", test.src, got, test.want)
+			}
+		} else if err == nil {
+			t.Errorf("Expected error decoding int64 %q", test.src)
+		}
+	}
+	for _, test := range []struct {
+		src  string
+		want float64
+		ok   bool
+	}{
+		{"0.5", 0.5, true},
+		{"-1.25e2", -125, true},
+		{"1E-2", 0.01, true},
+		{"1.", 0, false},
+		{"1e", 0, false},
+		{"1e400", 0, false},
+	} {
+		d.ResetFromBytes([]byte(test.src))
+		got, err := d.Float64()
+		if test.ok {
+			if err != nil {
+				t.Errorf("Unexpected error decoding float64 %q: %s", test.src, err)
+			} else if got != test.want {
+				t.Errorf("Decoded float64 %q as %v, want %v", test.src, got, test.want)
+			}
+		} else if err == nil {
+			t.Errorf("Expected error decoding float64 %q", test.src)
+		}
+	}
+}
+
+func TestDecodeSkip(t *testing.T) {
+	d := NewDecoder()
+	for _, src := range []string{
+		`null`, `true`, `false`, `-1.5e+3`, `""`, `"a\"b"`, `[]`, `{}`,
+		string(benchValue),
+	} {
+		d.ResetFromBytes([]byte(src))
+		if err := d.Skip(); err != nil {
+			t.Errorf("Unexpected error skipping %s: %s", src, err)
+			continue
+		}
+		if err := d.End(); err != nil {
+			t.Errorf("Unexpected error after skipping %s: %s", src, err)
+		}
+	}
+	for _, src := range []string{
+		``, `nul`, `tru`, `[1,]`, `{"a":1,}`, `{"a" 1}`, `{1:1}`, `"abc`,
+		`"\x"`, `"\u12"`, "\"a\x01\"", `[1 2]`, `-`, `.5`,
+	} {
+		d.ResetFromBytes([]byte(src))
+		if err := d.Skip(); err == nil {
+			t.Errorf("Expected error skipping %q", src)
+		}
+	}
+	d.ResetFromBytes([]byte(`{} {}`))
+	if err := d.Skip(); err != nil {
+		t.Fatalf("Unexpected error skipping value: %s", err)
+	}
+	if err := d.End(); err == nil {
+		t.Errorf("Expected error for trailing value")
+	}
+}
+
+func TestDecodeString(t *testing.T) {
+	d := NewDecoder()
+	for _, test := range []struct {
+		src  string
+		want string
+	}{
+		{`"abc"`, "abc"},
+		{`""`, ""},
+		{`"a\"b\\c\/d"`, `a"b\c/d`},
+		{`"\b\f\n\r\t"`, "\b\f\n\r\t"},
+		{`"café"`, "café"},
+		{`"😀!"`, "\U0001F600!"},
+		{`"\ud83d!"`, "�!"},
+		{`"\ud83dA"`, "�A"},
+		{`"\ude00"`, "�"},
+	} {
+		d.ResetFromBytes([]byte(test.src))
+		got, err := d.String()
+		if err != nil {
+			t.Errorf("Unexpected error decoding string %s: %s", test.src, err)
+			continue
+		}
+		if string(got) != test.want {
+			t.Errorf("Decoded string %s as %q, want %q", test.src, got, test.want)
+		}
+	}
+	d.ResetFromBytes([]byte(`{"ab": "c", "d": [1, "e"]}`))
+	more, err := d.ObjectStart()
+	keys := []string{}
+	for more && err == nil {
+		var key []byte
+		if key, err = d.Key(); err != nil {
+			break
+		}
+		keys = append(keys, string(key))
+		if err = d.Skip(); err != nil {
+			break
+		}
+		more, err = d.ObjectNext()
+	}
+	if err != nil {
+		t.Fatalf("Unexpected error decoding object: %s", err)
+	}
+	if len(keys) != 2 || keys[0] != "ab" || keys[1] != "d" {
+		t.Errorf("Decoded unexpected object keys: %q", keys)
+	}
+}
+
+func TestDecodeHexBytes(t *testing.T) {
+	d := NewDecoder()
+	d.ResetFromBytes([]byte(`"00ffA0"`))
+	got, err := d.HexBytes(nil)
+	if err != nil {
+		t.Fatalf("Unexpected error decoding hex bytes: %s", err)
+	}
+	if string(got) != "\x00\xff\xa0" {
+		t.Errorf("Decoded unexpected hex bytes: %x", got)
+	}
+	for _, src := range []string{`"0"`, `"0g"`, `"00`, `00`} {
+		d.ResetFromBytes([]byte(src))
+		if _, err := d.HexBytes(nil); err == nil {
+			t.Errorf("Expected error decoding hex bytes %s", src)
+		}
+	}
+}
+
+func TestDecodeError(t *testing.T) {
+	d := NewDecoder()
+	d.ResetFromBytes([]byte(`{
+  "block": {
+    "transactions": [{}, {}, {}, {
+      "operations": [{}, {"amount": {"value": 12}}]
+    }]
+  }
+}`))
+	err := decodeValue(d, []string{"block", "transactions", "operations", "amount", "value"})
+	derr, ok := err.(*DecodeError)
+	if !ok {
+		t.Fatalf("Expected a DecodeError, got: %v", err)
+	}
+	if want := "block.transactions[3].operations[1].amount.value"; derr.Path != want {
+		t.Errorf("Got error path %q, want %q", derr.Path, want)
+	}
+	if derr.Expected != "string" {
+		t.Errorf("Got expected value %q, want %q", derr.Expected, "string")
+	}
+	if derr.Line != 4 || derr.Column != 47 {
+		t.Errorf("Got error position %d:%d, want 4:47", derr.Line, derr.Column)
+	}
+	if want := `}, {"amount": {"value": 12}}]`; derr.Snippet != want {
+		t.Errorf("Got error snippet %q, want %q", derr.Snippet, want)
+	}
+}
+
+// decodeValue decodes the given path of keys, descending into all array
+// elements, and expects a string at the end of it.
+func decodeValue(d *Decoder, path []string) error {
+	if len(path) == 0 {
+		_, err := d.String()
+		return err
+	}
+	if d.Peek() == '[' {
+		more, err := d.ArrayStart()
+		for more {
+			if err = decodeValue(d, path); err != nil {
+				return err
+			}
+			more, err = d.ArrayNext()
+		}
+		return err
+	}
+	more, err := d.ObjectStart()
+	for more {
+		var key []byte
+		if key, err = d.Key(); err != nil {
+			return err
+		}
+		if string(key) == path[0] {
+			err = decodeValue(d, path[1:])
+		} else {
+			err = d.Skip()
+		}
+		if err != nil {
+			return err
+		}
+		more, err = d.ObjectNext()
+	}
+	return err
+}
+
+func TestDecodeStrict(t *testing.T) {
+	d := NewDecoder()
+	for _, test := range []struct {
+		src  string
+		kind FindingKind
+	}{
+		{`{"a": 1, "a": 2}`, DuplicateKey},
+		{`{"a": {"b": 1, "b": 2}}`, DuplicateKey},
+		{`{"a": 1, "\u0061": 2}`, DuplicateKey},
+		{`{"\n": 1, "\u000a": 2}`, DuplicateKey},
+		{"{\"\\ud83d\\ude00\": 1, \"\U0001f600\": 2}", DuplicateKey},
+		{"[\"\xff\"]", InvalidUTF8},
+		{"[\"\\n\xff\"]", InvalidUTF8},
+		{`["\ud83d"]`, LoneSurrogate},
+		{`["\ude00A"]`, LoneSurrogate},
+		{`[007]`, LeadingZero},
+		{`[-01.5]`, LeadingZero},
+	} {
+		d.ResetFromBytes([]byte(test.src))
+		d.SetStrict(Lenient)
+		if err := d.Skip(); err != nil {
+			t.Errorf("Unexpected error skipping %q leniently: %s", test.src, err)
+		}
+		if len(d.Findings()) != 0 {
+			t.Errorf("Unexpected findings skipping %q leniently", test.src)
+		}
+		d.ResetFromBytes([]byte(test.src))
+		d.SetStrict(StrictWarn)
+		if err := d.Skip(); err != nil {
+			t.Errorf("Unexpected error skipping %q with warnings: %s", test.src, err)
+		}
+		findings := d.Findings()
+		if len(findings) != 1 || findings[0].Kind != test.kind {
+			t.Errorf("Got findings %v for %q, want a single %s", findings, test.src, test.kind)
+		}
+		d.ResetFromBytes([]byte(test.src))
+		d.SetStrict(StrictError)
+		if err := d.Skip(); err == nil {
+			t.Errorf("Expected error skipping %q strictly", test.src)
+		}
+	}
+	for _, src := range []string{
+		`{"a": 1, "b": {"a": 2}, "c": [{"a": 1}, {"a": 1}]}`, `["\ud83d\ude00"]`, `[0, -0.5, 10]`,
+		`{"\u0061": 1, "\u0062": 2, "a\/": 3}`,
+	} {
+		d.ResetFromBytes([]byte(src))
+		d.SetStrict(StrictError)
+		if err := d.Skip(); err != nil {
+			t.Errorf("Unexpected error skipping %q strictly: %s", src, err)
+		}
+	}
+	// Strings and numbers read directly are also checked.
+	d.ResetFromBytes([]byte(`{"a": "\ud83d", "a": 01}`))
+	d.SetStrict(StrictWarn)
+	more, err := d.ObjectStart()
+	for more && err == nil {
+		if _, err = d.Key(); err != nil {
+			break
+		}
+		if d.Peek() == '"' {
+			_, err = d.String()
+		} else {
+			_, err = d.Int64()
+		}
+		if err != nil {
+			break
+		}
+		more, err = d.ObjectNext()
+	}
+	if err != nil {
+		t.Fatalf("Unexpected error decoding with warnings: %s", err)
+	}
+	kinds := []FindingKind{}
+	for _, finding := range d.Findings() {
+		kinds = append(kinds, finding.Kind)
+	}
+	if len(kinds) != 3 || kinds[0] != LoneSurrogate || kinds[1] != DuplicateKey || kinds[2] != LeadingZero {
+		t.Errorf("Got unexpected findings: %v", kinds)
+	}
+}
+
+func TestDecodeLimits(t *testing.T) {
+	d := NewDecoder()
+	d.SetLimits(Limits{
+		MaxArrayLength:  3,
+		MaxDepth:        3,
+		MaxSize:         64,
+		MaxStringLength: 4,
+	})
+	for _, test := range []struct {
+		src  string
+		kind LimitKind
+	}{
+		{`[1, 2, 3, 4]`, ArrayLengthLimit},
+		{`[[[[1]]]]`, DepthLimit},
+		{`{"a": {"b": {"c": {}}}}`, ""},
+		{`{"a": {"b": {"c": {"d": 1}}}}`, DepthLimit},
+		{`"abcde"`, StringLengthLimit},
+		{`{"abcde": 1}`, StringLengthLimit},
+		{`"a\nbc"`, StringLengthLimit},
+		{`["abcd", [1, 2, 3]]`, ""},
+		{`[` + strings.Repeat(`1,`, 40) + `1]`, SizeLimit},
+	} {
+		err := d.ResetFromReadCloser(io.NopCloser(strings.NewReader(test.src)))
+		if err == nil {
+			err = d.Skip()
+		}
+		if test.kind == "" {
+			if err != nil {
+				t.Errorf("Unexpected error decoding %s: %s", test.src, err)
+			}
+			continue
+		}
+		var lerr *LimitError
+		if !errors.As(err, &lerr) {
+			t.Errorf("Expected LimitError decoding %s, got: %v", test.src, err)
+			continue
+		}
+		if lerr.Kind != test.kind {
+			t.Errorf("Got %s LimitError decoding %s, want %s", lerr.Kind, test.src, test.kind)
+		}
+	}
+	d.ResetFromBytes([]byte(`"abcde"`))
+	if _, err := d.String(); err == nil {
+		t.Errorf("Expected LimitError when reading a long string")
+	}
+	d.ResetFromBytes([]byte(`"0011223344"`))
+	if _, err := d.HexBytes(nil); err == nil {
+		t.Errorf("Expected LimitError when reading long hex bytes")
+	}
+}
@@ -0,0 +1,92 @@
+// Copyright 2021 Coinbase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package json
+
+// Indent appends an indented form of the given JSON value to dst. Each
+// element of an array or object begins on a new line, starting with the
+// prefix, followed by one copy of indent for each level of nesting. Empty
+// arrays and objects are kept on a single line.
+//
+// Unlike encoding/json.Indent, the value is validated before anything is
+// appended, and whitespace within the input is discarded rather than
+// preserved.
+func Indent(dst []byte, src []byte, prefix string, indent string) ([]byte, error) {
+	d := NewDecoder()
+	d.ResetFromBytes(src)
+	if err := d.Skip(); err != nil {
+		return dst, err
+	}
+	if err := d.End(); err != nil {
+		return dst, err
+	}
+	depth := 0
+	newline := func() {
+		dst = append(dst, '\n')
+		dst = append(dst, prefix...)
+		for i := 0; i < depth; i++ {
+			dst = append(dst, indent...)
+		}
+	}
+	for i := 0; i < len(src); i++ {
+		c := src[i]
+		switch c {
+		case '"':
+			start := i
+			for i++; src[i] != '"'; i++ {
+				if src[i] == '\\' {
+					i++
+				}
+			}
+			dst = append(dst, src[start:i+1]...)
+		case ',':
+			dst = append(dst, ',')
+			newline()
+		case ':':
+			dst = append(dst, ": "...)
+		case '[', '{':
+			dst = append(dst, c)
+			j := i + 1
+			for j < len(src) && whitespace[src[j]] {
+				j++
+			}
+			if src[j] == ']' || src[j] == '}' {
+				dst = append(dst, src[j])
+				i = j
+				continue
+			}
+			depth++
+			newline()
+		case ']', '}':
+			depth--
+			newline()
+			dst = append(dst, c)
+		default:
+			if !whitespace[c] {
+				dst = append(dst, c)
+			}
+		}
+	}
+	return dst, nil
+}
+
+// Pretty returns the given JSON value indented with two spaces, e.g. for the
+// output of EncodeJSON. Invalid values are returned as is.
+func Pretty(src []byte) []byte {
+	out, err := Indent(nil, src, "", "  ")
+	if err != nil {
+		return src
+	}
+	return out
+}
@@ -0,0 +1,67 @@
+// Copyright 2021 Coinbase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package json
+
+import (
+	"testing"
+)
+
+func TestIndent(t *testing.T) {
+	for _, test := range []struct {
+		src  string
+		want string
+	}{
+		{`1`, `1`},
+		{` "a" `, `"a"`},
+		{`{}`, `{}`},
+		{`[ ]`, `[]`},
+		{`{"a":1}`, "{\n>  \"a\": 1\n>}"},
+		{`[1, 2]`, "[\n>  1,\n>  2\n>]"},
+		{
+			`{"a":[{"b":{}}],"c\",:{":"[,]"}`,
+			"{\n>  \"a\": [\n>    {\n>      \"b\": {}\n>    }\n>  ],\n>  \"c\\\",:{\": \"[,]\"\n>}",
+		},
+	} {
+		got, err := Indent(nil, []byte(test.src), ">", "  ")
+		if err != nil {
+			t.Errorf("Unexpected error indenting %s: %s", test.src, err)
+			continue
+		}
+		if string(got) != test.want {
+			t.Errorf("Indent(%s) = %q, want %q", test.src, got, test.want)
+		}
+	}
+	for _, src := range []string{`{"a":}`, `[1,]`, `1 2`, `"a`} {
+		dst := []byte("x")
+		got, err := Indent(dst, []byte(src), "", "\t")
+		if err == nil {
+			t.Errorf("Expected an error indenting %s", src)
+		}
+		if string(got) != "x" {
+			t.Errorf("Indent(%s) modified dst: %q", src, got)
+		}
+	}
+}
+
+func TestPretty(t *testing.T) {
+	got := Pretty([]byte(`{"a":[1]}`))
+	want := "{\n  \"a\": [\n    1\n  ]\n}"
+	if string(got) != want {
+		t.Errorf("Pretty() = %q, want %q", got, want)
+	}
+	if got := Pretty([]byte(`{`)); string(got) != `{` {
+		t.Errorf("Pretty() of invalid input = %q, want it unchanged", got)
+	}
+}
@@ -0,0 +1,440 @@
+// Copyright 2021 Coinbase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package json
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// streamBufferSize specifies the size of the buffer used to read streamed
+// input.
+const streamBufferSize = 64 << 10
+
+// position specifies the position within the original input of the data held
+// by a Decoder. The line and column are the number of lines and columns that
+// precede the data.
+type position struct {
+	column int
+	line   int
+	offset int
+}
+
+// streamer incrementally reads the input for ResetFromStream. Values are
+// copied into either the skeleton, i.e. the input without the elements of
+// the streamed array, or the Decoder's buffer for the current element.
+type streamer struct {
+	d         *Decoder
+	fn        func(idx int) error
+	frames    []pathFrame
+	line      int
+	lineStart int
+	max       int
+	offset    int
+	path      []string
+	r         *bufio.Reader
+}
+
+// ResetFromStream will reset the Decoder, and incrementally read the JSON
+// value from the given Reader, so that large inputs don't need to be held in
+// memory. The Reader will be closed when the method exits.
+//
+// The path specifies the keys of the nested objects leading to an array, e.g.
+// ["block", "transactions"]. Instead of being buffered, each element of the
+// array is loaded into the Decoder by itself, and fn is called with its index
+// to decode it, e.g. by calling DecodeJSON on an API value followed by End.
+// Errors within an element are reported with the path and position of the
+// element within the full input.
+//
+// Once the input has been read, the Decoder is reset to the rest of the value,
+// with the array left empty, so that it can be decoded as usual. Positions of
+// errors within this data are relative to the input with the elements of the
+// array removed.
+//
+// Findings accumulate across the elements and the rest of the value. The
+// MaxSize limit applies to the input as a whole, i.e. the total number of
+// bytes read from the Reader.
+func (d *Decoder) ResetFromStream(r io.ReadCloser, path []string, fn func(idx int) error) error {
+	defer r.Close()
+	if d.reader == nil {
+		d.reader = bufio.NewReaderSize(r, streamBufferSize)
+	} else {
+		d.reader.Reset(r)
+	}
+	d.findings = d.findings[:0]
+	s := &streamer{
+		d:    d,
+		fn:   fn,
+		line: 1,
+		max:  d.limits.MaxSize,
+		path: path,
+		r:    d.reader,
+	}
+	for _, key := range path {
+		s.frames = append(s.frames, pathFrame{index: -1, key: []byte(key)})
+	}
+	var err error
+	skeleton := d.skeleton[:0]
+	if len(path) == 0 {
+		skeleton, err = s.copyValue(skeleton)
+	} else {
+		skeleton, err = s.walk(skeleton, 0)
+	}
+	if err == nil {
+		skeleton, err = s.copyRest(skeleton)
+	}
+	// NOTE(tav): We drop the reference to the Reader, so that it can be
+	// garbage collected while the Decoder is idle.
+	d.reader.Reset(nil)
+	if err != nil {
+		skeleton = skeleton[:0]
+	}
+	// NOTE(tav): The element buffer and skeleton are swapped, so that both can
+	// be reused by future calls.
+	d.skeleton = d.buf
+	d.buf = append(skeleton, 0)
+	d.cursor = 0
+	d.keys = d.keys[:0]
+	d.origin = position{}
+	d.path = d.path[:0]
+	return err
+}
+
+// copyNested copies an array or object, including any nested values.
+func (s *streamer) copyNested(dst []byte) ([]byte, error) {
+	depth := 0
+	for {
+		var (
+			c   byte
+			err error
+		)
+		if dst, c, err = s.read(dst); err != nil {
+			return dst, err
+		}
+		switch c {
+		case '"':
+			if dst, err = s.copyStringBody(dst); err != nil {
+				return dst, err
+			}
+		case '{', '[':
+			depth++
+		case '}', ']':
+			depth--
+			if depth == 0 {
+				return dst, nil
+			}
+		}
+	}
+}
+
+// copyRest copies everything up to the end of the input.
+func (s *streamer) copyRest(dst []byte) ([]byte, error) {
+	for {
+		if _, ok := s.peek(); !ok {
+			return dst, s.readErr()
+		}
+		var err error
+		if dst, _, err = s.read(dst); err != nil {
+			return dst, err
+		}
+	}
+}
+
+// copyStringBody copies the rest of a string after its opening quote.
+func (s *streamer) copyStringBody(dst []byte) ([]byte, error) {
+	for {
+		var (
+			c   byte
+			err error
+		)
+		if dst, c, err = s.read(dst); err != nil {
+			return dst, err
+		}
+		switch c {
+		case '"':
+			return dst, nil
+		case '\\':
+			if dst, _, err = s.read(dst); err != nil {
+				return dst, err
+			}
+		}
+	}
+}
+
+// copyValue copies the next value, along with any preceding whitespace. Any
+// malformed input is copied as is, so that it can be reported by the Decoder.
+func (s *streamer) copyValue(dst []byte) ([]byte, error) {
+	dst, err := s.copyWhitespace(dst)
+	if err != nil {
+		return dst, err
+	}
+	c, ok := s.peek()
+	if !ok {
+		return dst, s.readErr()
+	}
+	switch c {
+	case '{', '[':
+		return s.copyNested(dst)
+	case '"':
+		if dst, _, err = s.read(dst); err != nil {
+			return dst, err
+		}
+		return s.copyStringBody(dst)
+	}
+	for {
+		c, ok := s.peek()
+		if !ok {
+			return dst, s.readErr()
+		}
+		switch c {
+		case ',', ':', ']', '}', '{', '[', '"', ' ', '\t', '\n', '\r':
+			return dst, nil
+		}
+		if dst, _, err = s.read(dst); err != nil {
+			return dst, err
+		}
+	}
+}
+
+func (s *streamer) copyWhitespace(dst []byte) ([]byte, error) {
+	for {
+		c, ok := s.peek()
+		if !ok || !whitespace[c] {
+			return dst, nil
+		}
+		var err error
+		if dst, _, err = s.read(dst); err != nil {
+			return dst, err
+		}
+	}
+}
+
+// element loads the next element of the streamed array into the Decoder, and
+// calls fn to decode it.
+func (s *streamer) element(idx int) error {
+	d := s.d
+	origin := position{
+		column: s.offset - s.lineStart,
+		line:   s.line - 1,
+		offset: s.offset,
+	}
+	buf, err := s.copyValue(d.buf[:0])
+	d.buf = append(buf, 0)
+	if err != nil {
+		return err
+	}
+	d.cursor = 0
+	d.keys = d.keys[:0]
+	d.origin = origin
+	d.path = append(d.path[:0], s.frames...)
+	d.path = append(d.path, pathFrame{index: idx})
+	return s.fn(idx)
+}
+
+func (s *streamer) errorf(idx int, format string, args ...interface{}) *DecodeError {
+	path := strings.Join(s.path, ".")
+	if idx >= 0 {
+		path += "[" + strconv.Itoa(idx) + "]"
+	}
+	return &DecodeError{
+		Column: s.offset - s.lineStart + 1,
+		Line:   s.line,
+		Msg:    fmt.Sprintf(format, args...),
+		Offset: s.offset,
+		Path:   path,
+	}
+}
+
+// next consumes the next byte of input, while enforcing the MaxSize limit.
+func (s *streamer) next() (byte, error) {
+	if s.max > 0 && s.offset >= s.max {
+		return 0, &LimitError{Kind: SizeLimit, Limit: s.max}
+	}
+	c, err := s.r.ReadByte()
+	if err != nil {
+		return 0, err
+	}
+	s.offset++
+	if c == '\n' {
+		s.line++
+		s.lineStart = s.offset
+	}
+	return c, nil
+}
+
+// peek returns the next byte of input without consuming it. It returns false
+// at the end of the input, or if the input could not be read.
+func (s *streamer) peek() (byte, bool) {
+	b, err := s.r.Peek(1)
+	if err != nil {
+		return 0, false
+	}
+	return b[0], true
+}
+
+// read consumes the next byte of input, and appends it to dst.
+func (s *streamer) read(dst []byte) ([]byte, byte, error) {
+	c, err := s.next()
+	if err != nil {
+		if err == io.EOF {
+			return dst, 0, s.errorf(-1, "unexpected end of input")
+		}
+		return dst, 0, err
+	}
+	return append(dst, c), c, nil
+}
+
+// readErr returns the error that stopped the input from being read, if it was
+// anything other than reaching the end of the input.
+func (s *streamer) readErr() error {
+	if _, err := s.r.Peek(1); err != nil && err != io.EOF {
+		return err
+	}
+	return nil
+}
+
+func (s *streamer) skipWhitespace() error {
+	for {
+		c, ok := s.peek()
+		if !ok || !whitespace[c] {
+			return s.readErr()
+		}
+		if _, err := s.next(); err != nil {
+			return err
+		}
+	}
+}
+
+// stream reads the elements of the array at the end of the path, and appends
+// an empty array to the skeleton in its place.
+func (s *streamer) stream(dst []byte) ([]byte, error) {
+	if c, ok := s.peek(); !ok || c != '[' {
+		return s.copyValue(dst)
+	}
+	if _, err := s.next(); err != nil {
+		return dst, err
+	}
+	dst = append(dst, "[]"...)
+	if err := s.skipWhitespace(); err != nil {
+		return dst, err
+	}
+	if c, ok := s.peek(); ok && c == ']' {
+		_, err := s.next()
+		return dst, err
+	}
+	for idx := 0; ; idx++ {
+		if max := s.d.limits.MaxArrayLength; max > 0 && idx >= max {
+			return dst, &LimitError{
+				Err: s.errorf(
+					idx, "array exceeds the maximum length of %d elements", max,
+				),
+				Kind:  ArrayLengthLimit,
+				Limit: max,
+			}
+		}
+		if err := s.element(idx); err != nil {
+			return dst, err
+		}
+		if err := s.skipWhitespace(); err != nil {
+			return dst, err
+		}
+		c, ok := s.peek()
+		if !ok {
+			return dst, s.errorf(idx, "unexpected end of input, expected ',' or ']'")
+		}
+		switch c {
+		case ',':
+			_, err := s.next()
+			if err != nil {
+				return dst, err
+			}
+		case ']':
+			_, err := s.next()
+			return dst, err
+		default:
+			return dst, s.errorf(
+				idx, "unexpected character %s, expected ',' or ']'", quoteChar(c),
+			)
+		}
+	}
+}
+
+// walk copies the object at the given depth of the path, and descends into
+// the value for the key at that depth.
+func (s *streamer) walk(dst []byte, depth int) ([]byte, error) {
+	dst, err := s.copyWhitespace(dst)
+	if err != nil {
+		return dst, err
+	}
+	if c, ok := s.peek(); !ok || c != '{' {
+		return s.copyValue(dst)
+	}
+	if dst, _, err = s.read(dst); err != nil {
+		return dst, err
+	}
+	for {
+		if dst, err = s.copyWhitespace(dst); err != nil {
+			return dst, err
+		}
+		// NOTE(tav): Malformed input is left for copyRest to copy, so that
+		// it can be reported by the Decoder.
+		if c, ok := s.peek(); !ok || c != '"' {
+			return dst, nil
+		}
+		start := len(dst)
+		if dst, err = s.copyValue(dst); err != nil {
+			return dst, err
+		}
+		key := string(dst[start+1 : len(dst)-1])
+		if dst, err = s.copyWhitespace(dst); err != nil {
+			return dst, err
+		}
+		if c, ok := s.peek(); !ok || c != ':' {
+			return dst, nil
+		}
+		if dst, _, err = s.read(dst); err != nil {
+			return dst, err
+		}
+		switch {
+		case key != s.path[depth]:
+			dst, err = s.copyValue(dst)
+		case depth+1 < len(s.path):
+			dst, err = s.walk(dst, depth+1)
+		default:
+			if dst, err = s.copyWhitespace(dst); err == nil {
+				dst, err = s.stream(dst)
+			}
+		}
+		if err != nil {
+			return dst, err
+		}
+		if dst, err = s.copyWhitespace(dst); err != nil {
+			return dst, err
+		}
+		c, ok := s.peek()
+		if !ok || (c != ',' && c != '}') {
+			return dst, nil
+		}
+		if dst, _, err = s.read(dst); err != nil {
+			return dst, err
+		}
+		if c == '}' {
+			return dst, nil
+		}
+	}
+}
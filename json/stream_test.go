@@ -0,0 +1,143 @@
+// Copyright 2021 Coinbase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package json
+
+import (
+	"errors"
+	"io"
+	"strings"
+	"testing"
+	"testing/iotest"
+)
+
+var streamPath = []string{"block", "transactions"}
+
+func streamValues(d *Decoder, src string) ([]string, string, error) {
+	var elems []string
+	r := io.NopCloser(iotest.OneByteReader(strings.NewReader(src)))
+	err := d.ResetFromStream(r, streamPath, func(idx int) error {
+		raw, err := d.Raw()
+		if err != nil {
+			return err
+		}
+		elems = append(elems, string(raw))
+		return d.End()
+	})
+	if err != nil {
+		return elems, "", err
+	}
+	rest, err := d.Raw()
+	if err == nil {
+		err = d.End()
+	}
+	return elems, string(rest), err
+}
+
+func TestStream(t *testing.T) {
+	d := NewDecoder()
+	for _, test := range []struct {
+		src   string
+		elems []string
+		rest  string
+	}{
+		{
+			`{"block": {"index": 1, "transactions": [{"a": "]"}, [1, {}], "x\"y"], "z": null}, "other": []}`,
+			[]string{`{"a": "]"}`, `[1, {}]`, `"x\"y"`},
+			`{"block": {"index": 1, "transactions": [], "z": null}, "other": []}`,
+		},
+		{
+			`{"block": {"transactions": []}}`,
+			nil,
+			`{"block": {"transactions": []}}`,
+		},
+		{
+			`{"transactions": [1], "block": null}`,
+			nil,
+			`{"transactions": [1], "block": null}`,
+		},
+		{
+			`{"block": {"transactions": null}}`,
+			nil,
+			`{"block": {"transactions": null}}`,
+		},
+		{
+			`{"block": {"transactions": [ 1 , 2 ]}}`,
+			[]string{`1`, `2`},
+			`{"block": {"transactions": []}}`,
+		},
+	} {
+		elems, rest, err := streamValues(d, test.src)
+		if err != nil {
+			t.Errorf("Unexpected error streaming %s: %s", test.src, err)
+			continue
+		}
+		if strings.Join(elems, "|") != strings.Join(test.elems, "|") {
+			t.Errorf("Got elements %q when streaming %s, want %q", elems, test.src, test.elems)
+		}
+		if rest != test.rest {
+			t.Errorf("Got %s when streaming %s, want %s", rest, test.src, test.rest)
+		}
+	}
+}
+
+func TestStreamErrors(t *testing.T) {
+	d := NewDecoder()
+	src := "{\"block\": {\n  \"transactions\": [\n    {\"a\": 1},\n    {\"a\": x}\n  ]\n}}"
+	_, _, err := streamValues(d, src)
+	var derr *DecodeError
+	if !errors.As(err, &derr) {
+		t.Fatalf("Expected DecodeError, got: %v", err)
+	}
+	if derr.Path != "block.transactions[1].a" || derr.Line != 4 || derr.Column != 11 || derr.Offset != 56 {
+		t.Errorf("Got unexpected error: %s", derr)
+	}
+	if src[derr.Offset] != 'x' {
+		t.Errorf("Got offset %d which doesn't point at the invalid value", derr.Offset)
+	}
+	for _, src := range []string{
+		`{"block": {"transactions": [1, 2`,
+		`{"block": {"transactions": [1 2]}}`,
+		`{"block": {"transactions": [1]}`,
+		`{"block": {"transactions": [1]}} x`,
+	} {
+		if _, _, err := streamValues(d, src); err == nil {
+			t.Errorf("Expected error when streaming %s", src)
+		}
+	}
+	d.SetLimits(Limits{MaxArrayLength: 2, MaxDepth: 3, MaxSize: 40})
+	for _, test := range []struct {
+		src  string
+		kind LimitKind
+	}{
+		{`{"block": {"transactions": [1, 2, 3]}}`, ArrayLengthLimit},
+		{`{"block": {"transactions": [[1]]}}`, DepthLimit},
+		{`{"block": {"transactions": ["` + strings.Repeat("a", 40) + `"]}}`, SizeLimit},
+		{`{"block": {"transactions": [], "x": "0123456789"}}`, SizeLimit},
+		{`{"block": {"transactions": ["` + strings.Repeat("a", 8) + `", "` + strings.Repeat("a", 8) + `"]}}`, SizeLimit},
+		{`{"block": {"transactions": [1, 2]}}`, ""},
+	} {
+		_, _, err := streamValues(d, test.src)
+		if test.kind == "" {
+			if err != nil {
+				t.Errorf("Unexpected error streaming %s: %s", test.src, err)
+			}
+			continue
+		}
+		var lerr *LimitError
+		if !errors.As(err, &lerr) || lerr.Kind != test.kind {
+			t.Errorf("Expected %s LimitError streaming %s, got: %v", test.kind, test.src, err)
+		}
+	}
+}
@@ -94,6 +94,11 @@ func Infof(format string, args ...interface{}) {
 	sugar.Infof(format, args...)
 }
 
+// Warnf uses fmt.Sprintf to log a formatted string.
+func Warnf(format string, args ...interface{}) {
+	sugar.Warnf(format, args...)
+}
+
 func init() {
 	enc := zap.NewDevelopmentEncoderConfig()
 	enc.EncodeLevel = zapcore.CapitalColorLevelEncoder
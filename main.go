@@ -75,6 +75,12 @@ func runMethod(args []string, exec func(*validate.Runner, context.Context) error
 	runner := validate.New(cfg, db)
 	process.SetExitHandler(cancel)
 	err := exec(runner, ctx)
+	if cerr := runner.Close(); cerr != nil {
+		log.Errorf("Failed to close runner: %s", cerr)
+		if err == nil {
+			err = cerr
+		}
+	}
 	done <- true
 	if err != nil {
 		process.Exit(1)
@@ -0,0 +1,155 @@
+// Public Domain (-) 2010-present, The Web4 Authors.
+// See the Web4 UNLICENSE file for details.
+
+package retry
+
+import (
+	"sync"
+	"time"
+)
+
+// Breaker states.
+const (
+	// Closed is the normal state of a Breaker, where all calls are allowed.
+	Closed BreakerState = iota
+	// Open is the state of a Breaker after too many consecutive failures,
+	// where all calls are rejected until the cooldown has passed.
+	Open
+	// HalfOpen is the state of a Breaker after the cooldown, where a single
+	// probe call is allowed to determine whether to close the Breaker again.
+	HalfOpen
+)
+
+// Breaker implements a circuit breaker, so that callers stop making calls to a
+// failing service, and resume automatically once it recovers. It is safe for
+// concurrent use.
+type Breaker struct {
+	changed   time.Time
+	cooldown  time.Duration
+	failures  int
+	mu        sync.Mutex // protects changed, failures, probing, state, trips
+	probing   bool
+	state     BreakerState
+	threshold int
+	trips     int
+}
+
+// Allow returns whether a call can be made. If not, it also returns the
+// interval to wait for before trying again. Each allowed call must be followed
+// by a call to Record with its outcome.
+func (b *Breaker) Allow() (time.Duration, bool) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	switch b.state {
+	case Open:
+		wait := b.cooldown - time.Since(b.changed)
+		if wait > 0 {
+			return wait, false
+		}
+		b.setState(HalfOpen)
+		b.probing = true
+		return 0, true
+	case HalfOpen:
+		// NOTE(tav): We don't know how long the current probe will take, so
+		// we ask callers to wait for a full cooldown.
+		if b.probing {
+			return b.cooldown, false
+		}
+		b.probing = true
+	}
+	return 0, true
+}
+
+// Cancel records that a call allowed by the Breaker was not made after all,
+// e.g. as the caller gave up waiting for it. It is used instead of Record, and
+// doesn't count as either a success or a failure.
+func (b *Breaker) Cancel() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.state == HalfOpen {
+		b.probing = false
+	}
+}
+
+// Record records the outcome of a call that was allowed by the Breaker.
+func (b *Breaker) Record(failed bool) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	switch b.state {
+	case Closed:
+		if !failed {
+			b.failures = 0
+			return
+		}
+		b.failures++
+		if b.failures >= b.threshold {
+			b.trip()
+		}
+	case HalfOpen:
+		b.probing = false
+		if failed {
+			b.trip()
+		} else {
+			b.failures = 0
+			b.setState(Closed)
+		}
+	}
+}
+
+// Status returns the current status of the Breaker.
+func (b *Breaker) Status() BreakerStatus {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return BreakerStatus{
+		Since: b.changed,
+		State: b.state,
+		Trips: b.trips,
+	}
+}
+
+func (b *Breaker) setState(s BreakerState) {
+	b.changed = time.Now()
+	b.state = s
+}
+
+func (b *Breaker) trip() {
+	b.setState(Open)
+	b.trips++
+}
+
+// BreakerState represents the state of a Breaker.
+type BreakerState int
+
+func (s BreakerState) String() string {
+	switch s {
+	case Closed:
+		return "closed"
+	case Open:
+		return "open"
+	case HalfOpen:
+		return "half-open"
+	}
+	return "unknown"
+}
+
+// BreakerStatus represents the status of a Breaker.
+type BreakerStatus struct {
+	// Since is the time of the last state change. It is zero if the state
+	// has never changed.
+	Since time.Time
+	State BreakerState
+	// Trips is the number of times that the Breaker has been opened.
+	Trips int
+}
+
+// NewBreaker returns a Breaker that opens after the given number of
+// consecutive failures, and allows a probe call after the given cooldown.
+func NewBreaker(threshold int, cooldown time.Duration) *Breaker {
+	if threshold < 1 {
+		threshold = 1
+	}
+	return &Breaker{
+		cooldown:  cooldown,
+		threshold: threshold,
+	}
+}
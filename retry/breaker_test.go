@@ -0,0 +1,66 @@
+// Public Domain (-) 2010-present, The Web4 Authors.
+// See the Web4 UNLICENSE file for details.
+
+package retry
+
+import (
+	"testing"
+	"time"
+)
+
+func TestBreaker(t *testing.T) {
+	b := NewBreaker(2, 10*time.Millisecond)
+	allow := func(want bool) {
+		t.Helper()
+		if _, ok := b.Allow(); ok != want {
+			t.Fatalf("unexpected Allow result in %s state: got %v, want %v", b.Status().State, ok, want)
+		}
+	}
+	state := func(want BreakerState) {
+		t.Helper()
+		if got := b.Status().State; got != want {
+			t.Fatalf("unexpected breaker state: got %s, want %s", got, want)
+		}
+	}
+	allow(true)
+	b.Record(true)
+	allow(true)
+	b.Record(false)
+	allow(true)
+	b.Record(true)
+	state(Closed)
+	allow(true)
+	b.Record(true)
+	state(Open)
+	wait, ok := b.Allow()
+	if ok || wait <= 0 || wait > 10*time.Millisecond {
+		t.Fatalf("unexpected Allow result for open breaker: got (%s, %v)", wait, ok)
+	}
+	time.Sleep(10 * time.Millisecond)
+	allow(true)
+	state(HalfOpen)
+	allow(false)
+	b.Record(true)
+	state(Open)
+	time.Sleep(10 * time.Millisecond)
+	allow(true)
+	b.Cancel()
+	state(HalfOpen)
+	allow(true)
+	b.Record(false)
+	state(Closed)
+	allow(true)
+	b.Record(true)
+	allow(true)
+	b.Cancel()
+	allow(true)
+	b.Record(true)
+	state(Open)
+	time.Sleep(10 * time.Millisecond)
+	allow(true)
+	b.Record(false)
+	state(Closed)
+	if trips := b.Status().Trips; trips != 3 {
+		t.Fatalf("unexpected number of trips: got %d, want 3", trips)
+	}
+}
@@ -0,0 +1,150 @@
+// Public Domain (-) 2010-present, The Web4 Authors.
+// See the Web4 UNLICENSE file for details.
+
+package retry
+
+import (
+	"context"
+	"sync"
+)
+
+// Limiter limits the number of concurrent calls to a service, and adapts the
+// limit using additive increase/multiplicative decrease (AIMD): the limit grows
+// by one for each full window of successful calls, and is halved when the
+// service is overloaded. It is safe for concurrent use.
+type Limiter struct {
+	inflight int
+	limit    float64
+	max      float64
+	min      float64
+	mu       sync.Mutex // protects inflight, limit, since, waiters
+	since    int
+	waiters  []chan struct{}
+}
+
+// Acquire waits until a call can be made within the current limit. It returns
+// an error if the given context is done first. Each successful call to
+// Acquire must be followed by a call to Release.
+func (l *Limiter) Acquire(ctx context.Context) error {
+	l.mu.Lock()
+	if l.inflight < int(l.limit) && len(l.waiters) == 0 {
+		l.inflight++
+		l.mu.Unlock()
+		return nil
+	}
+	ch := make(chan struct{})
+	l.waiters = append(l.waiters, ch)
+	l.mu.Unlock()
+	select {
+	case <-ch:
+		return nil
+	case <-ctx.Done():
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	for i, elem := range l.waiters {
+		if elem == ch {
+			l.waiters = append(l.waiters[:i], l.waiters[i+1:]...)
+			return ctx.Err()
+		}
+	}
+	// NOTE(tav): We were granted a slot just as the context was done, so we
+	// hand it over to the next waiter.
+	l.inflight--
+	l.wake()
+	return ctx.Err()
+}
+
+// Cancel releases the slot for a call acquired with Acquire, without adapting
+// the limit, e.g. for calls that were cancelled before they completed.
+func (l *Limiter) Cancel() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.inflight--
+	l.wake()
+}
+
+// Limit returns the current limit on concurrent calls.
+func (l *Limiter) Limit() int {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return int(l.limit)
+}
+
+// InFlight returns the number of calls currently being made.
+func (l *Limiter) InFlight() int {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.inflight
+}
+
+// Release releases the slot for a call acquired with Acquire, and adapts the
+// limit based on whether the call found the service to be overloaded.
+func (l *Limiter) Release(overloaded bool) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.inflight--
+	l.since++
+	if overloaded {
+		// NOTE(tav): We only decrease the limit once per window, so that a
+		// burst of failures from calls made at the same time doesn't collapse
+		// the limit.
+		if l.since >= int(l.limit) {
+			l.limit /= 2
+			if l.limit < l.min {
+				l.limit = l.min
+			}
+			l.since = 0
+		}
+	} else {
+		l.limit += 1 / l.limit
+		if l.limit > l.max {
+			l.limit = l.max
+		}
+	}
+	l.wake()
+}
+
+// TryAcquire acquires a slot for a call if one is available without waiting,
+// and returns whether it did so. Each successful call to TryAcquire must be
+// followed by a call to Release.
+func (l *Limiter) TryAcquire() bool {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.inflight < int(l.limit) && len(l.waiters) == 0 {
+		l.inflight++
+		return true
+	}
+	return false
+}
+
+// wake grants slots to waiters while within the limit.
+func (l *Limiter) wake() {
+	for len(l.waiters) > 0 && l.inflight < int(l.limit) {
+		l.inflight++
+		close(l.waiters[0])
+		l.waiters = l.waiters[1:]
+	}
+}
+
+// NewLimiter returns a Limiter with the given initial limit, which is adapted
+// within the given bounds.
+func NewLimiter(initial int, min int, max int) *Limiter {
+	if min < 1 {
+		min = 1
+	}
+	if max < min {
+		max = min
+	}
+	if initial < min {
+		initial = min
+	} else if initial > max {
+		initial = max
+	}
+	return &Limiter{
+		limit: float64(initial),
+		max:   float64(max),
+		min:   float64(min),
+		since: initial,
+	}
+}
@@ -0,0 +1,81 @@
+// Public Domain (-) 2010-present, The Web4 Authors.
+// See the Web4 UNLICENSE file for details.
+
+package retry
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestLimiter(t *testing.T) {
+	ctx := context.Background()
+	l := NewLimiter(2, 1, 4)
+	for i := 0; i < 2; i++ {
+		if err := l.Acquire(ctx); err != nil {
+			t.Fatalf("unexpected error acquiring slot: %s", err)
+		}
+	}
+	cctx, cancel := context.WithTimeout(ctx, 5*time.Millisecond)
+	defer cancel()
+	if err := l.Acquire(cctx); err != context.DeadlineExceeded {
+		t.Fatalf("unexpected error acquiring slot over the limit: %v", err)
+	}
+	done := make(chan error)
+	go func() {
+		done <- l.Acquire(ctx)
+	}()
+	l.Release(false)
+	if err := <-done; err != nil {
+		t.Fatalf("unexpected error acquiring released slot: %s", err)
+	}
+	if n := l.InFlight(); n != 2 {
+		t.Fatalf("unexpected number of in-flight calls: got %d, want 2", n)
+	}
+	l.Release(false)
+	l.Release(false)
+	// Additive increase: the limit grows by 1/limit per success.
+	if limit := l.Limit(); limit != 3 {
+		t.Fatalf("unexpected limit after successes: got %d, want 3", limit)
+	}
+	for i := 0; i < 20; i++ {
+		l.Acquire(ctx)
+		l.Release(false)
+	}
+	if limit := l.Limit(); limit != 4 {
+		t.Fatalf("unexpected limit after many successes: got %d, want 4", limit)
+	}
+	// Multiplicative decrease: only once per window of calls.
+	for i := 0; i < 2; i++ {
+		l.Acquire(ctx)
+	}
+	l.Release(true)
+	l.Release(true)
+	if limit := l.Limit(); limit != 2 {
+		t.Fatalf("unexpected limit after overload: got %d, want 2", limit)
+	}
+	for i := 0; i < 10; i++ {
+		l.Acquire(ctx)
+		l.Release(true)
+	}
+	if limit := l.Limit(); limit != 1 {
+		t.Fatalf("unexpected limit after sustained overload: got %d, want 1", limit)
+	}
+	if !l.TryAcquire() {
+		t.Fatalf("unexpected failure to acquire free slot without waiting")
+	}
+	if l.TryAcquire() {
+		t.Fatalf("unexpected slot acquired over the limit without waiting")
+	}
+	l.Release(false)
+	if n := l.InFlight(); n != 0 {
+		t.Fatalf("unexpected number of in-flight calls: got %d, want 0", n)
+	}
+	limit := l.Limit()
+	l.Acquire(ctx)
+	l.Cancel()
+	if n := l.InFlight(); n != 0 || l.Limit() != limit {
+		t.Fatalf("unexpected limiter after cancelled call: got limit %d with %d in flight", l.Limit(), n)
+	}
+}
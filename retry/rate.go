@@ -0,0 +1,80 @@
+// Public Domain (-) 2010-present, The Web4 Authors.
+// See the Web4 UNLICENSE file for details.
+
+package retry
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// RateLimiter limits the rate of calls to a service using a token bucket, so
+// that calls can be made in bursts of up to the bucket size, while being
+// limited to the given rate on average. It is safe for concurrent use.
+type RateLimiter struct {
+	burst  float64
+	last   time.Time
+	mu     sync.Mutex // protects last, tokens
+	rate   float64
+	tokens float64
+}
+
+// Wait waits until a call can be made within the rate limit. It returns an
+// error if the given context is done first.
+func (r *RateLimiter) Wait(ctx context.Context) error {
+	r.mu.Lock()
+	now := time.Now()
+	r.tokens += now.Sub(r.last).Seconds() * r.rate
+	if r.tokens > r.burst {
+		r.tokens = r.burst
+	}
+	r.last = now
+	// NOTE(tav): We reserve a token even if none are available, so that
+	// waiting callers are let through in order.
+	r.tokens--
+	if r.tokens >= 0 {
+		r.mu.Unlock()
+		return nil
+	}
+	wait := time.Duration(-r.tokens / r.rate * float64(time.Second))
+	r.mu.Unlock()
+	if err := ctx.Err(); err != nil {
+		r.cancel()
+		return err
+	}
+	timer := time.NewTimer(wait)
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		timer.Stop()
+		r.cancel()
+		return ctx.Err()
+	}
+}
+
+// cancel returns a reserved token.
+func (r *RateLimiter) cancel() {
+	r.mu.Lock()
+	r.tokens++
+	if r.tokens > r.burst {
+		r.tokens = r.burst
+	}
+	r.mu.Unlock()
+}
+
+// NewRateLimiter returns a RateLimiter that allows the given number of calls
+// per second on average, in bursts of up to the given size. The burst size
+// is at least 1.
+func NewRateLimiter(perSecond float64, burst int) *RateLimiter {
+	if burst < 1 {
+		burst = 1
+	}
+	return &RateLimiter{
+		burst:  float64(burst),
+		last:   time.Now(),
+		rate:   perSecond,
+		tokens: float64(burst),
+	}
+}
@@ -0,0 +1,42 @@
+// Public Domain (-) 2010-present, The Web4 Authors.
+// See the Web4 UNLICENSE file for details.
+
+package retry
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestRateLimiter(t *testing.T) {
+	ctx := context.Background()
+	r := NewRateLimiter(100, 3)
+	start := time.Now()
+	for i := 0; i < 3; i++ {
+		if err := r.Wait(ctx); err != nil {
+			t.Fatalf("unexpected error within burst: %s", err)
+		}
+	}
+	if elapsed := time.Since(start); elapsed > 5*time.Millisecond {
+		t.Fatalf("unexpected wait within burst: %s", elapsed)
+	}
+	for i := 0; i < 5; i++ {
+		if err := r.Wait(ctx); err != nil {
+			t.Fatalf("unexpected error beyond burst: %s", err)
+		}
+	}
+	if elapsed := time.Since(start); elapsed < 40*time.Millisecond {
+		t.Fatalf("unexpected lack of waiting beyond burst: %s", elapsed)
+	}
+	cctx, cancel := context.WithTimeout(ctx, time.Millisecond)
+	defer cancel()
+	slow := NewRateLimiter(1, 1)
+	slow.Wait(ctx)
+	if err := slow.Wait(cctx); err != context.DeadlineExceeded {
+		t.Fatalf("unexpected error waiting beyond context deadline: %v", err)
+	}
+	if slow.tokens < -0.1 {
+		t.Fatalf("token reserved by cancelled wait was not returned: %f", slow.tokens)
+	}
+}
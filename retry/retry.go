@@ -5,10 +5,33 @@
 package retry
 
 import (
+	"context"
 	"fmt"
+	"math/rand"
 	"time"
 )
 
+// decorrelatedBase specifies the interval that DecorrelatedJitter starts from
+// if MinInterval is zero.
+const decorrelatedBase = 100 * time.Millisecond
+
+// Jitter strategies.
+const (
+	// FullJitter picks a random interval between zero and the backoff
+	// interval.
+	FullJitter Jitter = iota
+	// EqualJitter keeps half of the backoff interval, and picks the rest at
+	// random, so that there is always some delay.
+	EqualJitter
+	// DecorrelatedJitter picks a random interval between MinInterval and
+	// three times the previous interval, capped at MaxInterval, if specified.
+	// If MinInterval is zero, decorrelatedBase is used in its place. The
+	// BackoffFactor is only used to determine the number of iterations within
+	// the TotalLimit.
+	DecorrelatedJitter
+	noJitter
+)
+
 // Default is the default retry Handler. It keeps trying up to 5 times without
 // any delays.
 var Default = MustBuild(Policy{
@@ -20,36 +43,215 @@ var Never = MustBuild(Policy{
 	MaxIterations: 1,
 })
 
-// Handler encapsulates a retry policy. Each element specifies the time interval
-// before the next call. The first interval is always zero, so as to not cause
-// any delays before the very first attempt.
-type Handler []time.Duration
+// Attempt describes the outcome of an attempt made within a retry loop.
+type Attempt struct {
+	// Delay is the interval that was waited for before the attempt.
+	Delay time.Duration
+	// Duration is the time taken by the attempt.
+	Duration time.Duration
+	// Err is the error from the attempt. It is nil if the attempt succeeded.
+	Err error
+	// Number is the number of the attempt, starting from 1.
+	Number int
+	// Retriable indicates whether the Classifier found the error to be
+	// retriable.
+	Retriable bool
+}
+
+// Classifier classifies the error from a failed attempt. It returns whether
+// the error is retriable, and the minimum interval to wait for before the next
+// attempt, e.g. as specified by a server's Retry-After header. The minimum
+// interval is capped at the Handler's MaxInterval, if specified, and negative
+// values are ignored.
+type Classifier func(err error) (retriable bool, after time.Duration)
+
+// Handler encapsulates a retry policy. It specifies the backoff interval before
+// each call, with jitter applied on top. The first interval is always zero, so
+// as to not cause any delays before the very first attempt.
+type Handler struct {
+	classify  Classifier
+	intervals []time.Duration
+	jitter    Jitter
+	max       time.Duration
+	min       time.Duration
+}
 
 // Iter returns an Iterator for the retry Handler.
 func (h Handler) Iter() Iterator {
-	return Iterator{h}
+	return Iterator{h: h}
+}
+
+// Len returns the maximum number of iterations for the retry Handler.
+func (h Handler) Len() int {
+	return len(h.intervals)
+}
+
+// WithClassifier returns a copy of the retry Handler that uses the given
+// Classifier for the errors passed to Iterator.Record.
+func (h Handler) WithClassifier(c Classifier) Handler {
+	h.classify = c
+	return h
 }
 
 // Iterator forms the core API of the retry mechanism. Callers should call Next
-// in a for loop, and exit the loop on success.
+// or NextContext in a for loop, and exit the loop on success.
+//
+// If the outcome of each attempt is passed to Record, then iteration stops as
+// soon as an error is classified as not being retriable, and any minimum
+// interval from the Classifier is honored.
 type Iterator struct {
-	h Handler
+	after   time.Duration
+	delay   time.Duration
+	h       Handler
+	idx     int
+	prev    time.Duration
+	start   time.Time
+	started time.Time
+	stop    bool
 }
 
-// Next advances the Iterator by one.
+// Attempts returns the number of attempts that have been started, i.e. the
+// number of times that Next or NextContext has returned true.
+func (i *Iterator) Attempts() int {
+	return i.idx
+}
+
+// Elapsed returns the time since the first attempt was started.
+func (i *Iterator) Elapsed() time.Duration {
+	if i.start.IsZero() {
+		return 0
+	}
+	return time.Since(i.start)
+}
+
+// Next advances the Iterator by one, sleeping for the current interval.
 func (i *Iterator) Next() bool {
-	if len(i.h) == 0 {
+	d, ok := i.next()
+	if !ok {
+		return false
+	}
+	if d > 0 {
+		time.Sleep(d)
+	}
+	i.started = time.Now()
+	return true
+}
+
+// NextContext advances the Iterator by one, like Next, but returns false as
+// soon as the given context is done, including while sleeping for the current
+// interval.
+func (i *Iterator) NextContext(ctx context.Context) bool {
+	if ctx.Err() != nil {
+		return false
+	}
+	d, ok := i.next()
+	if !ok {
 		return false
 	}
-	d := i.h[0]
-	i.h = i.h[1:]
 	if d == 0 {
+		i.started = time.Now()
+		return true
+	}
+	t := time.NewTimer(d)
+	select {
+	case <-ctx.Done():
+		t.Stop()
+		// NOTE(tav): The attempt was never started, so it isn't counted.
+		i.idx--
+		return false
+	case <-t.C:
+		i.started = time.Now()
 		return true
 	}
-	time.Sleep(d)
-	return true
 }
 
+// Record records the outcome of the current attempt, where a nil error
+// indicates success, and returns its description, e.g. for use in metrics.
+//
+// Errors are classified using the Handler's Classifier, if any. Otherwise,
+// all errors are treated as retriable.
+func (i *Iterator) Record(err error) Attempt {
+	a := Attempt{
+		Delay:  i.delay,
+		Err:    err,
+		Number: i.idx,
+	}
+	if !i.started.IsZero() {
+		a.Duration = time.Since(i.started)
+	}
+	if err == nil {
+		return a
+	}
+	a.Retriable = true
+	if i.h.classify != nil {
+		a.Retriable, i.after = i.h.classify(err)
+	}
+	if !a.Retriable {
+		i.stop = true
+	}
+	return a
+}
+
+// jitter applies the Handler's jitter strategy to the given interval.
+func (i *Iterator) jitter(d time.Duration) time.Duration {
+	// NOTE(tav): With DecorrelatedJitter, the intervals from the backoff are
+	// zero if MinInterval is zero, so only the first interval is skipped.
+	if d == 0 && (i.h.jitter != DecorrelatedJitter || i.idx == 0) {
+		return 0
+	}
+	switch i.h.jitter {
+	case FullJitter:
+		d = randDuration(d)
+	case EqualJitter:
+		d = d/2 + randDuration(d-d/2)
+	case DecorrelatedJitter:
+		base := i.h.min
+		if base == 0 {
+			base = decorrelatedBase
+			if i.h.max > 0 && base > i.h.max {
+				base = i.h.max
+			}
+		}
+		if i.prev < base {
+			i.prev = base
+		}
+		upper := 3 * i.prev
+		if i.h.max > 0 && upper > i.h.max {
+			upper = i.h.max
+		}
+		d = base + randDuration(upper-base)
+		i.prev = d
+	}
+	return d
+}
+
+// next returns the interval to wait for before the next iteration, with any
+// jitter applied. It returns false if the Iterator has been exhausted, or the
+// last recorded error was not retriable.
+func (i *Iterator) next() (time.Duration, bool) {
+	if i.stop || i.idx == len(i.h.intervals) {
+		return 0, false
+	}
+	if i.idx == 0 {
+		i.start = time.Now()
+	}
+	d := i.jitter(i.h.intervals[i.idx])
+	i.idx++
+	if d < i.after {
+		d = i.after
+		if i.h.max > 0 && d > i.h.max {
+			d = i.h.max
+		}
+	}
+	i.after = 0
+	i.delay = d
+	return d, true
+}
+
+// Jitter specifies the strategy for adding randomness to retry intervals, so
+// that clients retrying at the same time don't all hit a server together.
+type Jitter int
+
 // Policy specifies the constraints for creating a retry Handler.
 type Policy struct {
 	// BackoffFactor defines the backoff between each retry iteration. If
@@ -59,8 +261,12 @@ type Policy struct {
 	// DisableJitter turns off the automatic addition of jitter into the retry
 	// intervals.
 	DisableJitter bool
+	// Jitter specifies the strategy for adding jitter into the retry
+	// intervals. It defaults to FullJitter.
+	Jitter Jitter
 	// MaxInterval defines the maximum interval duration. If specified, this
-	// must be greater than or equal to the MinInterval value.
+	// must be greater than or equal to the MinInterval value. If unspecified,
+	// intervals are not capped.
 	MaxInterval time.Duration
 	// MaxIterations defines the maxinum number of iterations for a retry
 	// Handler. At least one of MaxIterations and TotalLimit must be specified.
@@ -88,7 +294,10 @@ func Build(p Policy) (Handler, error) {
 			p.BackoffFactor,
 		)
 	}
-	if p.MaxInterval < p.MinInterval {
+	if p.Jitter < FullJitter || p.Jitter >= noJitter {
+		return Handler{}, fmt.Errorf("retry: unknown Jitter strategy: %d", p.Jitter)
+	}
+	if p.MaxInterval != 0 && p.MaxInterval < p.MinInterval {
 		return Handler{}, fmt.Errorf(
 			"retry: MaxInterval (%s) must be greater than or equal to MinInterval (%s)",
 			p.MaxInterval, p.MinInterval,
@@ -104,18 +313,26 @@ func Build(p Policy) (Handler, error) {
 			"retry: TotalLimit cannot be negative: %s", p.TotalLimit,
 		)
 	}
-	h := Handler{0}
+	h := Handler{
+		intervals: []time.Duration{0},
+		jitter:    p.Jitter,
+		max:       p.MaxInterval,
+		min:       p.MinInterval,
+	}
+	if p.DisableJitter {
+		h.jitter = noJitter
+	}
 	ival := p.MinInterval
 	total := time.Duration(0)
 	for {
-		if p.MaxIterations > 0 && uint(len(h)) == p.MaxIterations {
+		if p.MaxIterations > 0 && uint(len(h.intervals)) == p.MaxIterations {
 			break
 		}
-		if len(h) == 1 {
+		if len(h.intervals) == 1 {
 			total = ival
 		} else {
 			ival = time.Duration(float64(ival) * p.BackoffFactor)
-			if ival > p.MaxInterval {
+			if p.MaxInterval > 0 && ival > p.MaxInterval {
 				ival = p.MaxInterval
 			}
 			total += ival
@@ -123,7 +340,7 @@ func Build(p Policy) (Handler, error) {
 		if p.TotalLimit > 0 && total > p.TotalLimit {
 			break
 		}
-		h = append(h, ival)
+		h.intervals = append(h.intervals, ival)
 	}
 	return h, nil
 }
@@ -137,3 +354,11 @@ func MustBuild(p Policy) Handler {
 	}
 	return h
 }
+
+// randDuration returns a random duration between zero and d inclusive.
+func randDuration(d time.Duration) time.Duration {
+	if d <= 0 {
+		return 0
+	}
+	return time.Duration(rand.Int63n(int64(d) + 1))
+}
@@ -4,10 +4,54 @@
 package retry
 
 import (
+	"context"
+	"errors"
 	"testing"
 	"time"
 )
 
+func TestDecorrelatedJitter(t *testing.T) {
+	for _, test := range []struct {
+		max time.Duration
+		min time.Duration
+		low time.Duration
+	}{
+		{0, 10 * time.Millisecond, 10 * time.Millisecond},
+		{time.Second, 0, decorrelatedBase},
+		{0, 0, decorrelatedBase},
+		{time.Millisecond, 0, time.Millisecond},
+	} {
+		it := MustBuild(Policy{
+			Jitter:        DecorrelatedJitter,
+			MaxInterval:   test.max,
+			MaxIterations: 20,
+			MinInterval:   test.min,
+		}).Iter()
+		above := false
+		for idx := 0; ; idx++ {
+			d, ok := it.next()
+			if !ok {
+				break
+			}
+			if idx == 0 {
+				if d != 0 {
+					t.Errorf("Unexpected first interval with max %s and min %s: got %s, want 0", test.max, test.min, d)
+				}
+				continue
+			}
+			if d < test.low || (test.max > 0 && d > test.max) {
+				t.Errorf("Interval %d with max %s and min %s out of range: got %s", idx, test.max, test.min, d)
+			}
+			if d > test.low {
+				above = true
+			}
+		}
+		if !above && test.max != test.low {
+			t.Errorf("Unexpected intervals with max %s and min %s: all were %s", test.max, test.min, test.low)
+		}
+	}
+}
+
 func TestIterator(t *testing.T) {
 	retry := MustBuild(Policy{
 		BackoffFactor: 1.5,
@@ -25,8 +69,152 @@ func TestIterator(t *testing.T) {
 	for it.Next() {
 		count++
 	}
-	want := len(retry) * 2
+	want := retry.Len() * 2
 	if count != want {
 		t.Fatalf("unexpected retry count: got %d, want %d", count, want)
 	}
 }
+
+func TestJitter(t *testing.T) {
+	base := Policy{
+		BackoffFactor: 2,
+		MaxInterval:   time.Second,
+		MaxIterations: 20,
+		MinInterval:   10 * time.Millisecond,
+	}
+	for _, jitter := range []Jitter{FullJitter, EqualJitter, DecorrelatedJitter, noJitter} {
+		p := base
+		if jitter == noJitter {
+			p.DisableJitter = true
+		} else {
+			p.Jitter = jitter
+		}
+		h := MustBuild(p)
+		it := h.Iter()
+		varied := false
+		for idx := 0; ; idx++ {
+			d, ok := it.next()
+			if !ok {
+				break
+			}
+			ival := h.intervals[idx]
+			min, max := time.Duration(0), ival
+			switch jitter {
+			case EqualJitter:
+				min = ival / 2
+			case DecorrelatedJitter:
+				if idx > 0 {
+					min, max = p.MinInterval, p.MaxInterval
+				}
+			case noJitter:
+				min = ival
+			}
+			if d < min || d > max {
+				t.Errorf("Interval %d with jitter %d out of range: got %s, want [%s, %s]", idx, jitter, d, min, max)
+			}
+			if d != ival {
+				varied = true
+			}
+		}
+		if varied == (jitter == noJitter) {
+			t.Errorf("Unexpected intervals with jitter %d: varied = %v", jitter, varied)
+		}
+	}
+	if _, err := Build(Policy{Jitter: noJitter, MaxIterations: 1}); err == nil {
+		t.Errorf("Expected error when building with an unknown Jitter strategy")
+	}
+}
+
+func TestNextContext(t *testing.T) {
+	retry := MustBuild(Policy{
+		MaxInterval:   time.Hour,
+		MaxIterations: 3,
+		MinInterval:   time.Hour,
+	})
+	ctx, cancel := context.WithCancel(context.Background())
+	it := retry.Iter()
+	if !it.NextContext(ctx) {
+		t.Fatalf("Expected the first iteration to run immediately")
+	}
+	time.AfterFunc(10*time.Millisecond, cancel)
+	start := time.Now()
+	if it.NextContext(ctx) {
+		t.Fatalf("Expected the iteration to stop when the context is done")
+	}
+	if elapsed := time.Since(start); elapsed > time.Minute {
+		t.Fatalf("NextContext did not return when the context was done: took %s", elapsed)
+	}
+	if it.NextContext(ctx) {
+		t.Fatalf("Expected no iterations after the context is done")
+	}
+}
+
+func TestRecord(t *testing.T) {
+	errFatal := errors.New("fatal")
+	errSlow := errors.New("slow")
+	retry := MustBuild(Policy{
+		DisableJitter: true,
+		MaxIterations: 5,
+	}).WithClassifier(func(err error) (bool, time.Duration) {
+		switch err {
+		case errFatal:
+			return false, 0
+		case errSlow:
+			return true, 20 * time.Millisecond
+		}
+		return true, 0
+	})
+	it := retry.Iter()
+	if it.Attempts() != 0 || it.Elapsed() != 0 {
+		t.Fatalf("Unexpected state before the first attempt")
+	}
+	var attempts []Attempt
+	for it.Next() {
+		switch it.Attempts() {
+		case 1:
+			attempts = append(attempts, it.Record(errSlow))
+		case 2:
+			attempts = append(attempts, it.Record(errors.New("transient")))
+		default:
+			attempts = append(attempts, it.Record(errFatal))
+		}
+	}
+	if len(attempts) != 3 {
+		t.Fatalf("Got %d attempts, want 3", len(attempts))
+	}
+	if it.Elapsed() < 20*time.Millisecond {
+		t.Errorf("Elapsed time did not include the minimum interval: %s", it.Elapsed())
+	}
+	if a := attempts[1]; a.Number != 2 || a.Delay < 20*time.Millisecond || !a.Retriable {
+		t.Errorf("Unexpected second attempt: %+v", a)
+	}
+	if a := attempts[2]; a.Number != 3 || a.Delay != 0 || a.Retriable || a.Err != errFatal {
+		t.Errorf("Unexpected third attempt: %+v", a)
+	}
+	it = retry.Iter()
+	it.Next()
+	if a := it.Record(nil); a.Err != nil || a.Retriable || a.Number != 1 {
+		t.Errorf("Unexpected successful attempt: %+v", a)
+	}
+}
+
+func TestRecordMaxInterval(t *testing.T) {
+	retry := MustBuild(Policy{
+		DisableJitter: true,
+		MaxInterval:   10 * time.Millisecond,
+		MaxIterations: 2,
+	}).WithClassifier(func(err error) (bool, time.Duration) {
+		return true, time.Hour
+	})
+	it := retry.Iter()
+	var attempts []Attempt
+	for it.Next() {
+		attempts = append(attempts, it.Record(errors.New("slow")))
+	}
+	if len(attempts) != 2 {
+		t.Fatalf("Got %d attempts, want 2", len(attempts))
+	}
+	if d := attempts[1].Delay; d != 10*time.Millisecond {
+		t.Fatalf("Got a delay of %s, want it capped at the MaxInterval of 10ms", d)
+	}
+}
@@ -0,0 +1,115 @@
+// Copyright 2021 Coinbase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package store
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	"github.com/dgraph-io/badger/v3"
+	"github.com/tav/validate-rosetta/api"
+)
+
+const prefixBlock = 'b'
+
+// AddBlock records the given BlockIdentifier as being part of the synced
+// chain.
+func (d *DB) AddBlock(id api.BlockIdentifier) error {
+	err := d.db.Update(func(txn *badger.Txn) error {
+		return txn.Set(blockKey(id.Index), []byte(id.Hash))
+	})
+	if err != nil {
+		return fmt.Errorf("store: failed to add block %d: %w", id.Index, err)
+	}
+	return nil
+}
+
+// BlockHash returns the hash of the synced block at the given index. If there
+// is no synced block at that index, the returned bool will be false.
+func (d *DB) BlockHash(index int64) (string, bool, error) {
+	var hash string
+	err := d.db.View(func(txn *badger.Txn) error {
+		item, err := txn.Get(blockKey(index))
+		if err != nil {
+			return err
+		}
+		return item.Value(func(val []byte) error {
+			hash = string(val)
+			return nil
+		})
+	})
+	if err == badger.ErrKeyNotFound {
+		return "", false, nil
+	}
+	if err != nil {
+		return "", false, fmt.Errorf("store: failed to get block %d: %w", index, err)
+	}
+	return hash, true, nil
+}
+
+// LastBlock returns the BlockIdentifier of the synced block with the highest
+// index below the given limit. If there is no such block, the returned bool
+// will be false.
+func (d *DB) LastBlock(limit int64) (api.BlockIdentifier, bool, error) {
+	id := api.BlockIdentifier{}
+	if limit <= 0 {
+		return id, false, nil
+	}
+	found := false
+	err := d.db.View(func(txn *badger.Txn) error {
+		opts := badger.DefaultIteratorOptions
+		opts.Prefix = []byte{prefixBlock}
+		opts.Reverse = true
+		it := txn.NewIterator(opts)
+		defer it.Close()
+		it.Seek(blockKey(limit - 1))
+		if !it.Valid() {
+			return nil
+		}
+		item := it.Item()
+		id.Index = int64(binary.BigEndian.Uint64(item.Key()[1:]))
+		found = true
+		return item.Value(func(val []byte) error {
+			id.Hash = string(val)
+			return nil
+		})
+	})
+	if err != nil {
+		return id, false, fmt.Errorf("store: failed to get last block: %w", err)
+	}
+	return id, found, nil
+}
+
+// RemoveBlock removes the synced block at the given index, along with any of
+// its indexed transactions.
+//
+// The block is removed before its transactions, so that an interrupted call
+// never leaves a synced block with a partial index.
+func (d *DB) RemoveBlock(index int64) error {
+	err := d.db.Update(func(txn *badger.Txn) error {
+		return txn.Delete(blockKey(index))
+	})
+	if err != nil {
+		return fmt.Errorf("store: failed to remove block %d: %w", index, err)
+	}
+	return d.removeTransactions(index)
+}
+
+func blockKey(index int64) []byte {
+	key := make([]byte, 9)
+	key[0] = prefixBlock
+	binary.BigEndian.PutUint64(key[1:], uint64(index))
+	return key
+}
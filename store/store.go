@@ -16,10 +16,19 @@
 package store
 
 import (
+	"fmt"
+
 	"github.com/dgraph-io/badger/v3"
 	"github.com/tav/validate-rosetta/log"
 )
 
+// formatVersion specifies the version of the format in which data is stored.
+// It is recorded within the datastore, and at the start of each transaction
+// record.
+const formatVersion = 1
+
+const prefixVersion = 'v'
+
 // DB is an internal datastore for validate-rosetta data.
 type DB struct {
 	db *badger.DB
@@ -37,7 +46,33 @@ func New(dir string) (*DB, error) {
 	if err != nil {
 		return nil, err
 	}
+	if err := initFormat(db); err != nil {
+		db.Close()
+		return nil, err
+	}
 	return &DB{
 		db: db,
 	}, nil
 }
+
+// initFormat records the format version within new databases, and checks it
+// within existing ones.
+func initFormat(db *badger.DB) error {
+	return db.Update(func(txn *badger.Txn) error {
+		item, err := txn.Get([]byte{prefixVersion})
+		if err == badger.ErrKeyNotFound {
+			return txn.Set([]byte{prefixVersion}, []byte{formatVersion})
+		}
+		if err != nil {
+			return fmt.Errorf("store: failed to get the datastore format version: %w", err)
+		}
+		return item.Value(func(val []byte) error {
+			if len(val) != 1 || val[0] != formatVersion {
+				return fmt.Errorf(
+					"store: unsupported datastore format version: %x", val,
+				)
+			}
+			return nil
+		})
+	})
+}
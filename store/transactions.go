@@ -0,0 +1,426 @@
+// Copyright 2021 Coinbase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package store
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+
+	"github.com/dgraph-io/badger/v3"
+	"github.com/tav/validate-rosetta/api"
+)
+
+// Key prefixes for the transaction index. The account and currency index keys
+// contain the AppendKey encoding of the AccountIdentifier or Currency, followed
+// by the block index and transaction index of each transaction.
+const (
+	prefixAccount     = 'a'
+	prefixCurrency    = 'c'
+	prefixTransaction = 't'
+)
+
+var errCorruptRecord = errors.New("store: corrupt transaction record")
+
+// IndexedOperation represents the searchable attributes of an Operation.
+type IndexedOperation struct {
+	// Account is the binary key encoding of the AccountIdentifier, as produced
+	// by AppendKey. It is empty if the Operation has no account.
+	Account []byte
+	Address string
+	Coin    string
+	// Currency is the binary key encoding of the Amount's Currency, as
+	// produced by AppendKey. It is empty if the Operation has no amount.
+	Currency []byte
+	Status   string
+	Type     string
+}
+
+// IndexedTransaction represents a Transaction that has been indexed from a
+// synced block.
+type IndexedTransaction struct {
+	Block api.BlockIdentifier
+	Hash  string
+	// JSON is the JSON encoding of the full Transaction.
+	JSON       []byte
+	Operations []IndexedOperation
+}
+
+// AddIndexedBlock records the given block as being part of the synced chain,
+// like AddBlock, and indexes all of its transactions so that they can be
+// scanned with ScanTransactions.
+//
+// The transactions are indexed before the block is recorded, so that an
+// interrupted call never leaves a synced block with a partial index. Any
+// existing index entries for the block's index, e.g. from an interrupted call
+// or an orphaned block, are removed first.
+func (d *DB) AddIndexedBlock(block api.Block) error {
+	id := block.BlockIdentifier
+	if err := d.removeTransactions(id.Index); err != nil {
+		return err
+	}
+	wb := d.db.NewWriteBatch()
+	defer wb.Cancel()
+	var (
+		buf []byte
+		enc []byte
+	)
+	keys := map[string]struct{}{}
+	for i, txn := range block.Transactions {
+		enc = txn.EncodeJSON(enc[:0])
+		buf = appendTransactionRecord(buf[:0], id, txn, enc)
+		// NOTE(tav): Badger retains the value until the batch is flushed, so
+		// we pass it a copy of our reusable buffer.
+		val := make([]byte, len(buf))
+		copy(val, buf)
+		for key := range keys {
+			delete(keys, key)
+		}
+		keys[string(transactionKey(id.Index, uint32(i)))] = struct{}{}
+		for _, op := range txn.Operations {
+			if op.Account.Set {
+				key := indexKey(prefixAccount, op.Account.Value.AppendKey(nil), id.Index, uint32(i))
+				keys[string(key)] = struct{}{}
+			}
+			if op.Amount.Set {
+				key := indexKey(prefixCurrency, op.Amount.Value.Currency.AppendKey(nil), id.Index, uint32(i))
+				keys[string(key)] = struct{}{}
+			}
+		}
+		for key := range keys {
+			var err error
+			if key[0] == prefixTransaction {
+				err = wb.Set([]byte(key), val)
+			} else {
+				err = wb.Set([]byte(key), nil)
+			}
+			if err != nil {
+				return fmt.Errorf(
+					"store: failed to index transaction %q: %w",
+					txn.TransactionIdentifier.Hash, err,
+				)
+			}
+		}
+	}
+	if err := wb.Flush(); err != nil {
+		return fmt.Errorf("store: failed to index block %d: %w", id.Index, err)
+	}
+	return d.AddBlock(id)
+}
+
+// ScanAccountTransactions is like ScanTransactions, but only scans the
+// transactions with an operation on the account with the given key, as
+// produced by AccountIdentifier.AppendKey.
+func (d *DB) ScanAccountTransactions(
+	account []byte, minBlock int64, maxBlock int64, fn func(*IndexedTransaction) error,
+) error {
+	return d.scanIndex(prefixAccount, account, minBlock, maxBlock, fn)
+}
+
+// ScanCurrencyTransactions is like ScanTransactions, but only scans the
+// transactions with an operation with an amount in the currency with the
+// given key, as produced by Currency.AppendKey.
+func (d *DB) ScanCurrencyTransactions(
+	currency []byte, minBlock int64, maxBlock int64, fn func(*IndexedTransaction) error,
+) error {
+	return d.scanIndex(prefixCurrency, currency, minBlock, maxBlock, fn)
+}
+
+// ScanTransactions calls the given function with every indexed transaction in
+// blocks between the given minimum and maximum block indexes, inclusive.
+// Transactions are scanned in descending order of their block index.
+//
+// The IndexedTransaction is reused across calls to fn, and the byte slices
+// within it are only valid during the call, so it must not be retained.
+func (d *DB) ScanTransactions(
+	minBlock int64, maxBlock int64, fn func(*IndexedTransaction) error,
+) error {
+	if maxBlock < 0 || maxBlock < minBlock {
+		return nil
+	}
+	return d.db.View(func(txn *badger.Txn) error {
+		opts := badger.DefaultIteratorOptions
+		opts.Prefix = []byte{prefixTransaction}
+		opts.Reverse = true
+		it := txn.NewIterator(opts)
+		defer it.Close()
+		rec := &IndexedTransaction{}
+		seek := transactionKey(maxBlock, ^uint32(0))
+		for it.Seek(seek); it.Valid(); it.Next() {
+			item := it.Item()
+			if int64(binary.BigEndian.Uint64(item.Key()[1:9])) < minBlock {
+				break
+			}
+			err := item.Value(func(val []byte) error {
+				if err := decodeTransactionRecord(rec, val); err != nil {
+					return err
+				}
+				return fn(rec)
+			})
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// removeTransactions removes the indexed transactions of the block at the
+// given index, along with their account and currency index keys. The deletions
+// are made with a WriteBatch, so that they are split across transactions for
+// large blocks.
+func (d *DB) removeTransactions(index int64) error {
+	var keys [][]byte
+	err := d.db.View(func(txn *badger.Txn) error {
+		opts := badger.DefaultIteratorOptions
+		opts.Prefix = transactionKey(index, 0)[:9]
+		it := txn.NewIterator(opts)
+		defer it.Close()
+		rec := &IndexedTransaction{}
+		for it.Rewind(); it.Valid(); it.Next() {
+			item := it.Item()
+			key := item.KeyCopy(nil)
+			keys = append(keys, key)
+			idx := binary.BigEndian.Uint32(key[9:])
+			err := item.Value(func(val []byte) error {
+				if err := decodeTransactionRecord(rec, val); err != nil {
+					return err
+				}
+				for _, op := range rec.Operations {
+					if len(op.Account) > 0 {
+						keys = append(keys, indexKey(prefixAccount, op.Account, index, idx))
+					}
+					if len(op.Currency) > 0 {
+						keys = append(keys, indexKey(prefixCurrency, op.Currency, index, idx))
+					}
+				}
+				return nil
+			})
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err == nil && len(keys) > 0 {
+		wb := d.db.NewWriteBatch()
+		defer wb.Cancel()
+		seen := map[string]struct{}{}
+		for _, key := range keys {
+			if _, ok := seen[string(key)]; ok {
+				continue
+			}
+			seen[string(key)] = struct{}{}
+			if err = wb.Delete(key); err != nil {
+				break
+			}
+		}
+		if err == nil {
+			err = wb.Flush()
+		}
+	}
+	if err != nil {
+		return fmt.Errorf(
+			"store: failed to remove indexed transactions of block %d: %w", index, err,
+		)
+	}
+	return nil
+}
+
+// scanIndex calls the given function with the indexed transactions that have
+// the given key within the account or currency index.
+func (d *DB) scanIndex(
+	prefix byte, key []byte, minBlock int64, maxBlock int64, fn func(*IndexedTransaction) error,
+) error {
+	if maxBlock < 0 || maxBlock < minBlock {
+		return nil
+	}
+	return d.db.View(func(txn *badger.Txn) error {
+		opts := badger.DefaultIteratorOptions
+		opts.PrefetchValues = false
+		opts.Prefix = append([]byte{prefix}, key...)
+		opts.Reverse = true
+		it := txn.NewIterator(opts)
+		defer it.Close()
+		rec := &IndexedTransaction{}
+		seek := indexKey(prefix, key, maxBlock, ^uint32(0))
+		for it.Seek(seek); it.Valid(); it.Next() {
+			suffix := it.Item().Key()[len(opts.Prefix):]
+			if len(suffix) != 12 {
+				return errCorruptRecord
+			}
+			block := int64(binary.BigEndian.Uint64(suffix))
+			if block < minBlock {
+				break
+			}
+			item, err := txn.Get(transactionKey(block, binary.BigEndian.Uint32(suffix[8:])))
+			if err != nil {
+				return fmt.Errorf("store: failed to get indexed transaction: %w", err)
+			}
+			err = item.Value(func(val []byte) error {
+				if err := decodeTransactionRecord(rec, val); err != nil {
+					return err
+				}
+				return fn(rec)
+			})
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+func appendRecordBytes(b []byte, v []byte) []byte {
+	var n [binary.MaxVarintLen64]byte
+	b = append(b, n[:binary.PutUvarint(n[:], uint64(len(v)))]...)
+	return append(b, v...)
+}
+
+func appendRecordString(b []byte, v string) []byte {
+	var n [binary.MaxVarintLen64]byte
+	b = append(b, n[:binary.PutUvarint(n[:], uint64(len(v)))]...)
+	return append(b, v...)
+}
+
+func appendTransactionRecord(
+	b []byte, block api.BlockIdentifier, txn api.Transaction, enc []byte,
+) []byte {
+	var n [binary.MaxVarintLen64]byte
+	b = append(b, formatVersion)
+	b = append(b, n[:binary.PutVarint(n[:], block.Index)]...)
+	b = appendRecordString(b, block.Hash)
+	b = appendRecordString(b, txn.TransactionIdentifier.Hash)
+	b = appendRecordBytes(b, enc)
+	b = append(b, n[:binary.PutUvarint(n[:], uint64(len(txn.Operations)))]...)
+	var scratch []byte
+	for _, op := range txn.Operations {
+		if op.Account.Set {
+			scratch = op.Account.Value.AppendKey(scratch[:0])
+			b = appendRecordBytes(b, scratch)
+			b = appendRecordString(b, op.Account.Value.Address)
+		} else {
+			b = appendRecordBytes(b, nil)
+			b = appendRecordString(b, "")
+		}
+		if op.CoinChange.Set {
+			b = appendRecordString(b, op.CoinChange.Value.CoinIdentifier.Identifier)
+		} else {
+			b = appendRecordString(b, "")
+		}
+		if op.Amount.Set {
+			scratch = op.Amount.Value.Currency.AppendKey(scratch[:0])
+			b = appendRecordBytes(b, scratch)
+		} else {
+			b = appendRecordBytes(b, nil)
+		}
+		b = appendRecordString(b, op.Status.Value)
+		b = appendRecordString(b, op.Type)
+	}
+	return b
+}
+
+// decodeTransactionRecord decodes the given record into rec. The byte slices
+// within rec will point into the given data.
+func decodeTransactionRecord(rec *IndexedTransaction, data []byte) error {
+	if len(data) == 0 || data[0] != formatVersion {
+		return errCorruptRecord
+	}
+	r := recordReader{data: data[1:]}
+	rec.Block.Index = r.varint()
+	rec.Block.Hash = string(r.bytes())
+	rec.Hash = string(r.bytes())
+	rec.JSON = r.bytes()
+	count := r.uvarint()
+	if r.err != nil || count > uint64(len(data)) {
+		return errCorruptRecord
+	}
+	rec.Operations = rec.Operations[:0]
+	for i := uint64(0); i < count; i++ {
+		rec.Operations = append(rec.Operations, IndexedOperation{
+			Account:  r.bytes(),
+			Address:  string(r.bytes()),
+			Coin:     string(r.bytes()),
+			Currency: r.bytes(),
+			Status:   string(r.bytes()),
+			Type:     string(r.bytes()),
+		})
+	}
+	return r.err
+}
+
+// indexKey returns the key for the transaction at the given block index and
+// transaction index within the account or currency index.
+func indexKey(prefix byte, key []byte, block int64, idx uint32) []byte {
+	b := make([]byte, 1+len(key)+12)
+	b[0] = prefix
+	n := copy(b[1:], key) + 1
+	binary.BigEndian.PutUint64(b[n:], uint64(block))
+	binary.BigEndian.PutUint32(b[n+8:], idx)
+	return b
+}
+
+func transactionKey(block int64, idx uint32) []byte {
+	key := make([]byte, 13)
+	key[0] = prefixTransaction
+	binary.BigEndian.PutUint64(key[1:], uint64(block))
+	binary.BigEndian.PutUint32(key[9:], idx)
+	return key
+}
+
+type recordReader struct {
+	data []byte
+	err  error
+}
+
+func (r *recordReader) bytes() []byte {
+	n := r.uvarint()
+	if r.err != nil {
+		return nil
+	}
+	if n > uint64(len(r.data)) {
+		r.err = errCorruptRecord
+		return nil
+	}
+	v := r.data[:n]
+	r.data = r.data[n:]
+	return v
+}
+
+func (r *recordReader) uvarint() uint64 {
+	if r.err != nil {
+		return 0
+	}
+	v, n := binary.Uvarint(r.data)
+	if n <= 0 {
+		r.err = errCorruptRecord
+		return 0
+	}
+	r.data = r.data[n:]
+	return v
+}
+
+func (r *recordReader) varint() int64 {
+	if r.err != nil {
+		return 0
+	}
+	v, n := binary.Varint(r.data)
+	if n <= 0 {
+		r.err = errCorruptRecord
+		return 0
+	}
+	r.data = r.data[n:]
+	return v
+}
@@ -0,0 +1,216 @@
+// Copyright 2021 Coinbase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validate
+
+import (
+	"bytes"
+	"context"
+	stdjson "encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/tav/validate-rosetta/api"
+	"github.com/tav/validate-rosetta/json"
+)
+
+// CallChecker validates the /call endpoint by making the calls defined by the
+// configured CallFixtures.
+type CallChecker struct {
+	client   *api.Client
+	fixtures []CallFixture
+	interval time.Duration
+	reporter *Reporter
+}
+
+// check makes the call defined by the given fixture and validates the result.
+// If the response is marked as idempotent, the call is repeated to confirm
+// that it returns an identical response.
+func (c *CallChecker) check(ctx context.Context, fixture CallFixture, allow api.Allow) error {
+	if !stringInList(allow.CallMethods, fixture.Method) {
+		return fmt.Errorf(
+			"validate: call fixture method %q is not declared in the call_methods of /network/options",
+			fixture.Method,
+		)
+	}
+	req := &api.CallRequest{
+		Method:     fixture.Method,
+		Parameters: fixture.params,
+	}
+	resp := &api.CallResponse{}
+	if err := c.client.Call(ctx, req, resp, syncRetry); err != nil {
+		return fmt.Errorf("validate: failed to call method %q: %s", fixture.Method, err)
+	}
+	result, err := resp.Result.RawWithJSONNumber()
+	if err != nil {
+		return fmt.Errorf(
+			"validate: /call returned an invalid result for method %q: %w",
+			fixture.Method, err,
+		)
+	}
+	// NOTE(tav): Both the response and the fixture are canonically encoded,
+	// so that they are compared independently of key order, number
+	// formatting, and string escaping.
+	if len(fixture.Result) > 0 {
+		if !resp.Result.Equal(fixture.result) {
+			return fmt.Errorf(
+				"validate: /call returned an unexpected result for method %q: %s",
+				fixture.Method, resp.Result,
+			)
+		}
+	}
+	for _, pred := range fixture.Predicates {
+		if err := checkCallPredicate(result, pred); err != nil {
+			return fmt.Errorf(
+				"validate: /call returned a result for method %q which %s",
+				fixture.Method, err,
+			)
+		}
+	}
+	if !resp.Idempotent {
+		c.reporter.callValidated(false)
+		return nil
+	}
+	repeat := &api.CallResponse{}
+	if err := c.client.Call(ctx, req, repeat, syncRetry); err != nil {
+		return fmt.Errorf("validate: failed to repeat call to method %q: %s", fixture.Method, err)
+	}
+	if !repeat.Idempotent {
+		return fmt.Errorf(
+			"validate: /call returned a non-idempotent response for method %q after an idempotent one",
+			fixture.Method,
+		)
+	}
+	if !resp.Result.Equal(repeat.Result) {
+		return fmt.Errorf(
+			"validate: /call returned different results for idempotent method %q: %s and %s",
+			fixture.Method, resp.Result, repeat.Result,
+		)
+	}
+	c.reporter.callValidated(true)
+	return nil
+}
+
+func (c *CallChecker) run(ctx context.Context, allow api.Allow) error {
+	t := time.NewTicker(c.interval)
+	defer t.Stop()
+	for {
+		for _, fixture := range c.fixtures {
+			if err := c.check(ctx, fixture, allow); err != nil {
+				return err
+			}
+		}
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-t.C:
+		}
+	}
+}
+
+// checkCallPredicate checks that the given predicate holds for the decoded
+// result of a call.
+func checkCallPredicate(result map[string]interface{}, pred CallPredicate) error {
+	var cur interface{} = result
+	for _, elem := range strings.Split(pred.Path, ".") {
+		switch v := cur.(type) {
+		case map[string]interface{}:
+			next, ok := v[elem]
+			if !ok {
+				return fmt.Errorf("has no value at %q", pred.Path)
+			}
+			cur = next
+		case []interface{}:
+			idx, err := strconv.Atoi(elem)
+			if err != nil || idx < 0 || idx >= len(v) {
+				return fmt.Errorf("has no value at %q", pred.Path)
+			}
+			cur = v[idx]
+		default:
+			return fmt.Errorf("has no value at %q", pred.Path)
+		}
+	}
+	if len(pred.value) == 0 {
+		return nil
+	}
+	enc, err := canonicalJSON(cur)
+	if err != nil {
+		return fmt.Errorf("could not be checked: invalid value at %q: %w", pred.Path, err)
+	}
+	if !bytes.Equal(enc, pred.value) {
+		return fmt.Errorf("has %s at %q instead of %s", enc, pred.Path, pred.Value)
+	}
+	return nil
+}
+
+// canonicalJSON returns the canonical encoding of the given value, which can
+// either be raw JSON, or a value decoded from JSON.
+func canonicalJSON(v interface{}) ([]byte, error) {
+	data, ok := v.(stdjson.RawMessage)
+	if !ok {
+		var err error
+		data, err = stdjson.Marshal(v)
+		if err != nil {
+			return nil, fmt.Errorf("validate: failed to encode JSON value: %w", err)
+		}
+	}
+	enc, err := json.Canonicalize(nil, data)
+	if err != nil {
+		return nil, fmt.Errorf("validate: failed to canonicalize JSON value: %w", err)
+	}
+	return enc, nil
+}
+
+// decodeJSONObject decodes the given JSON object, with numeric values decoded
+// as json.Number. If data is empty, a nil map is returned.
+func decodeJSONObject(data stdjson.RawMessage) (map[string]interface{}, error) {
+	if len(data) == 0 {
+		return nil, nil
+	}
+	v, err := decodeJSONValue(data)
+	if err != nil {
+		return nil, err
+	}
+	if v == nil {
+		return nil, nil
+	}
+	obj, ok := v.(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("validate: expected a JSON object, got: %s", data)
+	}
+	return obj, nil
+}
+
+// decodeJSONValue decodes the given JSON value, with numeric values decoded as
+// json.Number.
+func decodeJSONValue(data stdjson.RawMessage) (interface{}, error) {
+	dec := stdjson.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	var v interface{}
+	if err := dec.Decode(&v); err != nil {
+		return nil, fmt.Errorf("validate: failed to decode JSON value: %w", err)
+	}
+	return v, nil
+}
+
+func newCallChecker(cfg *Config, reporter *Reporter) *CallChecker {
+	return &CallChecker{
+		client:   newClient(cfg, reporter, cfg.OnlineURL),
+		fixtures: cfg.Call.Fixtures,
+		interval: time.Duration(cfg.Call.Interval) * time.Second,
+		reporter: reporter,
+	}
+}
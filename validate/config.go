@@ -15,29 +15,350 @@
 package validate
 
 import (
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
 	"fmt"
+	"net/http"
 	"os"
+	"strings"
+	"time"
 
 	"github.com/tav/validate-rosetta/api"
 	"github.com/tav/validate-rosetta/log"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
 )
 
 // Config defines the configuration for validate-rosetta.
 type Config struct {
+	Call struct {
+		// Enabled turns on the validation of the /call endpoint using the
+		// configured Fixtures.
+		Enabled bool `json:"enabled"`
+		// Fixtures specifies the calls to make to the /call endpoint.
+		Fixtures []CallFixture `json:"fixtures"`
+		// Interval specifies the number of seconds between each run through
+		// the Fixtures. If unspecified, it defaults to 60.
+		Interval uint `json:"interval"`
+	} `json:"call"`
 	// Directory for storing validate-rosetta data.
 	Directory string `json:"directory"`
-	Log       struct {
+	Events    struct {
+		// Enabled turns on the validation of the /events/blocks endpoint
+		// against the blocks and reorgs observed by the Syncer.
+		Enabled bool `json:"enabled"`
+		// Limit specifies the maximum number of events to request in each
+		// call to /events/blocks. If unspecified, it defaults to 100.
+		Limit int64 `json:"limit"`
+		// PollInterval specifies the number of seconds between polls of
+		// /events/blocks once the event stream has caught up. If
+		// unspecified, it defaults to 10.
+		PollInterval uint `json:"poll_interval"`
+	} `json:"events"`
+	Limits struct {
+		// MaxArrayLength specifies the maximum number of elements in any
+		// array within a response. If unspecified, it defaults to 1,000,000.
+		MaxArrayLength int `json:"max_array_length"`
+		// MaxDepth specifies the maximum nesting depth of arrays and objects
+		// within a response. If unspecified, it defaults to 128.
+		MaxDepth int `json:"max_depth"`
+		// MaxResponseSize specifies the maximum size of a response in bytes.
+		// If unspecified, it defaults to 256 MiB.
+		MaxResponseSize int `json:"max_response_size"`
+		// MaxStringLength specifies the maximum length of any string within a
+		// response in bytes. If unspecified, it defaults to 16 MiB.
+		MaxStringLength int `json:"max_string_length"`
+	} `json:"limits"`
+	Log struct {
 		Blocks bool `json:"blocks"`
 	} `json:"log"`
+	Mempool struct {
+		// ConfirmationWindow specifies the number of seconds within which a
+		// transaction seen in the mempool must appear in a synced block. The
+		// window is measured using the timestamps of synced blocks, and only
+		// starts once the Syncer has caught up with the tip. If unspecified,
+		// it defaults to 600.
+		ConfirmationWindow uint `json:"confirmation_window"`
+		// Enabled turns on the validation of the /mempool and
+		// /mempool/transaction endpoints.
+		Enabled bool `json:"enabled"`
+		// PollInterval specifies the number of seconds between calls to
+		// /mempool. If unspecified, it defaults to 5.
+		PollInterval uint `json:"poll_interval"`
+	} `json:"mempool"`
 	// Network specifies the specific network to test against.
 	Network api.NetworkIdentifier `json:"network"`
+	// OfflineTransport specifies the HTTP settings for the "offline" Rosetta
+	// API server.
+	OfflineTransport TransportConfig `json:"offline_transport"`
 	// OfflineURL specifies the base URL for an "offline" Rosetta API server.
 	OfflineURL string `json:"offline_url"`
+	// OnlineTransport specifies the HTTP settings for the "online" Rosetta
+	// API server.
+	OnlineTransport TransportConfig `json:"online_transport"`
 	// OnlineURL specifies the base URL for an "online" Rosetta API server.
-	OnlineURL string `json:"online_url"`
+	OnlineURL  string `json:"online_url"`
+	RateLimits struct {
+		// Burst specifies the maximum number of requests that can be made at
+		// once to each Rosetta API server. If unspecified, it defaults to 1.
+		Burst int `json:"burst"`
+		// Endpoints specifies additional rate limits for specific endpoints,
+		// e.g. "/account/balance", which apply on top of the overall rate
+		// limit.
+		Endpoints map[string]RateLimit `json:"endpoints"`
+		// RequestsPerSecond specifies the maximum rate of requests to each
+		// Rosetta API server, shared by all validation processes. If
+		// unspecified, requests are not rate limited.
+		RequestsPerSecond float64 `json:"requests_per_second"`
+	} `json:"rate_limits"`
+	// Record specifies the path of a gzip-compressed JSONL archive to which
+	// all requests to the Rosetta API servers are recorded, along with their
+	// responses, e.g. to reproduce a validation failure offline.
+	Record string `json:"record"`
+	// Replay specifies the path of an archive written with Record. If
+	// specified, the recorded responses are served instead of making requests
+	// to the Rosetta API servers.
+	Replay string `json:"replay"`
+	Search struct {
+		// Enabled turns on the validation of the /search/transactions
+		// endpoint against the transactions indexed by the Syncer.
+		Enabled bool `json:"enabled"`
+		// Interval specifies the number of seconds between randomized search
+		// queries. If unspecified, it defaults to 10.
+		Interval uint `json:"interval"`
+	} `json:"search"`
 	// StatusPort specifies the port for the Status HTTP Server. If unspecified,
 	// the Status HTTP Server will not be run.
 	StatusPort uint16 `json:"status_port"`
+	// Strict specifies how strictly responses are decoded. With "warn",
+	// responses with unknown or duplicate keys, invalid UTF-8, lone
+	// surrogates, numbers with leading zeros, or null values for optional
+	// fields are reported as findings. With "error", they fail the call. If
+	// unspecified, or set to "off", responses are decoded leniently.
+	Strict string `json:"strict"`
+	Sync   struct {
+		// HedgePercentile turns on the hedging of requests to /block and
+		// /block/transaction. If a request hasn't returned after this
+		// percentile of recent latencies, e.g. 95, a duplicate request is
+		// sent, and the first successful response is used. If unspecified,
+		// requests are not hedged.
+		HedgePercentile float64 `json:"hedge_percentile"`
+		// StreamThreshold specifies the size of /block response, in bytes,
+		// from which the transactions of a block are decoded one at a time,
+		// so that large blocks don't need to be held in memory. This only
+		// applies when search validation is disabled, as it needs the full
+		// transactions. If unspecified, it defaults to 1MiB.
+		StreamThreshold int64 `json:"stream_threshold"`
+		// TransactionConcurrency specifies the maximum number of concurrent
+		// calls to /block/transaction when fetching the other transactions
+		// of a block. If unspecified, it defaults to 8.
+		TransactionConcurrency int `json:"transaction_concurrency"`
+	} `json:"sync"`
+	Tracing struct {
+		// Endpoint specifies the host and port of the OTLP/HTTP collector to
+		// which traces are exported. If unspecified, it defaults to
+		// "localhost:4318".
+		Endpoint string `json:"endpoint"`
+		// Exporter turns on the tracing of synced blocks, API calls, and
+		// datastore commits. With "otlp", traces are exported to a collector
+		// at Endpoint. With "file", traces are written as JSON to File. If
+		// unspecified, tracing is disabled.
+		Exporter string `json:"exporter"`
+		// File specifies the path of the file to which traces are written
+		// with the "file" exporter.
+		File string `json:"file"`
+		// Insecure turns off TLS when exporting to the OTLP collector.
+		Insecure bool `json:"insecure"`
+	} `json:"tracing"`
+	recordFile     *os.File
+	recorder       *api.Recorder
+	replay         *api.Replay
+	traceFile      *os.File
+	tracerProvider *sdktrace.TracerProvider
+	transports     map[string]api.Transport
+}
+
+// CallFixture defines a call to the /call endpoint along with the expected
+// result.
+type CallFixture struct {
+	// Method specifies the method to call. It must be one of the CallMethods
+	// declared by the /network/options endpoint.
+	Method string `json:"method"`
+	// Parameters specifies the parameters for the call.
+	Parameters json.RawMessage `json:"parameters"`
+	// Predicates specifies conditions that must hold for the result of the
+	// call.
+	Predicates []CallPredicate `json:"predicates"`
+	// Result, if specified, must match the result of the call exactly.
+	Result json.RawMessage `json:"result"`
+	params api.MapObject
+	result api.MapObject
+}
+
+// CallPredicate defines a condition on a value within the result of a call.
+type CallPredicate struct {
+	// Path specifies the location of the value within the result as a
+	// dot-separated sequence of object keys and array indices, e.g.
+	// "balances.0.value".
+	Path string `json:"path"`
+	// Value, if specified, must match the value at Path. Otherwise, the
+	// predicate only requires that a value exists at Path.
+	Value json.RawMessage `json:"value"`
+	value []byte
+}
+
+// EndpointTimeouts defines the timeouts for requests to a specific endpoint.
+// Unspecified timeouts default to those of the TransportConfig.
+type EndpointTimeouts struct {
+	// DialTimeout specifies the number of seconds to wait for a connection to
+	// be established.
+	DialTimeout uint `json:"dial_timeout"`
+	// ResponseHeaderTimeout specifies the number of seconds to wait for the
+	// response headers after a request has been written.
+	ResponseHeaderTimeout uint `json:"response_header_timeout"`
+	// TLSHandshakeTimeout specifies the number of seconds to wait for a TLS
+	// handshake.
+	TLSHandshakeTimeout uint `json:"tls_handshake_timeout"`
+	// Timeout specifies the number of seconds that a request can take,
+	// including reading the response.
+	Timeout uint `json:"timeout"`
+}
+
+// RateLimit defines the rate limit for requests to an endpoint.
+type RateLimit struct {
+	// Burst specifies the maximum number of requests that can be made at
+	// once. If unspecified, it defaults to 1.
+	Burst int `json:"burst"`
+	// RequestsPerSecond specifies the maximum rate of requests.
+	RequestsPerSecond float64 `json:"requests_per_second"`
+}
+
+// TransportConfig defines the HTTP settings for a Rosetta API server.
+type TransportConfig struct {
+	// CACert specifies the path to a PEM file of CA certificates to trust
+	// instead of the system roots.
+	CACert string `json:"ca_cert"`
+	// ClientCert specifies the path to a PEM file with the client certificate
+	// to present to the server. It must be specified along with ClientKey.
+	ClientCert string `json:"client_cert"`
+	// ClientKey specifies the path to a PEM file with the private key for
+	// ClientCert.
+	ClientKey string `json:"client_key"`
+	// DialTimeout specifies the number of seconds to wait for a connection to
+	// be established. If unspecified, it defaults to 30.
+	DialTimeout uint `json:"dial_timeout"`
+	// DisableCompression stops responses from being requested with gzip
+	// compression.
+	DisableCompression bool `json:"disable_compression"`
+	// DisableHTTP2 stops HTTP/2 from being used over TLS.
+	DisableHTTP2 bool `json:"disable_http2"`
+	// Endpoints specifies the timeouts for specific endpoints, e.g. "/block".
+	Endpoints map[string]EndpointTimeouts `json:"endpoints"`
+	// GzipRequests compresses request bodies with gzip.
+	GzipRequests bool `json:"gzip_requests"`
+	// H2C turns on the use of HTTP/2 without TLS.
+	H2C bool `json:"h2c"`
+	// Headers specifies static headers to send with each request, e.g. API
+	// keys. A "Host" header overrides the host of requests.
+	Headers map[string]string `json:"headers"`
+	// IdleConnTimeout specifies the number of seconds that idle connections
+	// are kept open for. If unspecified, it defaults to 90.
+	IdleConnTimeout uint `json:"idle_conn_timeout"`
+	// MaxIdleConns specifies the maximum number of idle connections to keep
+	// open. If unspecified, it defaults to 2.
+	MaxIdleConns int `json:"max_idle_conns"`
+	// ResponseHeaderTimeout specifies the number of seconds to wait for the
+	// response headers after a request has been written. If unspecified, it
+	// is unlimited.
+	ResponseHeaderTimeout uint `json:"response_header_timeout"`
+	// TLSHandshakeTimeout specifies the number of seconds to wait for a TLS
+	// handshake. If unspecified, it defaults to 10.
+	TLSHandshakeTimeout uint `json:"tls_handshake_timeout"`
+	// Timeout specifies the number of seconds that a request can take,
+	// including reading the response. If unspecified, it defaults to 30.
+	Timeout uint `json:"timeout"`
+}
+
+// transport returns the api.Transport for the TransportConfig. The given
+// field is used in errors.
+func (t TransportConfig) transport(field string) (api.Transport, error) {
+	seconds := func(n uint) time.Duration {
+		return time.Duration(n) * time.Second
+	}
+	if t.MaxIdleConns < 0 {
+		return api.Transport{}, fmt.Errorf(
+			`validate: "%s.max_idle_conns" cannot be negative: %d`, field, t.MaxIdleConns,
+		)
+	}
+	if t.H2C && (t.CACert != "" || t.ClientCert != "") {
+		return api.Transport{}, fmt.Errorf(
+			`validate: "%s.h2c" cannot be used with TLS certificates`, field,
+		)
+	}
+	tr := api.Transport{
+		DialTimeout:           seconds(t.DialTimeout),
+		DisableCompression:    t.DisableCompression,
+		DisableHTTP2:          t.DisableHTTP2,
+		Endpoints:             map[string]api.EndpointTransport{},
+		GzipRequests:          t.GzipRequests,
+		H2C:                   t.H2C,
+		Headers:               http.Header{},
+		IdleConnTimeout:       seconds(t.IdleConnTimeout),
+		MaxIdleConnsPerHost:   t.MaxIdleConns,
+		ResponseHeaderTimeout: seconds(t.ResponseHeaderTimeout),
+		TLSHandshakeTimeout:   seconds(t.TLSHandshakeTimeout),
+		Timeout:               seconds(t.Timeout),
+	}
+	for endpoint, e := range t.Endpoints {
+		if !strings.HasPrefix(endpoint, "/") {
+			return api.Transport{}, fmt.Errorf(
+				`validate: invalid endpoint in "%s.endpoints": %q`, field, endpoint,
+			)
+		}
+		tr.Endpoints[endpoint] = api.EndpointTransport{
+			DialTimeout:           seconds(e.DialTimeout),
+			ResponseHeaderTimeout: seconds(e.ResponseHeaderTimeout),
+			TLSHandshakeTimeout:   seconds(e.TLSHandshakeTimeout),
+			Timeout:               seconds(e.Timeout),
+		}
+	}
+	for key, val := range t.Headers {
+		tr.Headers.Set(key, val)
+	}
+	if t.CACert == "" && t.ClientCert == "" && t.ClientKey == "" {
+		return tr, nil
+	}
+	tr.TLS = &tls.Config{}
+	if t.CACert != "" {
+		pem, err := os.ReadFile(t.CACert)
+		if err != nil {
+			return api.Transport{}, fmt.Errorf(
+				`validate: unable to read "%s.ca_cert": %w`, field, err,
+			)
+		}
+		tr.TLS.RootCAs = x509.NewCertPool()
+		if !tr.TLS.RootCAs.AppendCertsFromPEM(pem) {
+			return api.Transport{}, fmt.Errorf(
+				`validate: no certificates found in "%s.ca_cert": %q`, field, t.CACert,
+			)
+		}
+	}
+	if t.ClientCert != "" || t.ClientKey != "" {
+		if t.ClientCert == "" || t.ClientKey == "" {
+			return api.Transport{}, fmt.Errorf(
+				`validate: "%s.client_cert" and "%s.client_key" must be specified together`,
+				field, field,
+			)
+		}
+		cert, err := tls.LoadX509KeyPair(t.ClientCert, t.ClientKey)
+		if err != nil {
+			return api.Transport{}, fmt.Errorf(
+				"validate: unable to load the client certificate for %q: %w", field, err,
+			)
+		}
+		tr.TLS.Certificates = []tls.Certificate{cert}
+	}
+	return tr, nil
 }
 
 // Init validates the Config and initializes related resources.
@@ -60,5 +381,275 @@ func (c *Config) Init() error {
 	if c.OnlineURL == "" {
 		return fmt.Errorf(`validate: missing "online_url" field`)
 	}
+	for i := range c.Call.Fixtures {
+		fixture := &c.Call.Fixtures[i]
+		if fixture.Method == "" {
+			return fmt.Errorf(`validate: missing "method" field in call fixture %d`, i)
+		}
+		params, err := decodeJSONObject(fixture.Parameters)
+		if err != nil {
+			return fmt.Errorf(
+				"validate: invalid parameters in call fixture %d: %w", i, err,
+			)
+		}
+		fixture.params, err = api.MapObjectFrom(params)
+		if err != nil {
+			return fmt.Errorf(
+				"validate: invalid parameters in call fixture %d: %w", i, err,
+			)
+		}
+		if len(fixture.Result) > 0 {
+			result, err := decodeJSONObject(fixture.Result)
+			if err != nil {
+				return fmt.Errorf(
+					"validate: invalid result in call fixture %d: %w", i, err,
+				)
+			}
+			// NOTE(tav): Empty results are left unset, so as to match the
+			// empty MapObject that they are decoded as.
+			if len(result) > 0 {
+				fixture.result, err = canonicalJSON(fixture.Result)
+				if err != nil {
+					return fmt.Errorf(
+						"validate: invalid result in call fixture %d: %w", i, err,
+					)
+				}
+			}
+		}
+		for j := range fixture.Predicates {
+			pred := &fixture.Predicates[j]
+			if pred.Path == "" {
+				return fmt.Errorf(
+					`validate: missing "path" field in predicate for call fixture %d`, i,
+				)
+			}
+			if len(pred.Value) == 0 {
+				continue
+			}
+			if _, err := decodeJSONValue(pred.Value); err != nil {
+				return fmt.Errorf(
+					"validate: invalid value in predicate for call fixture %d: %w", i, err,
+				)
+			}
+			pred.value, err = canonicalJSON(pred.Value)
+			if err != nil {
+				return fmt.Errorf(
+					"validate: invalid value in predicate for call fixture %d: %w", i, err,
+				)
+			}
+		}
+	}
+	if c.Call.Interval == 0 {
+		c.Call.Interval = 60
+	}
+	if c.Events.Limit < 0 {
+		return fmt.Errorf(
+			`validate: "events.limit" cannot be negative: %d`, c.Events.Limit,
+		)
+	}
+	if c.Events.Limit == 0 {
+		c.Events.Limit = 100
+	}
+	if c.Events.PollInterval == 0 {
+		c.Events.PollInterval = 10
+	}
+	for _, limit := range []struct {
+		field string
+		value *int
+		dflt  int
+	}{
+		{"max_array_length", &c.Limits.MaxArrayLength, 1000000},
+		{"max_depth", &c.Limits.MaxDepth, 128},
+		{"max_response_size", &c.Limits.MaxResponseSize, 256 << 20},
+		{"max_string_length", &c.Limits.MaxStringLength, 16 << 20},
+	} {
+		if *limit.value < 0 {
+			return fmt.Errorf(
+				`validate: "limits.%s" cannot be negative: %d`, limit.field, *limit.value,
+			)
+		}
+		if *limit.value == 0 {
+			*limit.value = limit.dflt
+		}
+	}
+	if c.Mempool.ConfirmationWindow == 0 {
+		c.Mempool.ConfirmationWindow = 600
+	}
+	if c.Mempool.PollInterval == 0 {
+		c.Mempool.PollInterval = 5
+	}
+	if err := c.initRateLimits(); err != nil {
+		return err
+	}
+	if err := c.initReplay(); err != nil {
+		return err
+	}
+	if err := c.initTransports(); err != nil {
+		return err
+	}
+	if c.Search.Interval == 0 {
+		c.Search.Interval = 10
+	}
+	switch c.Strict {
+	case "", "off", "warn", "error":
+	default:
+		return fmt.Errorf(`validate: invalid "strict" value: %q`, c.Strict)
+	}
+	if c.Sync.HedgePercentile < 0 || c.Sync.HedgePercentile >= 100 {
+		return fmt.Errorf(
+			`validate: "sync.hedge_percentile" must be between 0 and 100: %v`,
+			c.Sync.HedgePercentile,
+		)
+	}
+	if c.Sync.StreamThreshold < 0 {
+		return fmt.Errorf(
+			`validate: "sync.stream_threshold" cannot be negative: %d`,
+			c.Sync.StreamThreshold,
+		)
+	}
+	if c.Sync.StreamThreshold == 0 {
+		c.Sync.StreamThreshold = 1 << 20
+	}
+	if c.Sync.TransactionConcurrency < 0 {
+		return fmt.Errorf(
+			`validate: "sync.transaction_concurrency" cannot be negative: %d`,
+			c.Sync.TransactionConcurrency,
+		)
+	}
+	if c.Sync.TransactionConcurrency == 0 {
+		c.Sync.TransactionConcurrency = 8
+	}
+	// NOTE(tav): Files for writing are only created once everything else has
+	// been validated, so that they aren't leaked if Init fails.
+	if err := c.initTracing(); err != nil {
+		return err
+	}
+	if err := c.initRecording(); err != nil {
+		c.closeTracing()
+		return err
+	}
+	// NOTE(tav): The global settings for the api package are only applied
+	// once Init can no longer fail, so that an invalid Config leaves them
+	// untouched.
+	c.applySettings()
+	return nil
+}
+
+// applySettings applies the validated rate limits and HTTP settings to the
+// Clients for the configured base URLs.
+func (c *Config) applySettings() {
+	for _, baseURL := range []string{c.OfflineURL, c.OnlineURL} {
+		if baseURL == "" {
+			continue
+		}
+		api.SetRateLimit(
+			baseURL, "", c.RateLimits.RequestsPerSecond, c.RateLimits.Burst,
+		)
+		for endpoint, l := range c.RateLimits.Endpoints {
+			api.SetRateLimit(baseURL, endpoint, l.RequestsPerSecond, l.Burst)
+		}
+	}
+	for baseURL, t := range c.transports {
+		api.SetTransport(baseURL, t)
+	}
+}
+
+// initRateLimits validates the configured rate limits.
+func (c *Config) initRateLimits() error {
+	check := func(field string, l RateLimit) error {
+		if l.Burst < 0 {
+			return fmt.Errorf(
+				`validate: "%s.burst" cannot be negative: %d`, field, l.Burst,
+			)
+		}
+		if l.RequestsPerSecond < 0 {
+			return fmt.Errorf(
+				`validate: "%s.requests_per_second" cannot be negative: %v`,
+				field, l.RequestsPerSecond,
+			)
+		}
+		return nil
+	}
+	global := RateLimit{
+		Burst:             c.RateLimits.Burst,
+		RequestsPerSecond: c.RateLimits.RequestsPerSecond,
+	}
+	if err := check("rate_limits", global); err != nil {
+		return err
+	}
+	for endpoint, l := range c.RateLimits.Endpoints {
+		if !strings.HasPrefix(endpoint, "/") {
+			return fmt.Errorf(
+				`validate: invalid endpoint in "rate_limits.endpoints": %q`, endpoint,
+			)
+		}
+		if err := check("rate_limits.endpoints."+endpoint, l); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// initRecording creates the archive for recording requests.
+func (c *Config) initRecording() error {
+	if c.Record == "" {
+		return nil
+	}
+	f, err := os.Create(c.Record)
+	if err != nil {
+		return fmt.Errorf("validate: unable to create record archive: %w", err)
+	}
+	c.recordFile = f
+	c.recorder = api.NewRecorder(f)
+	log.Infof("Recording requests to: %s", c.Record)
+	return nil
+}
+
+// initReplay loads the archive for replaying requests.
+func (c *Config) initReplay() error {
+	if c.Record != "" && c.Record == c.Replay {
+		return fmt.Errorf(`validate: "record" and "replay" cannot be the same file`)
+	}
+	if c.Replay == "" {
+		return nil
+	}
+	f, err := os.Open(c.Replay)
+	if err != nil {
+		return fmt.Errorf("validate: unable to open replay archive: %w", err)
+	}
+	defer f.Close()
+	c.replay, err = api.NewReplay(f)
+	if err != nil {
+		return fmt.Errorf("validate: unable to load replay archive %q: %w", c.Replay, err)
+	}
+	log.Infof("Loaded %d recorded requests from: %s", c.replay.Len(), c.Replay)
+	return nil
+}
+
+// initTransports validates the HTTP settings for the configured base URLs.
+//
+// NOTE(tav): If the offline and online servers share the same base URL, the
+// online settings take precedence.
+func (c *Config) initTransports() error {
+	c.transports = map[string]api.Transport{}
+	for _, server := range []struct {
+		baseURL string
+		config  TransportConfig
+		field   string
+	}{
+		{c.OfflineURL, c.OfflineTransport, "offline_transport"},
+		{c.OnlineURL, c.OnlineTransport, "online_transport"},
+	} {
+		t, err := server.config.transport(server.field)
+		if err != nil {
+			return err
+		}
+		if c.replay != nil {
+			t.RoundTripper = c.replay
+		}
+		if server.baseURL != "" {
+			c.transports[server.baseURL] = t
+		}
+	}
 	return nil
 }
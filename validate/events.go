@@ -0,0 +1,272 @@
+// Copyright 2021 Coinbase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validate
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/tav/validate-rosetta/api"
+	"github.com/tav/validate-rosetta/store"
+)
+
+const (
+	// eventsOrphanPolls specifies the number of polls, after the event stream
+	// has caught up, within which a block orphaned by the Syncer must have been
+	// removed by a BlockRemoved event.
+	eventsOrphanPolls = 3
+	// eventsWindow specifies the number of recent blocks from the event stream
+	// that are kept for replaying BlockRemoved events and comparing against the
+	// synced chain.
+	eventsWindow = 2048
+)
+
+// EventsChecker validates the /events/blocks endpoint by replaying the event
+// stream and comparing the resulting chain against the blocks and reorgs
+// observed by the Syncer.
+type EventsChecker struct {
+	client    *api.Client
+	db        *store.DB
+	divergent map[api.BlockIdentifier]bool
+	interval  time.Duration
+	limit     int64
+	mu        sync.Mutex // protects orphans, removed, removedq, tip
+	offset    int64
+	orphans   map[api.BlockIdentifier]int
+	removed   map[api.BlockIdentifier]bool
+	removedq  []api.BlockIdentifier
+	reporter  *Reporter
+	tip       api.BlockIdentifier
+	trimmed   bool
+	view      []api.BlockIdentifier
+}
+
+// apply replays the given BlockEvent against the chain view built from the
+// event stream.
+func (e *EventsChecker) apply(ev api.BlockEvent) error {
+	id := ev.BlockIdentifier
+	switch ev.Type {
+	case api.BlockAdded:
+		if n := len(e.view); n > 0 && id.Index <= e.view[n-1].Index {
+			return fmt.Errorf(
+				"validate: /events/blocks event %d adds block %d on top of block %d",
+				ev.Sequence, id.Index, e.view[n-1].Index,
+			)
+		}
+		if len(e.view) == eventsWindow {
+			copy(e.view, e.view[1:])
+			e.view = e.view[:eventsWindow-1]
+			e.trimmed = true
+		}
+		e.view = append(e.view, id)
+	case api.BlockRemoved:
+		n := len(e.view)
+		if n == 0 {
+			if e.trimmed {
+				// NOTE(tav): The reorg is deeper than our window, so we can't
+				// verify the removed block.
+				break
+			}
+			return fmt.Errorf(
+				"validate: /events/blocks event %d removes block %d before any block was added",
+				ev.Sequence, id.Index,
+			)
+		}
+		if top := e.view[n-1]; !top.Equal(id) {
+			return fmt.Errorf(
+				"validate: /events/blocks event %d removes block %d (%s) instead of the tip %d (%s)",
+				ev.Sequence, id.Index, id.Hash, top.Index, top.Hash,
+			)
+		}
+		e.view = e.view[:n-1]
+		e.mu.Lock()
+		if _, ok := e.orphans[id]; ok {
+			delete(e.orphans, id)
+		} else {
+			if len(e.removedq) == eventsWindow {
+				delete(e.removed, e.removedq[0])
+				e.removedq = e.removedq[1:]
+			}
+			e.removed[id] = true
+			e.removedq = append(e.removedq, id)
+		}
+		e.mu.Unlock()
+	default:
+		return fmt.Errorf(
+			"validate: /events/blocks event %d has an invalid type: %q",
+			ev.Sequence, ev.Type,
+		)
+	}
+	return nil
+}
+
+// compare checks the chain view built from the event stream against the
+// blocks synced by the Syncer. As either side may not have caught up with a
+// reorg yet, a divergence is only reported if it persists across two polls.
+func (e *EventsChecker) compare() error {
+	e.mu.Lock()
+	synced := e.tip
+	e.mu.Unlock()
+	divergent := map[api.BlockIdentifier]bool{}
+	if len(e.view) > 0 && synced.Hash != "" {
+		hi := e.view[len(e.view)-1].Index
+		if synced.Index < hi {
+			hi = synced.Index
+		}
+		pos := 0
+		for idx := e.view[0].Index; idx <= hi; idx++ {
+			hash, ok, err := e.db.BlockHash(idx)
+			if err != nil {
+				return err
+			}
+			var id api.BlockIdentifier
+			inView := pos < len(e.view) && e.view[pos].Index == idx
+			if inView {
+				id = e.view[pos]
+				pos++
+			}
+			switch {
+			case inView && !ok:
+				divergent[id] = true
+			case !inView && ok:
+				divergent[api.BlockIdentifier{Hash: hash, Index: idx}] = true
+			case inView && ok && hash != id.Hash:
+				divergent[id] = true
+			}
+		}
+	}
+	for id := range divergent {
+		if e.divergent[id] {
+			return fmt.Errorf(
+				"validate: /events/blocks diverges from the synced chain at block %d (%s)",
+				id.Index, id.Hash,
+			)
+		}
+	}
+	e.divergent = divergent
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	for id, polls := range e.orphans {
+		if polls+1 >= eventsOrphanPolls {
+			return fmt.Errorf(
+				"validate: /events/blocks did not remove block %d (%s) which was orphaned by a reorg",
+				id.Index, id.Hash,
+			)
+		}
+		e.orphans[id] = polls + 1
+	}
+	return nil
+}
+
+// orphaned records that the Syncer has orphaned the given block due to a
+// reorg. The event stream is expected to contain a matching BlockRemoved
+// event.
+func (e *EventsChecker) orphaned(id api.BlockIdentifier) {
+	e.mu.Lock()
+	if e.removed[id] {
+		delete(e.removed, id)
+	} else {
+		e.orphans[id] = 0
+	}
+	e.mu.Unlock()
+}
+
+func (e *EventsChecker) poll(ctx context.Context) error {
+	resp := &api.EventsBlocksResponse{}
+	req := &api.EventsBlocksRequest{
+		Limit: api.OptionalInt64(e.limit),
+	}
+	for {
+		req.Offset = api.OptionalInt64(e.offset)
+		if err := e.client.EventsBlocks(ctx, req, resp, syncRetry); err != nil {
+			return fmt.Errorf("validate: failed to fetch block events: %s", err)
+		}
+		if int64(len(resp.Events)) > e.limit {
+			return fmt.Errorf(
+				"validate: /events/blocks returned %d events with a limit of %d",
+				len(resp.Events), e.limit,
+			)
+		}
+		for _, ev := range resp.Events {
+			if ev.Sequence != e.offset {
+				return fmt.Errorf(
+					"validate: /events/blocks returned event %d when event %d was expected",
+					ev.Sequence, e.offset,
+				)
+			}
+			if err := e.apply(ev); err != nil {
+				return err
+			}
+			e.offset++
+		}
+		if e.offset > resp.MaxSequence {
+			break
+		}
+		// NOTE(tav): An empty stream has a max_sequence of 0, just like a
+		// stream with a single event, so an empty first page means that there
+		// are no events yet.
+		if len(resp.Events) == 0 && e.offset == 0 && resp.MaxSequence == 0 {
+			break
+		}
+		if len(resp.Events) == 0 {
+			return fmt.Errorf(
+				"validate: /events/blocks returned no events at offset %d with a max_sequence of %d",
+				e.offset, resp.MaxSequence,
+			)
+		}
+	}
+	if err := e.compare(); err != nil {
+		return err
+	}
+	e.reporter.eventsValidated(e.offset)
+	return nil
+}
+
+func (e *EventsChecker) run(ctx context.Context) error {
+	t := time.NewTicker(e.interval)
+	defer t.Stop()
+	for {
+		if err := e.poll(ctx); err != nil {
+			return err
+		}
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-t.C:
+		}
+	}
+}
+
+// synced records the given block as the tip of the chain synced by the
+// Syncer.
+func (e *EventsChecker) synced(id api.BlockIdentifier) {
+	e.mu.Lock()
+	e.tip = id
+	e.mu.Unlock()
+}
+
+func newEventsChecker(cfg *Config, db *store.DB, reporter *Reporter) *EventsChecker {
+	return &EventsChecker{
+		client:   newClient(cfg, reporter, cfg.OnlineURL),
+		db:       db,
+		interval: time.Duration(cfg.Events.PollInterval) * time.Second,
+		limit:    cfg.Events.Limit,
+		orphans:  map[api.BlockIdentifier]int{},
+		removed:  map[api.BlockIdentifier]bool{},
+		reporter: reporter,
+	}
+}
@@ -0,0 +1,83 @@
+// Copyright 2021 Coinbase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validate
+
+import (
+	"context"
+	stdjson "encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/tav/validate-rosetta/api"
+)
+
+func TestEventsPoll(t *testing.T) {
+	hash := func(idx int64) string {
+		return string(rune('a' + idx))
+	}
+	for _, test := range []struct {
+		events int64
+		fail   bool
+		max    int64
+	}{
+		{0, false, 0},
+		{2, false, 1},
+		{0, true, 1},
+	} {
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			req := struct {
+				Offset int64 `json:"offset"`
+			}{}
+			if err := stdjson.NewDecoder(r.Body).Decode(&req); err != nil {
+				t.Errorf("Failed to decode request: %s", err)
+			}
+			resp := api.EventsBlocksResponse{MaxSequence: test.max}
+			for seq := req.Offset; seq < test.events; seq++ {
+				resp.Events = append(resp.Events, api.BlockEvent{
+					BlockIdentifier: api.BlockIdentifier{Hash: hash(seq), Index: seq},
+					Sequence:        seq,
+					Type:            api.BlockAdded,
+				})
+			}
+			w.Write(resp.EncodeJSON(nil))
+		}))
+		client := api.NewClient(srv.URL)
+		client.SetNetwork(api.NetworkIdentifier{Blockchain: "test", Network: "test"})
+		e := &EventsChecker{
+			client:   client,
+			limit:    10,
+			orphans:  map[api.BlockIdentifier]int{},
+			removed:  map[api.BlockIdentifier]bool{},
+			reporter: &Reporter{},
+		}
+		err := e.poll(context.Background())
+		srv.Close()
+		if test.fail {
+			if err == nil || !strings.Contains(err.Error(), "returned no events") {
+				t.Errorf("Expected an error polling %d events with a max_sequence of %d, got: %v", test.events, test.max, err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("Unexpected error polling %d events with a max_sequence of %d: %s", test.events, test.max, err)
+			continue
+		}
+		if e.offset != test.events {
+			t.Errorf("Got an offset of %d after polling %d events, want %d", e.offset, test.events, test.events)
+		}
+	}
+}
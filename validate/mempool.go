@@ -0,0 +1,272 @@
+// Copyright 2021 Coinbase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validate
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/tav/validate-rosetta/api"
+	"github.com/tav/validate-rosetta/store"
+)
+
+// recentConfirmedMax specifies the number of recently confirmed transaction
+// hashes that are kept by the MempoolChecker.
+const recentConfirmedMax = 1 << 16
+
+// MempoolChecker validates the transactions returned by the /mempool and
+// /mempool/transaction endpoints, and tracks whether they are eventually
+// included in a block synced by the Syncer.
+//
+// The confirmation window is measured using the timestamps of the synced
+// blocks, and only starts once the Syncer has caught up with the tip, so that
+// transactions aren't reported while the Syncer is catching up.
+type MempoolChecker struct {
+	client    *api.Client
+	confirmed recentHashes
+	db        *store.DB
+	interval  time.Duration
+	live      bool
+	mu        sync.Mutex // protects confirmed, live, pending, synced
+	pending   map[string]*mempoolEntry
+	reporter  *Reporter
+	synced    time.Time
+	window    time.Duration
+}
+
+type mempoolEntry struct {
+	inMempool bool
+	// reported is set once the transaction has been reported as unconfirmed,
+	// so that it isn't reported again while it remains in the mempool.
+	reported bool
+	// seen is the timestamp of the latest synced block when the transaction
+	// was first seen, or since the Syncer caught up with the tip. It is zero
+	// until then.
+	seen time.Time
+}
+
+// confirm marks any pending mempool transactions within the given block as
+// having been confirmed. The caughtUp parameter indicates whether the block
+// is at the tip of the chain as last seen by the Syncer.
+func (m *MempoolChecker) confirm(block api.Block, caughtUp bool) {
+	confirmed := 0
+	m.mu.Lock()
+	for _, txn := range block.Transactions {
+		hash := txn.TransactionIdentifier.Hash
+		// NOTE(tav): We keep track of recently confirmed transactions, as they
+		// may be confirmed between a /mempool call and being added to pending.
+		m.confirmed.add(hash)
+		if _, ok := m.pending[hash]; ok {
+			delete(m.pending, hash)
+			confirmed++
+		}
+	}
+	ts := time.Unix(0, int64(block.Timestamp)*int64(time.Millisecond))
+	if ts.After(m.synced) {
+		m.synced = ts
+	}
+	if caughtUp {
+		m.live = true
+	}
+	m.mu.Unlock()
+	if confirmed > 0 {
+		m.reporter.mempoolConfirmed(confirmed)
+	}
+}
+
+func (m *MempoolChecker) poll(ctx context.Context, allow api.Allow) error {
+	resp := &api.MempoolResponse{}
+	if err := m.client.Mempool(ctx, &api.NetworkRequest{}, resp, syncRetry); err != nil {
+		return fmt.Errorf("validate: failed to fetch mempool: %s", err)
+	}
+	current := make(map[string]struct{}, len(resp.TransactionIdentifiers))
+	var fresh []api.TransactionIdentifier
+	m.mu.Lock()
+	for _, id := range resp.TransactionIdentifiers {
+		if _, ok := current[id.Hash]; ok {
+			m.mu.Unlock()
+			return fmt.Errorf(
+				"validate: /mempool returned duplicate transaction %q", id.Hash,
+			)
+		}
+		current[id.Hash] = struct{}{}
+		if _, ok := m.pending[id.Hash]; ok {
+			continue
+		}
+		if !m.confirmed.has(id.Hash) {
+			fresh = append(fresh, id)
+		}
+	}
+	m.mu.Unlock()
+	req := &api.MempoolTransactionRequest{}
+	txresp := &api.MempoolTransactionResponse{}
+	for _, id := range fresh {
+		req.TransactionIdentifier = id
+		err := m.client.MempoolTransaction(ctx, req, txresp, syncRetry)
+		if err != nil {
+			// NOTE(tav): The transaction may have left the mempool since the
+			// /mempool call, so we only treat Rosetta errors as transient.
+			if err.CallError == nil {
+				delete(current, id.Hash)
+				continue
+			}
+			return fmt.Errorf(
+				"validate: failed to fetch mempool transaction %q: %s", id.Hash, err,
+			)
+		}
+		if err := validateMempoolTransaction(id, txresp.Transaction, allow); err != nil {
+			return err
+		}
+	}
+	confirmed := 0
+	var unconfirmed, vanished []string
+	m.mu.Lock()
+	for _, id := range fresh {
+		if _, ok := current[id.Hash]; !ok {
+			continue
+		}
+		if m.confirmed.has(id.Hash) {
+			confirmed++
+			continue
+		}
+		m.pending[id.Hash] = &mempoolEntry{}
+	}
+	for hash, entry := range m.pending {
+		_, entry.inMempool = current[hash]
+		if entry.reported {
+			// NOTE(tav): Transactions that have already been reported are
+			// only tracked until they leave the mempool.
+			if !entry.inMempool {
+				delete(m.pending, hash)
+			}
+			continue
+		}
+		if entry.seen.IsZero() {
+			if m.live {
+				entry.seen = m.synced
+			}
+			continue
+		}
+		if m.synced.Sub(entry.seen) < m.window {
+			continue
+		}
+		if entry.inMempool {
+			unconfirmed = append(unconfirmed, hash)
+			entry.reported = true
+		} else {
+			vanished = append(vanished, hash)
+			delete(m.pending, hash)
+		}
+	}
+	m.mu.Unlock()
+	m.reporter.mempoolSeen(len(fresh))
+	if confirmed > 0 {
+		m.reporter.mempoolConfirmed(confirmed)
+	}
+	for _, hash := range unconfirmed {
+		m.reporter.mempoolUnconfirmed(hash, m.window)
+	}
+	for _, hash := range vanished {
+		m.reporter.mempoolVanished(hash, m.window)
+	}
+	return nil
+}
+
+func (m *MempoolChecker) run(ctx context.Context, allow api.Allow) error {
+	t := time.NewTicker(m.interval)
+	defer t.Stop()
+	for {
+		if err := m.poll(ctx, allow); err != nil {
+			return err
+		}
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-t.C:
+		}
+	}
+}
+
+// recentHashes is a set of hashes that only keeps the recentConfirmedMax most
+// recently added ones.
+type recentHashes struct {
+	hashes map[string]struct{}
+	next   int
+	order  []string
+}
+
+func (r *recentHashes) add(hash string) {
+	if _, ok := r.hashes[hash]; ok {
+		return
+	}
+	if r.hashes == nil {
+		r.hashes = map[string]struct{}{}
+	}
+	r.hashes[hash] = struct{}{}
+	if len(r.order) < recentConfirmedMax {
+		r.order = append(r.order, hash)
+		return
+	}
+	delete(r.hashes, r.order[r.next])
+	r.order[r.next] = hash
+	r.next = (r.next + 1) % recentConfirmedMax
+}
+
+func (r *recentHashes) has(hash string) bool {
+	_, ok := r.hashes[hash]
+	return ok
+}
+
+func newMempoolChecker(cfg *Config, db *store.DB, reporter *Reporter) *MempoolChecker {
+	return &MempoolChecker{
+		client:   newClient(cfg, reporter, cfg.OnlineURL),
+		db:       db,
+		interval: time.Duration(cfg.Mempool.PollInterval) * time.Second,
+		pending:  map[string]*mempoolEntry{},
+		reporter: reporter,
+		window:   time.Duration(cfg.Mempool.ConfirmationWindow) * time.Second,
+	}
+}
+
+// validateMempoolTransaction validates that the given transaction matches the
+// requested identifier, and that its operations have no status and only use
+// the operation types declared in the network options.
+func validateMempoolTransaction(
+	id api.TransactionIdentifier, txn api.Transaction, allow api.Allow,
+) error {
+	if !txn.TransactionIdentifier.Equal(id) {
+		return fmt.Errorf(
+			"validate: /mempool/transaction returned transaction %q when %q was requested",
+			txn.TransactionIdentifier.Hash, id.Hash,
+		)
+	}
+	for _, op := range txn.Operations {
+		if op.Status.Set {
+			return fmt.Errorf(
+				"validate: operation %d in mempool transaction %q has a populated status: %q",
+				op.OperationIdentifier.Index, id.Hash, op.Status.Value,
+			)
+		}
+		if !stringInList(allow.OperationTypes, op.Type) {
+			return fmt.Errorf(
+				"validate: operation %d in mempool transaction %q has an undeclared type: %q",
+				op.OperationIdentifier.Index, id.Hash, op.Type,
+			)
+		}
+	}
+	return nil
+}
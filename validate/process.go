@@ -17,22 +17,47 @@ package validate
 
 import (
 	"context"
+	"fmt"
 	"time"
 
 	"github.com/neilotoole/errgroup"
+	"github.com/tav/validate-rosetta/api"
+	"github.com/tav/validate-rosetta/json"
 	"github.com/tav/validate-rosetta/log"
 	"github.com/tav/validate-rosetta/store"
 )
 
 // Runner encapsulates the validation processes for Rosetta APIs.
 type Runner struct {
+	call       *CallChecker
 	cfg        *Config
+	client     *api.Client
 	db         *store.DB
+	events     *EventsChecker
+	mempool    *MempoolChecker
 	reconciler *Reconciler
 	reporter   *Reporter
+	search     *SearchChecker
 	syncer     *Syncer
 }
 
+// Close releases the resources used by the Runner, e.g. flushing any requests
+// that are being recorded, and any spans that are yet to be exported.
+func (p *Runner) Close() error {
+	err := p.cfg.closeTracing()
+	if p.cfg.recorder == nil {
+		return err
+	}
+	rerr := p.cfg.recorder.Close()
+	if cerr := p.cfg.recordFile.Close(); rerr == nil {
+		rerr = cerr
+	}
+	if rerr != nil {
+		return fmt.Errorf("validate: failed to write record archive: %w", rerr)
+	}
+	return err
+}
+
 // ValidateConstructionAPI validates the Rosetta Construction API of an
 // implementation.
 func (p *Runner) ValidateConstructionAPI(ctx context.Context) error {
@@ -48,6 +73,11 @@ func (p *Runner) ValidateConstructionAPI(ctx context.Context) error {
 
 // ValidateDataAPI validates the Rosetta Data API of an implementation.
 func (p *Runner) ValidateDataAPI(ctx context.Context) error {
+	opts, err := p.fetchNetworkOptions(ctx)
+	if err != nil {
+		log.Errorf("Failed to fetch network options: %s", err)
+		return err
+	}
 	g, ctx := errgroup.WithContext(ctx)
 	g.Go(func() error {
 		if err := p.syncer.run(ctx); err != nil {
@@ -63,9 +93,53 @@ func (p *Runner) ValidateDataAPI(ctx context.Context) error {
 		}
 		return nil
 	})
+	if p.call != nil {
+		g.Go(func() error {
+			if err := p.call.run(ctx, opts.Allow); err != nil {
+				log.Errorf("Failed to validate calls: %s", err)
+				return err
+			}
+			return nil
+		})
+	}
+	if p.events != nil {
+		g.Go(func() error {
+			if err := p.events.run(ctx); err != nil {
+				log.Errorf("Failed to validate block events: %s", err)
+				return err
+			}
+			return nil
+		})
+	}
+	if p.mempool != nil {
+		g.Go(func() error {
+			if err := p.mempool.run(ctx, opts.Allow); err != nil {
+				log.Errorf("Failed to validate mempool: %s", err)
+				return err
+			}
+			return nil
+		})
+	}
+	if p.search != nil {
+		g.Go(func() error {
+			if err := p.search.run(ctx, opts.Allow); err != nil {
+				log.Errorf("Failed to validate transaction search: %s", err)
+				return err
+			}
+			return nil
+		})
+	}
 	return g.Wait()
 }
 
+func (p *Runner) fetchNetworkOptions(ctx context.Context) (api.NetworkOptionsResponse, error) {
+	resp := api.NetworkOptionsResponse{}
+	if err := p.client.NetworkOptions(ctx, &api.NetworkRequest{}, &resp, syncRetry); err != nil {
+		return resp, fmt.Errorf("validate: failed to fetch network options: %s", err)
+	}
+	return resp, nil
+}
+
 // New instantiates a new Runner to do validation. If a status port is
 // specified, this will also start up the Status HTTP server in the background.
 func New(cfg *Config, db *store.DB) *Runner {
@@ -80,15 +154,76 @@ func New(cfg *Config, db *store.DB) *Runner {
 		reporter: reporter,
 	}
 	srv.run(cfg.StatusPort)
-	syncer := &Syncer{
-		db:       db,
-		reporter: reporter,
+	syncer := newSyncer(cfg, db, reporter)
+	var call *CallChecker
+	if cfg.Call.Enabled {
+		call = newCallChecker(cfg, reporter)
+	}
+	var events *EventsChecker
+	if cfg.Events.Enabled {
+		events = newEventsChecker(cfg, db, reporter)
+		syncer.events = events
+	}
+	var mempool *MempoolChecker
+	if cfg.Mempool.Enabled {
+		mempool = newMempoolChecker(cfg, db, reporter)
+		syncer.mempool = mempool
+	}
+	var search *SearchChecker
+	if cfg.Search.Enabled {
+		search = newSearchChecker(cfg, db, reporter)
+		syncer.search = search
 	}
 	return &Runner{
+		call:       call,
 		cfg:        cfg,
+		client:     newClient(cfg, reporter, cfg.OnlineURL),
 		db:         db,
+		events:     events,
+		mempool:    mempool,
 		reconciler: reconciler,
 		reporter:   reporter,
+		search:     search,
 		syncer:     syncer,
 	}
 }
+
+// configureClient configures the given Client with the settings shared by all
+// validation processes.
+func configureClient(cfg *Config, reporter *Reporter, c *api.Client) {
+	c.SetAttemptHandler(reporter.attempt)
+	if cfg.recorder != nil {
+		c.SetRecorder(cfg.recorder)
+	}
+	if cfg.tracerProvider != nil {
+		c.Use(traceCall)
+	}
+	c.SetLimits(json.Limits{
+		MaxArrayLength:  cfg.Limits.MaxArrayLength,
+		MaxDepth:        cfg.Limits.MaxDepth,
+		MaxSize:         cfg.Limits.MaxResponseSize,
+		MaxStringLength: cfg.Limits.MaxStringLength,
+	}, reporter.limitExceeded)
+	switch cfg.Strict {
+	case "warn":
+		c.SetStrict(json.StrictWarn, reporter.decodeFindings)
+	case "error":
+		c.SetStrict(json.StrictError, nil)
+	}
+}
+
+func newClient(cfg *Config, reporter *Reporter, baseURL string) *api.Client {
+	c := api.NewClient(baseURL)
+	c.SetNetwork(cfg.Network)
+	configureClient(cfg, reporter, c)
+	return c
+}
+
+func stringInList(xs []string, s string) bool {
+	for _, elem := range xs {
+		if elem == s {
+			return true
+		}
+	}
+	return false
+}
@@ -16,15 +16,223 @@ package validate
 
 import (
 	"context"
+	"sync"
+	"time"
 
+	"github.com/tav/validate-rosetta/api"
+	"github.com/tav/validate-rosetta/json"
+	"github.com/tav/validate-rosetta/log"
+	"github.com/tav/validate-rosetta/retry"
 	"github.com/tav/validate-rosetta/store"
 )
 
 // Reporter reports on activity/progress by the various validation processes.
 type Reporter struct {
-	db *store.DB
+	attempts attemptStatus
+	calls    callStatus
+	db       *store.DB
+	events   int64
+	findings map[string]int
+	hedges   hedgeStatus
+	limits   map[string]int
+	logged   map[string]bool
+	mempool  mempoolStatus
+	mu       sync.Mutex // protects attempts, calls, events, findings, hedges, limits, logged, mempool, orphaned, searches
+	orphaned int
+	searches int
+}
+
+type attemptStatus struct {
+	Failed       int `json:"failed"`
+	NonRetriable int `json:"non_retriable"`
+	Retried      int `json:"retried"`
+	Succeeded    int `json:"succeeded"`
+}
+
+type backendStatus struct {
+	BaseURL      string    `json:"base_url"`
+	BreakerSince time.Time `json:"breaker_since"`
+	BreakerState string    `json:"breaker_state"`
+	BreakerTrips int       `json:"breaker_trips"`
+	InFlight     int       `json:"in_flight"`
+	Limit        int       `json:"limit"`
+}
+
+type callStatus struct {
+	Idempotent int `json:"idempotent"`
+	Validated  int `json:"validated"`
+}
+
+type hedgeStatus struct {
+	Sent int `json:"sent"`
+	Won  int `json:"won"`
+}
+
+type mempoolStatus struct {
+	Confirmed   int `json:"confirmed"`
+	Seen        int `json:"seen"`
+	Unconfirmed int `json:"unconfirmed"`
+	Vanished    int `json:"vanished"`
+}
+
+// attempt records the outcome of an attempt made during a Client API call.
+func (r *Reporter) attempt(endpoint string, a retry.Attempt) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if a.Number > 1 {
+		r.attempts.Retried++
+	}
+	switch {
+	case a.Err == nil:
+		r.attempts.Succeeded++
+	case a.Retriable:
+		r.attempts.Failed++
+	default:
+		r.attempts.Failed++
+		r.attempts.NonRetriable++
+	}
+}
+
+func (r *Reporter) blockOrphaned() {
+	r.mu.Lock()
+	r.orphaned++
+	r.mu.Unlock()
+}
+
+func (r *Reporter) callValidated(idempotent bool) {
+	r.mu.Lock()
+	r.calls.Validated++
+	if idempotent {
+		r.calls.Idempotent++
+	}
+	r.mu.Unlock()
+}
+
+// decodeFindings records the findings from decoding a response in strict
+// mode. Only the first finding of each kind for an endpoint is logged.
+func (r *Reporter) decodeFindings(endpoint string, findings []json.Finding) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.findings == nil {
+		r.findings = map[string]int{}
+		r.logged = map[string]bool{}
+	}
+	for _, finding := range findings {
+		r.findings[string(finding.Kind)]++
+		key := endpoint + " " + string(finding.Kind)
+		if r.logged[key] {
+			continue
+		}
+		r.logged[key] = true
+		log.Warnf("Strict decoding finding for %s: %s", endpoint, finding.Err)
+	}
+}
+
+func (r *Reporter) eventsValidated(n int64) {
+	r.mu.Lock()
+	r.events = n
+	r.mu.Unlock()
+}
+
+// hedged records a hedged request made during a Client API call.
+func (r *Reporter) hedged(endpoint string, won bool) {
+	r.mu.Lock()
+	r.hedges.Sent++
+	if won {
+		r.hedges.Won++
+	}
+	r.mu.Unlock()
+}
+
+func (r *Reporter) limitExceeded(endpoint string, err *json.LimitError) {
+	r.mu.Lock()
+	if r.limits == nil {
+		r.limits = map[string]int{}
+	}
+	r.limits[string(err.Kind)]++
+	r.mu.Unlock()
 }
 
 func (r *Reporter) logProgress(ctx context.Context) error {
 	return nil
 }
+
+func (r *Reporter) mempoolConfirmed(n int) {
+	r.mu.Lock()
+	r.mempool.Confirmed += n
+	r.mu.Unlock()
+}
+
+func (r *Reporter) mempoolSeen(n int) {
+	r.mu.Lock()
+	r.mempool.Seen += n
+	r.mu.Unlock()
+}
+
+func (r *Reporter) mempoolUnconfirmed(hash string, window time.Duration) {
+	log.Warnf(
+		"Mempool transaction %q was not included in a block within %s",
+		hash, window,
+	)
+	r.mu.Lock()
+	r.mempool.Unconfirmed++
+	r.mu.Unlock()
+}
+
+func (r *Reporter) mempoolVanished(hash string, window time.Duration) {
+	log.Warnf(
+		"Mempool transaction %q vanished without being included in a block within %s",
+		hash, window,
+	)
+	r.mu.Lock()
+	r.mempool.Vanished++
+	r.mu.Unlock()
+}
+
+func (r *Reporter) searchValidated() {
+	r.mu.Lock()
+	r.searches++
+	r.mu.Unlock()
+}
+
+func (r *Reporter) status() *statusReport {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return &statusReport{
+		Attempts: r.attempts,
+		Backends: backendStatuses(),
+		Calls:    r.calls,
+		Events:   r.events,
+		Findings: copyCounts(r.findings),
+		Hedges:   r.hedges,
+		Limits:   copyCounts(r.limits),
+		Mempool:  r.mempool,
+		Orphaned: r.orphaned,
+		Searches: r.searches,
+	}
+}
+
+// backendStatuses returns the status of the circuit breakers and concurrency
+// limiters for the Rosetta API servers being validated.
+func backendStatuses() []backendStatus {
+	statuses := []backendStatus{}
+	for _, s := range api.BackendStatuses() {
+		statuses = append(statuses, backendStatus{
+			BaseURL:      s.BaseURL,
+			BreakerSince: s.Breaker.Since,
+			BreakerState: s.Breaker.State.String(),
+			BreakerTrips: s.Breaker.Trips,
+			InFlight:     s.InFlight,
+			Limit:        s.Limit,
+		})
+	}
+	return statuses
+}
+
+func copyCounts(m map[string]int) map[string]int {
+	counts := make(map[string]int, len(m))
+	for k, v := range m {
+		counts[k] = v
+	}
+	return counts
+}
@@ -0,0 +1,503 @@
+// Copyright 2021 Coinbase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validate
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"math/rand"
+	"sync"
+	"time"
+
+	"github.com/tav/validate-rosetta/api"
+	"github.com/tav/validate-rosetta/json"
+	"github.com/tav/validate-rosetta/store"
+)
+
+const (
+	// searchMaxResults specifies the maximum number of results that are paged
+	// through for each query.
+	searchMaxResults = 1000
+	// searchSampleSize specifies the number of operations that are sampled
+	// for generating queries.
+	searchSampleSize = 1000
+	// searchWindow specifies the number of recent blocks within which the
+	// results of each query are checked for completeness.
+	searchWindow = 1000
+)
+
+// SearchChecker validates the /search/transactions endpoint by issuing
+// randomized queries and comparing the responses against the transactions
+// indexed from the blocks synced by the Syncer.
+//
+// Only the blocks indexed since the SearchChecker was created are compared
+// against, as blocks synced before then, e.g. before search validation was
+// enabled, may not have been indexed.
+type SearchChecker struct {
+	client   *api.Client
+	db       *store.DB
+	first    int64
+	interval time.Duration
+	mu       sync.Mutex // protects first, observed, samples, synced
+	observed int
+	rand     *rand.Rand
+	reporter *Reporter
+	samples  []searchSample
+	synced   int64
+}
+
+// searchSample captures the searchable attributes of a synced Operation, so
+// that queries can be generated which are known to have matching results.
+type searchSample struct {
+	account api.OptionalAccountIdentifierType
+	amount  api.OptionalAmountType
+	block   int64
+	coin    api.OptionalCoinIdentifierType
+	hash    string
+	status  api.OptionalStringType
+	typ     string
+}
+
+type searchMatcher func(txn *store.IndexedTransaction) bool
+
+// searchScanner scans the indexed transactions within the given range of
+// blocks which could match a query.
+type searchScanner func(minBlock int64, maxBlock int64, fn func(*store.IndexedTransaction) error) error
+
+// observe records the given block as having been indexed, and adds its
+// operations to the reservoir of samples used to generate queries.
+func (s *SearchChecker) observe(block api.Block) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.first < 0 {
+		s.first = block.BlockIdentifier.Index
+	}
+	s.synced = block.BlockIdentifier.Index
+	for _, txn := range block.Transactions {
+		for _, op := range txn.Operations {
+			sample := searchSample{
+				account: op.Account,
+				amount:  op.Amount,
+				block:   block.BlockIdentifier.Index,
+				hash:    txn.TransactionIdentifier.Hash,
+				status:  op.Status,
+				typ:     op.Type,
+			}
+			if op.CoinChange.Set {
+				sample.coin = api.OptionalCoinIdentifier(op.CoinChange.Value.CoinIdentifier)
+			}
+			s.observed++
+			if len(s.samples) < searchSampleSize {
+				s.samples = append(s.samples, sample)
+			} else if idx := s.rand.Intn(s.observed); idx < searchSampleSize {
+				s.samples[idx] = sample
+			}
+		}
+	}
+}
+
+// orphan drops the samples from the orphaned block at the given index, and
+// rewinds the height of the indexed blocks.
+func (s *SearchChecker) orphan(index int64) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	// NOTE(tav): The blocks that replace the orphaned ones are indexed, so
+	// the index covers them from the orphaned block onwards.
+	if s.first > index {
+		s.first = index
+	}
+	samples := s.samples[:0]
+	for _, sample := range s.samples {
+		if sample.block < index {
+			samples = append(samples, sample)
+		}
+	}
+	s.samples = samples
+	if s.synced >= index {
+		s.synced = index - 1
+	}
+}
+
+// query builds a randomized SearchTransactionsRequest from the given sample,
+// along with the matcher that evaluates it against indexed transactions, and
+// the scanner for the transactions that could match it.
+//
+// Conditions are evaluated at the transaction level, i.e. a condition matches
+// a transaction if any of its operations satisfy it.
+func (s *SearchChecker) query(
+	sample searchSample, maxBlock int64, allow api.Allow,
+) (*api.SearchTransactionsRequest, searchMatcher, searchScanner) {
+	req := &api.SearchTransactionsRequest{
+		Limit:    api.OptionalInt64(int64(1 + s.rand.Intn(25))),
+		MaxBlock: api.OptionalInt64(maxBlock),
+	}
+	var (
+		accountKey  []byte
+		conds       []searchMatcher
+		currencyKey []byte
+	)
+	add := func(cond func(op *store.IndexedOperation) bool) {
+		conds = append(conds, func(txn *store.IndexedTransaction) bool {
+			for i := range txn.Operations {
+				if cond(&txn.Operations[i]) {
+					return true
+				}
+			}
+			return false
+		})
+	}
+	for len(conds) == 0 {
+		switch s.rand.Intn(8) {
+		case 0:
+			hash := sample.hash
+			req.TransactionIdentifier = api.OptionalTransactionIdentifier(
+				api.TransactionIdentifier{Hash: hash},
+			)
+			conds = append(conds, func(txn *store.IndexedTransaction) bool {
+				return txn.Hash == hash
+			})
+		case 1:
+			if !sample.account.Set {
+				continue
+			}
+			req.AccountIdentifier = sample.account
+			key := sample.account.Value.AppendKey(nil)
+			accountKey = key
+			add(func(op *store.IndexedOperation) bool {
+				return bytes.Equal(op.Account, key)
+			})
+		case 2:
+			if !sample.account.Set {
+				continue
+			}
+			addr := sample.account.Value.Address
+			req.Address = api.OptionalString(addr)
+			add(func(op *store.IndexedOperation) bool {
+				return len(op.Account) > 0 && op.Address == addr
+			})
+		case 3:
+			if !sample.coin.Set {
+				continue
+			}
+			coin := sample.coin.Value.Identifier
+			req.CoinIdentifier = sample.coin
+			add(func(op *store.IndexedOperation) bool {
+				return op.Coin == coin
+			})
+		case 4:
+			if !sample.amount.Set {
+				continue
+			}
+			req.Currency = api.OptionalCurrency(sample.amount.Value.Currency)
+			key := sample.amount.Value.Currency.AppendKey(nil)
+			currencyKey = key
+			add(func(op *store.IndexedOperation) bool {
+				return bytes.Equal(op.Currency, key)
+			})
+		case 5:
+			if !sample.status.Set {
+				continue
+			}
+			status := sample.status.Value
+			req.Status = sample.status
+			add(func(op *store.IndexedOperation) bool {
+				return op.Status == status
+			})
+		case 6:
+			typ := sample.typ
+			req.Type = api.OptionalString(typ)
+			add(func(op *store.IndexedOperation) bool {
+				return op.Type == typ
+			})
+		case 7:
+			success := s.rand.Intn(2) == 0
+			req.Success = api.OptionalBool(success)
+			add(func(op *store.IndexedOperation) bool {
+				for _, status := range allow.OperationStatuses {
+					if status.Status == op.Status {
+						return status.Successful == success
+					}
+				}
+				return false
+			})
+		}
+	}
+	if s.rand.Intn(2) == 0 {
+		req.Operator = api.OptionalOperator(api.And)
+		match := func(txn *store.IndexedTransaction) bool {
+			for _, cond := range conds {
+				if !cond(txn) {
+					return false
+				}
+			}
+			return true
+		}
+		// NOTE(tav): Matches for AND queries must satisfy every condition, so
+		// only the transactions in the account or currency index need to be
+		// scanned, if the query has such a condition.
+		switch {
+		case accountKey != nil:
+			return req, match, func(minBlock int64, maxBlock int64, fn func(*store.IndexedTransaction) error) error {
+				return s.db.ScanAccountTransactions(accountKey, minBlock, maxBlock, fn)
+			}
+		case currencyKey != nil:
+			return req, match, func(minBlock int64, maxBlock int64, fn func(*store.IndexedTransaction) error) error {
+				return s.db.ScanCurrencyTransactions(currencyKey, minBlock, maxBlock, fn)
+			}
+		}
+		return req, match, s.db.ScanTransactions
+	}
+	req.Operator = api.OptionalOperator(api.Or)
+	return req, func(txn *store.IndexedTransaction) bool {
+		for _, cond := range conds {
+			if cond(txn) {
+				return true
+			}
+		}
+		return false
+	}, s.db.ScanTransactions
+}
+
+func (s *SearchChecker) run(ctx context.Context, allow api.Allow) error {
+	t := time.NewTicker(s.interval)
+	defer t.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-t.C:
+		}
+		if err := s.search(ctx, allow); err != nil {
+			return err
+		}
+	}
+}
+
+// search issues a randomized query against /search/transactions, and pages
+// through the results to validate them against the local index.
+//
+// NOTE(tav): So that each query takes bounded time and memory, every result is
+// checked against its block in the local index, but the results are only
+// checked for completeness within the most recent searchWindow blocks, and
+// only if there are no more than searchMaxResults of them.
+func (s *SearchChecker) search(ctx context.Context, allow api.Allow) error {
+	s.mu.Lock()
+	if len(s.samples) == 0 {
+		s.mu.Unlock()
+		return nil
+	}
+	sample := s.samples[s.rand.Intn(len(s.samples))]
+	first := s.first
+	maxBlock := s.synced
+	if maxBlock < first {
+		s.mu.Unlock()
+		return nil
+	}
+	req, match, scan := s.query(sample, maxBlock, allow)
+	s.mu.Unlock()
+	minBlock := maxBlock - searchWindow + 1
+	if minBlock < first {
+		minBlock = first
+	}
+	// NOTE(tav): The values in expected are set once the transactions have
+	// been returned.
+	expected := map[string]bool{}
+	err := scan(minBlock, maxBlock, func(txn *store.IndexedTransaction) error {
+		if match(txn) {
+			expected[searchResultKey(txn.Block.Index, txn.Hash)] = false
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("validate: failed to scan indexed transactions: %w", err)
+	}
+	limit := req.Limit.Value
+	resp := &api.SearchTransactionsResponse{}
+	seen := map[string]struct{}{}
+	var (
+		enc      []byte
+		complete = true
+		total    int64
+	)
+	for page := int64(0); ; page++ {
+		if page > 0 && page > total/limit+1 {
+			return fmt.Errorf(
+				"validate: /search/transactions returned more pages than expected for %d results",
+				total,
+			)
+		}
+		if err := s.client.SearchTransactions(ctx, req, resp, syncRetry); err != nil {
+			return fmt.Errorf("validate: failed to search transactions: %s", err)
+		}
+		if page == 0 {
+			total = resp.TotalCount
+			if total < int64(len(expected)) {
+				return fmt.Errorf(
+					"validate: /search/transactions returned a total_count of %d when %d transactions in blocks %d to %d match the %s query",
+					total, len(expected), minBlock, maxBlock, req.Operator.Value,
+				)
+			}
+		} else if resp.TotalCount != total {
+			return fmt.Errorf(
+				"validate: /search/transactions returned a total_count of %d at offset %d after %d at offset 0",
+				resp.TotalCount, req.Offset.Value, total,
+			)
+		}
+		if int64(len(resp.Transactions)) > limit {
+			return fmt.Errorf(
+				"validate: /search/transactions returned %d transactions with a limit of %d",
+				len(resp.Transactions), limit,
+			)
+		}
+		for _, btxn := range resp.Transactions {
+			id := btxn.BlockIdentifier
+			hash := btxn.Transaction.TransactionIdentifier.Hash
+			key := searchResultKey(id.Index, hash)
+			if _, ok := seen[key]; ok {
+				return fmt.Errorf(
+					"validate: /search/transactions returned transaction %q in block %d more than once",
+					hash, id.Index,
+				)
+			}
+			seen[key] = struct{}{}
+			if enc, err = s.verify(req, match, btxn, first, enc); err != nil {
+				return err
+			}
+			if _, ok := expected[key]; ok {
+				expected[key] = true
+			}
+		}
+		if !resp.NextOffset.Set {
+			break
+		}
+		next := req.Offset.Value + int64(len(resp.Transactions))
+		if resp.NextOffset.Value != next {
+			return fmt.Errorf(
+				"validate: /search/transactions returned a next_offset of %d instead of %d",
+				resp.NextOffset.Value, next,
+			)
+		}
+		if len(seen) >= searchMaxResults {
+			complete = false
+			break
+		}
+		req.Offset = api.OptionalInt64(next)
+	}
+	if complete {
+		if int64(len(seen)) != total {
+			return fmt.Errorf(
+				"validate: /search/transactions returned %d transactions instead of the total_count of %d with a limit of %d",
+				len(seen), total, req.Limit.Value,
+			)
+		}
+		for key, found := range expected {
+			if !found {
+				return fmt.Errorf(
+					"validate: /search/transactions did not return transaction %s for the %s query with a max_block of %d",
+					key, req.Operator.Value, req.MaxBlock.Value,
+				)
+			}
+		}
+	}
+	s.reporter.searchValidated()
+	return nil
+}
+
+// verify checks that the given search result is a transaction within the
+// local index which matches the query, and returns the reusable encoding
+// buffer. Results from blocks before the given first indexed block can't be
+// checked against the index, and are only checked against the max_block.
+func (s *SearchChecker) verify(
+	req *api.SearchTransactionsRequest, match searchMatcher,
+	btxn api.BlockTransaction, first int64, enc []byte,
+) ([]byte, error) {
+	id := btxn.BlockIdentifier
+	hash := btxn.Transaction.TransactionIdentifier.Hash
+	if id.Index > req.MaxBlock.Value {
+		return enc, fmt.Errorf(
+			"validate: /search/transactions returned transaction %q in block %d beyond the max_block of %d",
+			hash, id.Index, req.MaxBlock.Value,
+		)
+	}
+	if id.Index < first {
+		return enc, nil
+	}
+	enc = btxn.Transaction.EncodeJSON(enc[:0])
+	found := false
+	err := s.db.ScanTransactions(id.Index, id.Index, func(txn *store.IndexedTransaction) error {
+		if txn.Hash != hash {
+			return nil
+		}
+		found = true
+		if txn.Block.Hash != id.Hash {
+			return fmt.Errorf(
+				"validate: /search/transactions returned transaction %q in block %d with hash %q instead of %q",
+				hash, id.Index, id.Hash, txn.Block.Hash,
+			)
+		}
+		if !match(txn) {
+			return fmt.Errorf(
+				"validate: /search/transactions returned transaction %q in block %d which doesn't match the %s query",
+				hash, id.Index, req.Operator.Value,
+			)
+		}
+		if !bytes.Equal(enc, txn.JSON) {
+			return fmt.Errorf(
+				"validate: /search/transactions returned transaction %q in block %d which differs from the synced block:\n%s",
+				hash, id.Index, diffSyncedTransaction(txn.JSON, btxn.Transaction),
+			)
+		}
+		return nil
+	})
+	if err != nil {
+		return enc, err
+	}
+	if !found {
+		return enc, fmt.Errorf(
+			"validate: /search/transactions returned transaction %q in block %d which isn't in the synced block",
+			hash, id.Index,
+		)
+	}
+	return enc, nil
+}
+
+// diffSyncedTransaction describes how the given transaction differs from the
+// JSON encoding of the transaction within the synced block.
+func diffSyncedTransaction(synced []byte, txn api.Transaction) string {
+	dec := json.NewDecoder()
+	dec.ResetFromBytes(synced)
+	prev := api.Transaction{}
+	if err := prev.DecodeJSON(dec); err != nil {
+		return fmt.Sprintf("failed to decode synced transaction: %s", err)
+	}
+	return api.DescribeDiffs(prev.Diff(txn), 10)
+}
+
+func newSearchChecker(cfg *Config, db *store.DB, reporter *Reporter) *SearchChecker {
+	return &SearchChecker{
+		client:   newClient(cfg, reporter, cfg.OnlineURL),
+		db:       db,
+		first:    -1,
+		interval: time.Duration(cfg.Search.Interval) * time.Second,
+		rand:     rand.New(rand.NewSource(time.Now().UnixNano())),
+		reporter: reporter,
+		synced:   -1,
+	}
+}
+
+func searchResultKey(block int64, hash string) string {
+	return fmt.Sprintf("%d/%s", block, hash)
+}
@@ -33,8 +33,7 @@ type Server struct {
 
 // ServeHTTP acts as a handler for the Status HTTP Server.
 func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
-	status := &statusReport{}
-	data, err := json.Marshal(status)
+	data, err := json.Marshal(s.reporter.status())
 	if err != nil {
 		http.Error(w, err.Error(), http.StatusInternalServerError)
 		return
@@ -70,4 +69,14 @@ func (s *Server) run(port uint16) {
 }
 
 type statusReport struct {
+	Attempts attemptStatus   `json:"attempts"`
+	Backends []backendStatus `json:"backends"`
+	Calls    callStatus      `json:"calls"`
+	Events   int64           `json:"events"`
+	Findings map[string]int  `json:"findings"`
+	Hedges   hedgeStatus     `json:"hedges"`
+	Limits   map[string]int  `json:"limits"`
+	Mempool  mempoolStatus   `json:"mempool"`
+	Orphaned int             `json:"orphaned"`
+	Searches int             `json:"searches"`
 }
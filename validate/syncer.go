@@ -16,25 +16,339 @@ package validate
 
 import (
 	"context"
+	"fmt"
+	"math"
 	"time"
 
+	"github.com/neilotoole/errgroup"
+	"github.com/tav/validate-rosetta/api"
+	"github.com/tav/validate-rosetta/log"
+	"github.com/tav/validate-rosetta/retry"
 	"github.com/tav/validate-rosetta/store"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 )
 
+var syncRetry = retry.MustBuild(retry.Policy{
+	BackoffFactor: 2.0,
+	MaxInterval:   10 * time.Second,
+	MaxIterations: 10,
+	MinInterval:   100 * time.Millisecond,
+})
+
 // Syncer synchronizes blocks from the chain and does the initial validation of
 // them.
 type Syncer struct {
+	cfg      *Config
+	client   *api.Client
 	db       *store.DB
+	events   *EventsChecker
+	genesis  int64
+	last     api.BlockIdentifier
+	mempool  *MempoolChecker
 	reporter *Reporter
+	search   *SearchChecker
+	tip      int64
+	txpool   *api.Pool
+}
+
+// fetchBlock fetches the block at the given index. If the block has been
+// omitted by the chain, the returned bool will be false.
+//
+// Any transactions that were specified in the OtherTransactions field of the
+// BlockResponse are fetched from /block/transaction and merged into the
+// returned Block.
+//
+// If search validation is disabled, the transactions within the /block
+// response are streamed, and only their identifiers are kept.
+func (s *Syncer) fetchBlock(ctx context.Context, index int64) (api.Block, bool, error) {
+	req := &api.BlockRequest{
+		BlockIdentifier: api.PartialBlockIdentifier{
+			Index: api.OptionalInt64(index),
+		},
+	}
+	resp := &api.BlockResponse{}
+	var cerr *api.ClientError
+	if s.search == nil {
+		// NOTE(tav): Without search validation, only the identifiers of the
+		// transactions are needed, so we stream them to avoid holding large
+		// blocks in memory.
+		var ids []api.Transaction
+		cerr = s.client.BlockStream(ctx, req, resp, func(txn *api.Transaction) error {
+			ids = append(ids, api.Transaction{
+				TransactionIdentifier: txn.TransactionIdentifier,
+			})
+			return nil
+		}, syncRetry)
+		if cerr == nil && resp.Block.Set {
+			resp.Block.Value.Transactions = ids
+		}
+	} else {
+		cerr = s.client.Block(ctx, req, resp, syncRetry)
+	}
+	if cerr != nil {
+		return api.Block{}, false, fmt.Errorf(
+			"validate: failed to fetch block %d: %s", index, cerr,
+		)
+	}
+	if !resp.Block.Set {
+		if len(resp.OtherTransactions) > 0 {
+			return api.Block{}, false, fmt.Errorf(
+				"validate: /block returned other_transactions for omitted block %d",
+				index,
+			)
+		}
+		return api.Block{}, false, nil
+	}
+	block := resp.Block.Value
+	if block.BlockIdentifier.Index != index {
+		return api.Block{}, false, fmt.Errorf(
+			"validate: /block returned block %d when block %d was requested",
+			block.BlockIdentifier.Index, index,
+		)
+	}
+	if len(resp.OtherTransactions) == 0 {
+		return block, true, nil
+	}
+	txns, err := s.fetchOtherTransactions(ctx, block, resp.OtherTransactions)
+	if err != nil {
+		return api.Block{}, false, err
+	}
+	block.Transactions = append(block.Transactions, txns...)
+	return block, true, nil
+}
+
+// fetchOtherTransactions concurrently fetches the given transactions from
+// /block/transaction, and validates that each of them belongs to the given
+// block.
+func (s *Syncer) fetchOtherTransactions(
+	ctx context.Context, block api.Block, ids []api.TransactionIdentifier,
+) ([]api.Transaction, error) {
+	seen := make(map[string]struct{}, len(block.Transactions)+len(ids))
+	for _, txn := range block.Transactions {
+		seen[txn.TransactionIdentifier.Hash] = struct{}{}
+	}
+	for _, id := range ids {
+		if _, ok := seen[id.Hash]; ok {
+			return nil, fmt.Errorf(
+				"validate: block %d has duplicate transaction %q in other_transactions",
+				block.BlockIdentifier.Index, id.Hash,
+			)
+		}
+		seen[id.Hash] = struct{}{}
+	}
+	txns := make([]api.Transaction, len(ids))
+	workers := s.cfg.Sync.TransactionConcurrency
+	if workers > len(ids) {
+		workers = len(ids)
+	}
+	g, ctx := errgroup.WithContext(ctx)
+	for i := 0; i < workers; i++ {
+		worker := i
+		g.Go(func() error {
+			for idx := worker; idx < len(ids); idx += workers {
+				req := &api.BlockTransactionRequest{
+					BlockIdentifier:       block.BlockIdentifier,
+					TransactionIdentifier: ids[idx],
+				}
+				// NOTE(tav): We use a fresh response value for each call, as
+				// the decoded Transaction is retained after the call.
+				resp := &api.BlockTransactionResponse{}
+				if err := s.txpool.BlockTransaction(ctx, req, resp, syncRetry); err != nil {
+					return fmt.Errorf(
+						"validate: failed to fetch transaction %q in block %d: %s",
+						ids[idx].Hash, block.BlockIdentifier.Index, err,
+					)
+				}
+				got := resp.Transaction.TransactionIdentifier
+				if !got.Equal(ids[idx]) {
+					return fmt.Errorf(
+						"validate: /block/transaction returned transaction %q when %q was requested in block %d",
+						got.Hash, ids[idx].Hash, block.BlockIdentifier.Index,
+					)
+				}
+				txns[idx] = resp.Transaction
+			}
+			return nil
+		})
+	}
+	if err := g.Wait(); err != nil {
+		return nil, err
+	}
+	return txns, nil
+}
+
+// nextIndex returns the index of the block to fetch after the last synced
+// block.
+func (s *Syncer) nextIndex() int64 {
+	if s.last.Hash == "" {
+		return s.genesis
+	}
+	return s.last.Index + 1
+}
+
+// orphanBlock removes the last synced block after a reorg, and rewinds the
+// Syncer to the synced block before it.
+func (s *Syncer) orphanBlock(ctx context.Context) error {
+	orphan := s.last
+	err := traceStore(ctx, "RemoveBlock", func() error {
+		return s.db.RemoveBlock(orphan.Index)
+	})
+	if err != nil {
+		return err
+	}
+	last, _, err := s.db.LastBlock(orphan.Index)
+	if err != nil {
+		return err
+	}
+	log.Infof("Orphaned block %d (%s) due to a reorg", orphan.Index, orphan.Hash)
+	if s.search != nil {
+		s.search.orphan(orphan.Index)
+	}
+	if s.events != nil {
+		s.events.orphaned(orphan)
+		s.events.synced(last)
+	}
+	s.reporter.blockOrphaned()
+	s.last = last
+	return nil
+}
+
+func (s *Syncer) processBlock(ctx context.Context, block api.Block) error {
+	id := block.BlockIdentifier
+	if s.last.Hash != "" && block.ParentBlockIdentifier.Index != s.last.Index {
+		return fmt.Errorf(
+			"validate: block %d has parent index %d, but the previous block was %d",
+			id.Index, block.ParentBlockIdentifier.Index, s.last.Index,
+		)
+	}
+	// NOTE(tav): The block and its index are added together, so that a synced
+	// block always has its transactions indexed.
+	var err error
+	if s.search != nil {
+		err = traceStore(ctx, "AddIndexedBlock", func() error {
+			return s.db.AddIndexedBlock(block)
+		})
+	} else {
+		err = traceStore(ctx, "AddBlock", func() error {
+			return s.db.AddBlock(id)
+		})
+	}
+	if err != nil {
+		return err
+	}
+	if s.cfg.Log.Blocks {
+		log.Infof(
+			"Synced block %d (%s) with %d transactions",
+			id.Index, id.Hash, len(block.Transactions),
+		)
+	}
+	if s.mempool != nil {
+		s.mempool.confirm(block, id.Index >= s.tip)
+	}
+	if s.search != nil {
+		s.search.observe(block)
+	}
+	if s.events != nil {
+		s.events.synced(id)
+	}
+	s.last = id
+	return nil
 }
 
 func (s *Syncer) run(ctx context.Context) error {
+	if err := s.updateTip(ctx); err != nil {
+		return err
+	}
+	last, ok, err := s.db.LastBlock(math.MaxInt64)
+	if err != nil {
+		return err
+	}
+	if ok {
+		log.Infof("Resuming sync from block %d (%s)", last.Index, last.Hash)
+		s.last = last
+	}
+	index := s.nextIndex()
 	for {
 		select {
 		case <-ctx.Done():
 			return nil
 		default:
 		}
-		time.Sleep(time.Second)
+		if index > s.tip {
+			if err := s.updateTip(ctx); err != nil {
+				return err
+			}
+			if index > s.tip {
+				time.Sleep(time.Second)
+				continue
+			}
+		}
+		index, err = s.syncBlock(ctx, index)
+		if err != nil {
+			return err
+		}
+	}
+}
+
+// syncBlock fetches and processes the block at the given index, and returns
+// the index of the next block to sync.
+func (s *Syncer) syncBlock(ctx context.Context, index int64) (int64, error) {
+	ctx, span := tracer.Start(
+		ctx, "sync block",
+		trace.WithAttributes(attribute.Int64("rosetta.block.index", index)),
+	)
+	defer span.End()
+	block, ok, err := s.fetchBlock(ctx, index)
+	if err != nil {
+		return index, traceError(span, err)
+	}
+	if !ok {
+		span.SetAttributes(attribute.Bool("rosetta.block.omitted", true))
+		return index + 1, nil
+	}
+	span.SetAttributes(
+		attribute.String("rosetta.block.hash", block.BlockIdentifier.Hash),
+		attribute.Int("rosetta.block.transactions", len(block.Transactions)),
+	)
+	if s.last.Hash != "" && block.ParentBlockIdentifier.Hash != s.last.Hash {
+		span.SetAttributes(attribute.Bool("rosetta.block.reorg", true))
+		if err := s.orphanBlock(ctx); err != nil {
+			return index, traceError(span, err)
+		}
+		return s.nextIndex(), nil
+	}
+	if err := s.processBlock(ctx, block); err != nil {
+		return index, traceError(span, err)
+	}
+	return index + 1, nil
+}
+
+func (s *Syncer) updateTip(ctx context.Context) error {
+	resp := &api.NetworkStatusResponse{}
+	if err := s.client.NetworkStatus(ctx, &api.NetworkRequest{}, resp, syncRetry); err != nil {
+		return fmt.Errorf("validate: failed to fetch network status: %s", err)
+	}
+	s.genesis = resp.GenesisBlockIdentifier.Index
+	s.tip = resp.CurrentBlockIdentifier.Index
+	return nil
+}
+
+func newSyncer(cfg *Config, db *store.DB, reporter *Reporter) *Syncer {
+	txpool := api.NewPool(cfg.OnlineURL, func(c *api.Client) {
+		configureClient(cfg, reporter, c)
+		c.SetHedging(cfg.Sync.HedgePercentile, reporter.hedged)
+	})
+	txpool.SetNetwork(cfg.Network)
+	client := newClient(cfg, reporter, cfg.OnlineURL)
+	client.SetHedging(cfg.Sync.HedgePercentile, reporter.hedged)
+	client.SetStreamThreshold(cfg.Sync.StreamThreshold)
+	return &Syncer{
+		cfg:      cfg,
+		client:   client,
+		db:       db,
+		reporter: reporter,
+		tip:      -1,
+		txpool:   txpool,
 	}
 }
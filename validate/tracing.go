@@ -0,0 +1,164 @@
+// Copyright 2021 Coinbase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validate
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/tav/validate-rosetta/api"
+	"github.com/tav/validate-rosetta/log"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.7.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// NOTE(tav): Only block syncing, Client API calls and datastore commits are
+// traced so far. Reconciliation and the construction workflow are still stubs,
+// and each reconciliation and construction step needs a span of its own once
+// they are implemented.
+var tracer = otel.Tracer("github.com/tav/validate-rosetta/validate")
+
+// closeTracing flushes any spans that are yet to be exported, and stops the
+// exporting of traces.
+func (c *Config) closeTracing() error {
+	if c.tracerProvider == nil {
+		return nil
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	err := c.tracerProvider.Shutdown(ctx)
+	if c.traceFile != nil {
+		if cerr := c.traceFile.Close(); err == nil {
+			err = cerr
+		}
+	}
+	if err != nil {
+		return fmt.Errorf("validate: failed to export traces: %w", err)
+	}
+	return nil
+}
+
+// initTracing sets up the exporting of traces, if enabled.
+func (c *Config) initTracing() error {
+	var (
+		exporter sdktrace.SpanExporter
+		err      error
+	)
+	switch c.Tracing.Exporter {
+	case "":
+		return nil
+	case "file":
+		if c.Tracing.File == "" {
+			return fmt.Errorf(`validate: missing "tracing.file" field`)
+		}
+		c.traceFile, err = os.Create(c.Tracing.File)
+		if err != nil {
+			return fmt.Errorf("validate: unable to create trace file: %w", err)
+		}
+		exporter, err = stdouttrace.New(stdouttrace.WithWriter(c.traceFile))
+	case "otlp":
+		opts := []otlptracehttp.Option{}
+		if c.Tracing.Endpoint != "" {
+			opts = append(opts, otlptracehttp.WithEndpoint(c.Tracing.Endpoint))
+		}
+		if c.Tracing.Insecure {
+			opts = append(opts, otlptracehttp.WithInsecure())
+		}
+		exporter, err = otlptracehttp.New(context.Background(), opts...)
+	default:
+		return fmt.Errorf(`validate: invalid "tracing.exporter" value: %q`, c.Tracing.Exporter)
+	}
+	if err != nil {
+		if c.traceFile != nil {
+			c.traceFile.Close()
+			c.traceFile = nil
+		}
+		return fmt.Errorf("validate: unable to create trace exporter: %w", err)
+	}
+	c.tracerProvider = sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(resource.NewWithAttributes(
+			semconv.SchemaURL,
+			semconv.ServiceNameKey.String("validate-rosetta"),
+		)),
+	)
+	otel.SetTracerProvider(c.tracerProvider)
+	log.Infof("Exporting traces via: %s", c.Tracing.Exporter)
+	return nil
+}
+
+// traceCall is Middleware that traces Client API calls, along with the
+// outcome of each attempt made during them.
+func traceCall(
+	ctx context.Context, inv *api.Invocation, next func(ctx context.Context) *api.ClientError,
+) *api.ClientError {
+	ctx, span := tracer.Start(
+		ctx, "api "+inv.Endpoint, trace.WithSpanKind(trace.SpanKindClient),
+	)
+	defer span.End()
+	err := next(ctx)
+	span.SetAttributes(
+		attribute.String("rosetta.endpoint", inv.Endpoint),
+		attribute.Int("rosetta.attempts", len(inv.Attempts)),
+		attribute.Int("http.request_content_length", len(inv.Request)),
+		attribute.Int("http.status_code", inv.Status),
+	)
+	for _, a := range inv.Attempts {
+		attrs := []attribute.KeyValue{
+			attribute.Int("attempt.number", a.Number),
+			attribute.Int64("attempt.delay_ms", a.Delay.Milliseconds()),
+			attribute.Int64("attempt.duration_ms", a.Duration.Milliseconds()),
+		}
+		if a.Err != nil {
+			attrs = append(
+				attrs,
+				attribute.String("attempt.error", a.Err.Error()),
+				attribute.Bool("attempt.retriable", a.Retriable),
+			)
+		}
+		span.AddEvent("attempt", trace.WithAttributes(attrs...))
+	}
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	return err
+}
+
+// traceError records the given error on the span, and returns it.
+func traceError(span trace.Span, err error) error {
+	span.RecordError(err)
+	span.SetStatus(codes.Error, err.Error())
+	return err
+}
+
+// traceStore traces the given call to the internal datastore.
+func traceStore(ctx context.Context, op string, fn func() error) error {
+	_, span := tracer.Start(ctx, "store "+op)
+	defer span.End()
+	if err := fn(); err != nil {
+		return traceError(span, err)
+	}
+	return nil
+}